@@ -3,7 +3,9 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/url"
 	"os"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -117,6 +120,13 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 			SessionToken:    c.Token,
 		}},
 		&awsCredentials.EnvProvider{},
+		&sharedConfigProvider{
+			Filenames:   sharedConfigFilenames(c.CredsFilename),
+			Profile:     sharedConfigProfileName(c.Profile),
+			Region:      c.Region,
+			StsEndpoint: c.endpoint("sts"),
+			MaxRetries:  c.MaxRetries,
+		},
 		&awsCredentials.SharedCredentialsProvider{
 			Filename: c.CredsFilename,
 			Profile:  c.Profile,
@@ -181,11 +191,13 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		return awsCredentials.NewChainCredentials(providers), nil
 	}
 
+	roles, err := c.assumeRoles()
+	if err != nil {
+		return nil, err
+	}
+
 	// Otherwise we need to construct and STS client with the main credentials, and verify
 	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
-
 	creds := awsCredentials.NewChainCredentials(providers)
 	cp, err := creds.Get()
 	if err != nil {
@@ -200,9 +212,29 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 
 	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
 
+	// Each role is assumed with the credentials of the previous one. The
+	// credentials of every hop are cached until they expire, so refreshing the
+	// final role does not assume the whole chain again.
+	for i, role := range roles {
+		log.Printf("[INFO] Attempting to AssumeRole %s (hop %d, SessionName: %q, ExternalId: %q, Policy: %q, PolicyARNs: %q, DurationSeconds: %d)",
+			role.RoleARN, i+1, role.SessionName, role.ExternalID, role.Policy, role.PolicyARNs, role.DurationSeconds)
+
+		creds, err = assumeRoleCredentials(c, creds, role)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return creds, nil
+}
+
+// assumeRoleCredentials assumes a role using the given credentials and
+// verifies that the role can be assumed.
+func assumeRoleCredentials(c *Config, creds *awsCredentials.Credentials, role *AssumeRole) (*awsCredentials.Credentials, error) {
 	awsConfig := &aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
+		Endpoint:         aws.String(c.endpoint("sts")),
 		MaxRetries:       aws.Int(c.MaxRetries),
 		HTTPClient:       cleanhttp.DefaultClient(),
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
@@ -210,23 +242,27 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 
 	stsclient := sts.New(session.New(awsConfig))
 	assumeRoleProvider := &stscreds.AssumeRoleProvider{
-		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+		Client:   &stsPolicyARNsAssumeRoler{STS: stsclient, PolicyARNs: role.PolicyARNs},
+		RoleARN:  role.RoleARN,
+		Duration: stscreds.DefaultDuration,
 	}
-	if c.AssumeRoleSessionName != "" {
-		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	if role.SessionName != "" {
+		assumeRoleProvider.RoleSessionName = role.SessionName
 	}
-	if c.AssumeRoleExternalID != "" {
-		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	if role.ExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(role.ExternalID)
 	}
-	if c.AssumeRolePolicy != "" {
-		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	if role.Policy != "" {
+		assumeRoleProvider.Policy = aws.String(role.Policy)
+	}
+	if role.DurationSeconds > 0 {
+		assumeRoleProvider.Duration = time.Duration(role.DurationSeconds) * time.Second
 	}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
+	providers := []awsCredentials.Provider{assumeRoleProvider}
 
 	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
-	_, err = assumeRoleCreds.Get()
+	_, err := assumeRoleCreds.Get()
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
 			return nil, fmt.Errorf("The role %q cannot be assumed.\n\n"+
@@ -234,7 +270,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 				"    * The credentials used in order to assume the role are invalid\n"+
 				"    * The credentials do not have appropriate permission to assume the role\n"+
 				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
+				role.RoleARN)
 		}
 
 		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
@@ -243,6 +279,50 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	return assumeRoleCreds, nil
 }
 
+// stsPolicyARNsAssumeRoler passes managed session policy ARNs to AssumeRole.
+// The vendored STS client predates the PolicyArns parameter, so it is added
+// to the query once the request body has been built.
+type stsPolicyARNsAssumeRoler struct {
+	*sts.STS
+
+	PolicyARNs []string
+}
+
+func (c *stsPolicyARNsAssumeRoler) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	req, out := c.AssumeRoleRequest(input)
+	if len(c.PolicyARNs) > 0 {
+		req.Handlers.Build.PushBackNamed(request.NamedHandler{
+			Name: "terraform.PolicyARNsHandler",
+			Fn:   stsPolicyARNsBuildHandler(c.PolicyARNs),
+		})
+	}
+	return out, req.Send()
+}
+
+func stsPolicyARNsBuildHandler(policyARNs []string) func(*request.Request) {
+	return func(r *request.Request) {
+		if r.Error != nil {
+			return
+		}
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			r.Error = awserr.New("SerializationError", "failed reading AssumeRole request body", err)
+			return
+		}
+		body, err := url.ParseQuery(string(b))
+		if err != nil {
+			r.Error = awserr.New("SerializationError", "failed parsing AssumeRole request body", err)
+			return
+		}
+
+		for i, arn := range policyARNs {
+			body.Set(fmt.Sprintf("PolicyArns.member.%d.arn", i+1), arn)
+		}
+		r.SetBufferBody([]byte(body.Encode()))
+	}
+}
+
 func setOptionalEndpoint(cfg *aws.Config) string {
 	endpoint := os.Getenv("AWS_METADATA_URL")
 	if endpoint != "" {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -606,6 +607,119 @@ func TestAWSGetCredentials_shouldBeShared(t *testing.T) {
 	}
 }
 
+func TestAWSGetCredentials_shouldAssumeRoleChain(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=3600&PolicyArns.member.1.arn=arn%3Aaws%3Aiam%3A%3Aaws%3Apolicy%2FReadOnlyAccess&RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fsecurity&RoleSessionName=security&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRole_valid, "text/xml"},
+		},
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&RoleArn=arn%3Aaws%3Aiam%3A%3A222222222222%3Arole%2Fworkload&RoleSessionName=workload&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRole_chained, "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := GetCredentials(&Config{
+		AccessKey:                 "accesskey",
+		SecretKey:                 "secretkey",
+		Region:                    "us-east-1",
+		Endpoints:                 map[string]string{"sts": *stsSess.Config.Endpoint},
+		SkipMetadataApiCheck:      true,
+		AssumeRoleARN:             "arn:aws:iam::111111111111:role/security",
+		AssumeRoleSessionName:     "security",
+		AssumeRolePolicyARNs:      []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		AssumeRoleDurationSeconds: 3600,
+		AssumeRoleChain: []*AssumeRole{
+			{
+				RoleARN:     "arn:aws:iam::222222222222:role/workload",
+				SessionName: "workload",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.AccessKeyID != "chainedkey" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "chainedkey", v.AccessKeyID)
+	}
+	if v.SessionToken != "chainedtoken" {
+		t.Fatalf("SessionToken mismatch, expected (%s), got (%s)", "chainedtoken", v.SessionToken)
+	}
+}
+
+func TestAWSGetCredentials_shouldErrorOnUnassumableChainedRole(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fsecurity&RoleSessionName=security&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRole_valid, "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetCredentials(&Config{
+		AccessKey:             "accesskey",
+		SecretKey:             "secretkey",
+		Region:                "us-east-1",
+		Endpoints:             map[string]string{"sts": *stsSess.Config.Endpoint},
+		SkipMetadataApiCheck:  true,
+		AssumeRoleARN:         "arn:aws:iam::111111111111:role/security",
+		AssumeRoleSessionName: "security",
+		AssumeRoleChain: []*AssumeRole{
+			{
+				RoleARN:     "arn:aws:iam::222222222222:role/workload",
+				SessionName: "workload",
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("Expected an error when the chained role cannot be assumed")
+	}
+}
+
+func TestAWSGetCredentials_shouldErrorOnLongChainedRoleSession(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	_, err := GetCredentials(&Config{
+		AccessKey:                 "accesskey",
+		SecretKey:                 "secretkey",
+		Region:                    "us-east-1",
+		SkipMetadataApiCheck:      true,
+		AssumeRoleARN:             "arn:aws:iam::111111111111:role/security",
+		AssumeRoleDurationSeconds: 43200,
+		AssumeRoleChain: []*AssumeRole{
+			{
+				RoleARN:         "arn:aws:iam::222222222222:role/workload",
+				DurationSeconds: 7200,
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("Expected an error for a chained role session longer than one hour")
+	}
+	if !strings.Contains(err.Error(), "assume_role 2") {
+		t.Fatalf("Expected the error to name the second assume_role, got: %s", err)
+	}
+}
+
 func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 	// need to set the environment variables to a dummy string, as we don't know
 	// what they may be at runtime without hardcoding here
@@ -894,3 +1008,21 @@ const iamResponse_ListRoles_unauthorized = `<ErrorResponse xmlns="https://iam.am
   </Error>
   <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
 </ErrorResponse>`
+
+const stsResponse_AssumeRole_chained = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::222222222222:assumed-role/workload/workload</Arn>
+      <AssumedRoleId>ARO456EXAMPLE456:workload</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>chainedkey</AccessKeyId>
+      <SecretAccessKey>chainedsecret</SecretAccessKey>
+      <SessionToken>chainedtoken</SessionToken>
+      <Expiration>2099-12-31T23:59:59Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
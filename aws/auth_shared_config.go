@@ -0,0 +1,386 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/go-ini/ini"
+	"github.com/hashicorp/go-cleanhttp"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// SharedConfigProviderName is the provider name reported for credentials
+	// resolved from shared config profiles.
+	SharedConfigProviderName = "SharedConfigProvider"
+
+	// CredentialProcessProviderName is the provider name reported for
+	// credentials returned by a credential_process command.
+	CredentialProcessProviderName = "CredentialProcessProvider"
+
+	// mfaTokenEnvVar supplies the MFA code for profiles that set mfa_serial.
+	mfaTokenEnvVar = "AWS_MFA_TOKEN"
+
+	// credentialProcessTimeout bounds how long a credential_process command
+	// may run before it is killed.
+	credentialProcessTimeout = 1 * time.Minute
+)
+
+// sharedConfigProvider retrieves credentials for shared config profiles that
+// the SDK shared credentials provider does not handle: profiles that run an
+// external credential_process, and profiles that assume a role with the
+// credentials of a source_profile, optionally requiring an MFA token.
+type sharedConfigProvider struct {
+	// Filenames are the shared credentials and config files, in order of
+	// precedence.
+	Filenames []string
+	Profile   string
+
+	Region      string
+	StsEndpoint string
+	MaxRetries  int
+
+	provider awsCredentials.Provider
+	chain    string
+}
+
+// Retrieve resolves the profile chain on first use and returns credentials
+// from its final link.
+func (p *sharedConfigProvider) Retrieve() (awsCredentials.Value, error) {
+	if p.provider == nil {
+		files, err := loadSharedConfigFiles(p.Filenames)
+		if err != nil {
+			return awsCredentials.Value{ProviderName: SharedConfigProviderName}, err
+		}
+
+		// Profiles with only static keys are left to the shared credentials
+		// provider.
+		section, ok := sharedConfigProfile(files, p.Profile)
+		if !ok || (section["role_arn"] == "" && section["credential_process"] == "") {
+			return awsCredentials.Value{ProviderName: SharedConfigProviderName}, awserr.New("SharedConfigProfileNotChained",
+				fmt.Sprintf("shared config profile %q does not use role_arn or credential_process", p.Profile), nil)
+		}
+
+		provider, chain, err := p.resolve(files, p.Profile, map[string]bool{})
+		if err != nil {
+			return awsCredentials.Value{ProviderName: SharedConfigProviderName}, err
+		}
+		p.provider, p.chain = provider, chain
+	}
+
+	v, err := p.provider.Retrieve()
+	if err != nil {
+		log.Printf("[WARN] Error retrieving credentials for shared config %s: %s", p.chain, err)
+		return awsCredentials.Value{ProviderName: SharedConfigProviderName},
+			fmt.Errorf("error retrieving credentials for shared config %s: %s", p.chain, err)
+	}
+
+	log.Printf("[INFO] AWS credentials supplied by shared config %s", p.chain)
+	v.ProviderName = SharedConfigProviderName
+	return v, nil
+}
+
+// IsExpired returns true if the credentials of the final link have expired.
+func (p *sharedConfigProvider) IsExpired() bool {
+	return p.provider == nil || p.provider.IsExpired()
+}
+
+// resolve returns the provider for a profile along with a description of each
+// link of the chain, e.g.
+//
+//	profile "deploy" (role_arn arn:aws:iam::123456789012:role/deploy) -> profile "sso" (credential_process)
+func (p *sharedConfigProvider) resolve(files []*ini.File, profile string, visited map[string]bool) (awsCredentials.Provider, string, error) {
+	if visited[profile] {
+		return nil, "", fmt.Errorf("shared config profile %q is part of a source_profile cycle", profile)
+	}
+	visited[profile] = true
+
+	section, ok := sharedConfigProfile(files, profile)
+	if !ok {
+		return nil, "", awserr.New("SharedConfigProfileNotExists",
+			fmt.Sprintf("shared config profile %q does not exist", profile), nil)
+	}
+	link := fmt.Sprintf("profile %q", profile)
+
+	if roleARN := section["role_arn"]; roleARN != "" {
+		source := section["source_profile"]
+		if source == "" {
+			return nil, "", fmt.Errorf("shared config %s sets role_arn without source_profile", link)
+		}
+
+		var sourceProvider awsCredentials.Provider
+		var sourceChain string
+		if source == profile {
+			// A profile may assume a role with its own static keys.
+			v, ok := sharedConfigStaticCredentials(section)
+			if !ok {
+				return nil, "", fmt.Errorf("shared config %s uses itself as source_profile but has no static keys", link)
+			}
+			sourceProvider = &awsCredentials.StaticProvider{Value: v}
+			sourceChain = link + " (static keys)"
+		} else {
+			var err error
+			sourceProvider, sourceChain, err = p.resolve(files, source, visited)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+
+		provider, err := p.assumeRoleProvider(profile, section, sourceProvider)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return provider, fmt.Sprintf("%s (role_arn %s) -> %s", link, roleARN, sourceChain), nil
+	}
+
+	if command := section["credential_process"]; command != "" {
+		return &credentialProcessProvider{Command: command}, link + " (credential_process)", nil
+	}
+
+	if v, ok := sharedConfigStaticCredentials(section); ok {
+		return &awsCredentials.StaticProvider{Value: v}, link + " (static keys)", nil
+	}
+
+	return nil, "", awserr.New("SharedConfigProfileNoCredentials",
+		fmt.Sprintf("shared config %s has no static keys, credential_process or role_arn", link), nil)
+}
+
+// assumeRoleProvider returns a provider that assumes the role of a profile
+// using the credentials of its source profile.
+func (p *sharedConfigProvider) assumeRoleProvider(profile string, section map[string]string, source awsCredentials.Provider) (*stscreds.AssumeRoleProvider, error) {
+	stsconn := sts.New(session.New(&aws.Config{
+		Credentials: awsCredentials.NewCredentials(source),
+		Region:      aws.String(p.Region),
+		Endpoint:    aws.String(p.StsEndpoint),
+		MaxRetries:  aws.Int(p.MaxRetries),
+		HTTPClient:  cleanhttp.DefaultClient(),
+	}))
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:   stsconn,
+		RoleARN:  section["role_arn"],
+		Duration: stscreds.DefaultDuration,
+	}
+	if v := section["role_session_name"]; v != "" {
+		provider.RoleSessionName = v
+	}
+	if v := section["external_id"]; v != "" {
+		provider.ExternalID = aws.String(v)
+	}
+	if v := section["duration_seconds"]; v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("shared config profile %q has invalid duration_seconds %q: %s", profile, v, err)
+		}
+		provider.Duration = time.Duration(seconds) * time.Second
+	}
+	if v := section["mfa_serial"]; v != "" {
+		provider.SerialNumber = aws.String(v)
+		provider.TokenProvider = mfaTokenFromEnv(profile, v)
+	}
+
+	return provider, nil
+}
+
+// mfaTokenFromEnv returns a token provider that reads the MFA code from the
+// environment, as the provider cannot prompt for it.
+func mfaTokenFromEnv(profile, serial string) func() (string, error) {
+	return func() (string, error) {
+		if v := os.Getenv(mfaTokenEnvVar); v != "" {
+			return v, nil
+		}
+		return "", fmt.Errorf("shared config profile %q requires an MFA token for %s, set the %s environment variable",
+			profile, serial, mfaTokenEnvVar)
+	}
+}
+
+// sharedConfigProfileName returns the configured profile, falling back to
+// AWS_PROFILE and then the default profile.
+func sharedConfigProfileName(profile string) string {
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	if profile == "" {
+		profile = "default"
+	}
+	return profile
+}
+
+// sharedConfigFilenames returns the shared credentials and config files,
+// honouring the same environment variables as the AWS CLI.
+func sharedConfigFilenames(credsFilename string) []string {
+	if credsFilename == "" {
+		credsFilename = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
+	configFilename := os.Getenv("AWS_CONFIG_FILE")
+
+	if credsFilename == "" || configFilename == "" {
+		home, err := homedir.Dir()
+		if err != nil {
+			log.Printf("[WARN] Unable to determine home directory for shared config files: %s", err)
+		} else {
+			if credsFilename == "" {
+				credsFilename = filepath.Join(home, ".aws", "credentials")
+			}
+			if configFilename == "" {
+				configFilename = filepath.Join(home, ".aws", "config")
+			}
+		}
+	}
+
+	var filenames []string
+	for _, f := range []string{credsFilename, configFilename} {
+		if f != "" {
+			filenames = append(filenames, f)
+		}
+	}
+	return filenames
+}
+
+// loadSharedConfigFiles parses the shared config files that exist.
+func loadSharedConfigFiles(filenames []string) ([]*ini.File, error) {
+	var files []*ini.File
+	for _, filename := range filenames {
+		b, err := ioutil.ReadFile(filename)
+		if err != nil {
+			continue
+		}
+
+		f, err := ini.Load(b)
+		if err != nil {
+			return nil, fmt.Errorf("error loading shared config file %s: %s", filename, err)
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// sharedConfigProfile merges the keys of a profile across the shared config
+// files. Files earlier in the list take precedence.
+func sharedConfigProfile(files []*ini.File, profile string) (map[string]string, bool) {
+	m := make(map[string]string)
+	found := false
+
+	for i := len(files) - 1; i >= 0; i-- {
+		for _, name := range []string{profile, "profile " + profile} {
+			section, err := files[i].GetSection(name)
+			if err != nil {
+				continue
+			}
+			found = true
+			for _, key := range section.Keys() {
+				if v := key.String(); v != "" {
+					m[key.Name()] = v
+				}
+			}
+		}
+	}
+
+	return m, found
+}
+
+func sharedConfigStaticCredentials(section map[string]string) (awsCredentials.Value, bool) {
+	v := awsCredentials.Value{
+		AccessKeyID:     section["aws_access_key_id"],
+		SecretAccessKey: section["aws_secret_access_key"],
+		SessionToken:    section["aws_session_token"],
+		ProviderName:    SharedConfigProviderName,
+	}
+	return v, v.AccessKeyID != "" && v.SecretAccessKey != ""
+}
+
+// credentialProcessProvider retrieves credentials from an external command, as
+// configured by the credential_process shared config setting.
+type credentialProcessProvider struct {
+	awsCredentials.Expiry
+
+	Command string
+
+	retrieved bool
+	expires   bool
+}
+
+// credentialProcessOutput is the JSON document a credential_process command
+// writes to stdout.
+type credentialProcessOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+// IsExpired returns true until credentials have been retrieved, and after that
+// only once the returned Expiration has passed.
+func (p *credentialProcessProvider) IsExpired() bool {
+	if !p.retrieved {
+		return true
+	}
+	return p.expires && p.Expiry.IsExpired()
+}
+
+// Retrieve runs the command and parses the credentials it returns.
+func (p *credentialProcessProvider) Retrieve() (awsCredentials.Value, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.Command)
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			err = fmt.Errorf("%s: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return awsCredentials.Value{ProviderName: CredentialProcessProviderName},
+			fmt.Errorf("error running credential_process: %s", err)
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(out, &output); err != nil {
+		return awsCredentials.Value{ProviderName: CredentialProcessProviderName},
+			fmt.Errorf("error parsing credential_process output: %s", err)
+	}
+	if output.Version != 1 {
+		return awsCredentials.Value{ProviderName: CredentialProcessProviderName},
+			fmt.Errorf("unsupported credential_process output version %d, expected 1", output.Version)
+	}
+	if output.AccessKeyId == "" || output.SecretAccessKey == "" {
+		return awsCredentials.Value{ProviderName: CredentialProcessProviderName},
+			fmt.Errorf("credential_process output is missing AccessKeyId or SecretAccessKey")
+	}
+
+	p.retrieved = true
+	p.expires = output.Expiration != nil
+	if p.expires {
+		p.SetExpiration(*output.Expiration, 0)
+	}
+
+	return awsCredentials.Value{
+		AccessKeyID:     output.AccessKeyId,
+		SecretAccessKey: output.SecretAccessKey,
+		SessionToken:    output.SessionToken,
+		ProviderName:    CredentialProcessProviderName,
+	}, nil
+}
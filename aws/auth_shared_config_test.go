@@ -0,0 +1,319 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestAWSGetCredentials_shouldBeCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test command requires a POSIX shell")
+	}
+
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	configFile, removeConfigFile := writeSharedConfigFile(t, `[profile process]
+credential_process = echo '{"Version": 1, "AccessKeyId": "processkey", "SecretAccessKey": "processsecret", "SessionToken": "processtoken"}'
+`)
+	defer removeConfigFile()
+	defer setTestEnv(t, "AWS_CONFIG_FILE", configFile)()
+
+	creds, err := GetCredentials(&Config{Profile: "process", SkipMetadataApiCheck: true})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.ProviderName != SharedConfigProviderName {
+		t.Fatalf("Expected provider name to be %q, %q given", SharedConfigProviderName, v.ProviderName)
+	}
+	if v.AccessKeyID != "processkey" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "processkey", v.AccessKeyID)
+	}
+	if v.SecretAccessKey != "processsecret" {
+		t.Fatalf("SecretAccessKey mismatch, expected (%s), got (%s)", "processsecret", v.SecretAccessKey)
+	}
+	if v.SessionToken != "processtoken" {
+		t.Fatalf("SessionToken mismatch, expected (%s), got (%s)", "processtoken", v.SessionToken)
+	}
+}
+
+func TestAWSGetCredentials_shouldAssumeRoleFromSourceProfile(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Fdeploy&RoleSessionName=terraform&SerialNumber=arn%3Aaws%3Aiam%3A%3A123456789012%3Amfa%2Fuser&TokenCode=123456&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_AssumeRole_valid, "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configFile, removeConfigFile := writeSharedConfigFile(t, `[profile base]
+aws_access_key_id = basekey
+aws_secret_access_key = basesecret
+
+[profile deploy]
+role_arn = arn:aws:iam::123456789012:role/deploy
+source_profile = base
+mfa_serial = arn:aws:iam::123456789012:mfa/user
+role_session_name = terraform
+`)
+	defer removeConfigFile()
+	defer setTestEnv(t, "AWS_CONFIG_FILE", configFile)()
+	defer setTestEnv(t, mfaTokenEnvVar, "123456")()
+
+	creds, err := GetCredentials(&Config{
+		Profile:              "deploy",
+		Region:               "us-east-1",
+		Endpoints:            map[string]string{"sts": *stsSess.Config.Endpoint},
+		SkipMetadataApiCheck: true,
+	})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.ProviderName != SharedConfigProviderName {
+		t.Fatalf("Expected provider name to be %q, %q given", SharedConfigProviderName, v.ProviderName)
+	}
+	if v.AccessKeyID != "assumedkey" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "assumedkey", v.AccessKeyID)
+	}
+	if v.SessionToken != "assumedtoken" {
+		t.Fatalf("SessionToken mismatch, expected (%s), got (%s)", "assumedtoken", v.SessionToken)
+	}
+}
+
+func TestSharedConfigProvider_chain(t *testing.T) {
+	configFile, removeConfigFile := writeSharedConfigFile(t, `[profile sso]
+credential_process = /usr/local/bin/credential-helper
+
+[profile deploy]
+role_arn = arn:aws:iam::123456789012:role/deploy
+source_profile = sso
+
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = deploy
+`)
+	defer removeConfigFile()
+
+	files, err := loadSharedConfigFiles([]string{configFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &sharedConfigProvider{Region: "us-east-1"}
+	_, chain, err := p.resolve(files, "admin", map[string]bool{})
+	if err != nil {
+		t.Fatalf("Error resolving profile: %s", err)
+	}
+
+	expected := `profile "admin" (role_arn arn:aws:iam::123456789012:role/admin) -> ` +
+		`profile "deploy" (role_arn arn:aws:iam::123456789012:role/deploy) -> ` +
+		`profile "sso" (credential_process)`
+	if chain != expected {
+		t.Fatalf("Expected chain:\n%s\ngot:\n%s", expected, chain)
+	}
+}
+
+func TestSharedConfigProvider_errors(t *testing.T) {
+	configFile, removeConfigFile := writeSharedConfigFile(t, `[profile a]
+role_arn = arn:aws:iam::123456789012:role/a
+source_profile = b
+
+[profile b]
+role_arn = arn:aws:iam::123456789012:role/b
+source_profile = a
+
+[profile norole]
+role_arn = arn:aws:iam::123456789012:role/norole
+
+[profile empty]
+region = us-east-1
+
+[profile badduration]
+role_arn = arn:aws:iam::123456789012:role/badduration
+source_profile = badduration
+aws_access_key_id = key
+aws_secret_access_key = secret
+duration_seconds = forever
+`)
+	defer removeConfigFile()
+
+	files, err := loadSharedConfigFiles([]string{configFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		Profile string
+		Error   string
+	}{
+		{"a", "source_profile cycle"},
+		{"norole", "without source_profile"},
+		{"empty", "has no static keys, credential_process or role_arn"},
+		{"missing", "does not exist"},
+		{"badduration", "invalid duration_seconds"},
+	}
+
+	for _, tc := range cases {
+		p := &sharedConfigProvider{Region: "us-east-1"}
+		_, _, err := p.resolve(files, tc.Profile, map[string]bool{})
+		if err == nil {
+			t.Fatalf("%s: expected error", tc.Profile)
+		}
+		if !strings.Contains(err.Error(), tc.Error) {
+			t.Fatalf("%s: expected error to contain %q, got: %s", tc.Profile, tc.Error, err)
+		}
+	}
+}
+
+func TestSharedConfigProvider_staticProfileNotChained(t *testing.T) {
+	configFile, removeConfigFile := writeSharedConfigFile(t, credentialsFileContents)
+	defer removeConfigFile()
+
+	p := &sharedConfigProvider{Filenames: []string{configFile}, Profile: "myprofile"}
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatal("Expected static keys profile to be left to the shared credentials provider")
+	}
+}
+
+func TestMfaTokenFromEnv(t *testing.T) {
+	defer setTestEnv(t, mfaTokenEnvVar, "")()
+
+	tokenProvider := mfaTokenFromEnv("deploy", "arn:aws:iam::123456789012:mfa/user")
+	if _, err := tokenProvider(); err == nil || !strings.Contains(err.Error(), mfaTokenEnvVar) {
+		t.Fatalf("Expected error naming %s, got: %v", mfaTokenEnvVar, err)
+	}
+
+	os.Setenv(mfaTokenEnvVar, "654321")
+	token, err := tokenProvider()
+	if err != nil {
+		t.Fatalf("Error getting MFA token: %s", err)
+	}
+	if token != "654321" {
+		t.Fatalf("Expected MFA token %q, got %q", "654321", token)
+	}
+}
+
+func TestCredentialProcessProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test commands require a POSIX shell")
+	}
+
+	p := &credentialProcessProvider{
+		Command: `echo '{"Version": 1, "AccessKeyId": "key", "SecretAccessKey": "secret", "Expiration": "2000-01-01T00:00:00Z"}'`,
+	}
+	if !p.IsExpired() {
+		t.Fatal("Expected credentials to be expired before retrieval")
+	}
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("Error running credential_process: %s", err)
+	}
+	if v.AccessKeyID != "key" || v.SecretAccessKey != "secret" {
+		t.Fatalf("Unexpected credentials: %#v", v)
+	}
+	if !p.IsExpired() {
+		t.Fatal("Expected credentials with a past Expiration to be expired")
+	}
+
+	p = &credentialProcessProvider{
+		Command: `echo '{"Version": 1, "AccessKeyId": "key", "SecretAccessKey": "secret"}'`,
+	}
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("Error running credential_process: %s", err)
+	}
+	if p.IsExpired() {
+		t.Fatal("Expected credentials without Expiration not to expire")
+	}
+
+	cases := []struct {
+		Command string
+		Error   string
+	}{
+		{`echo 'helper failed' >&2; exit 1`, "helper failed"},
+		{`echo 'not json'`, "error parsing credential_process output"},
+		{`echo '{"Version": 2, "AccessKeyId": "key", "SecretAccessKey": "secret"}'`, "unsupported credential_process output version 2"},
+		{`echo '{"Version": 1}'`, "missing AccessKeyId or SecretAccessKey"},
+	}
+
+	for _, tc := range cases {
+		p := &credentialProcessProvider{Command: tc.Command}
+		_, err := p.Retrieve()
+		if err == nil {
+			t.Fatalf("%s: expected error", tc.Command)
+		}
+		if !strings.Contains(err.Error(), tc.Error) {
+			t.Fatalf("%s: expected error to contain %q, got: %s", tc.Command, tc.Error, err)
+		}
+	}
+}
+
+// writeSharedConfigFile writes a temporary shared config file and returns its
+// name along with a function removing it
+func writeSharedConfigFile(t *testing.T, contents string) (string, func()) {
+	file, err := ioutil.TempFile(os.TempDir(), "terraform_aws_config")
+	if err != nil {
+		t.Fatalf("Error writing temporary config file: %s", err)
+	}
+	if _, err := file.WriteString(contents); err != nil {
+		t.Fatalf("Error writing temporary config to file: %s", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("Error closing temporary config file: %s", err)
+	}
+
+	return file.Name(), func() { os.Remove(file.Name()) }
+}
+
+// setTestEnv sets an environment variable and returns a function restoring
+// its previous value
+func setTestEnv(t *testing.T, key, value string) func() {
+	old, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatalf("Error setting env var %s: %s", key, err)
+	}
+
+	return func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+const stsResponse_AssumeRole_valid = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/deploy/terraform</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:terraform</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>assumedkey</AccessKeyId>
+      <SecretAccessKey>assumedsecret</SecretAccessKey>
+      <SessionToken>assumedtoken</SessionToken>
+      <Expiration>2099-12-31T23:59:59Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
@@ -292,6 +292,19 @@ func autoscalingTagDescriptionsToSlice(ts []*autoscaling.TagDescription) []map[s
 	return tags
 }
 
+// autoscalingTagDescriptionsIgnoreConfig returns the tags without those
+// matching the provider ignore_tags configuration.
+func autoscalingTagDescriptionsIgnoreConfig(ts []*autoscaling.TagDescription, config *ignoreTagsConfig) []*autoscaling.TagDescription {
+	result := make([]*autoscaling.TagDescription, 0, len(ts))
+	for _, t := range ts {
+		if !config.ignored(aws.StringValue(t.Key)) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
 func setToMapByKey(s *schema.Set, key string) map[string]interface{} {
 	result := make(map[string]interface{})
 	for _, rawData := range s.List() {
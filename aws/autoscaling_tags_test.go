@@ -156,3 +156,42 @@ func TestIgnoringTagsAutoscaling(t *testing.T) {
 		}
 	}
 }
+
+func TestAutoscalingTagDescriptionsIgnoreConfig(t *testing.T) {
+	tags := []*autoscaling.TagDescription{
+		{
+			Key:               aws.String("Name"),
+			Value:             aws.String("foo"),
+			PropagateAtLaunch: aws.Bool(true),
+		},
+		{
+			Key:               aws.String("LastScanned"),
+			Value:             aws.String("2018-01-01"),
+			PropagateAtLaunch: aws.Bool(false),
+		},
+		{
+			Key:               aws.String("kubernetes.io/cluster/test"),
+			Value:             aws.String("owned"),
+			PropagateAtLaunch: aws.Bool(true),
+		},
+	}
+
+	config := &ignoreTagsConfig{
+		Keys:        []string{"LastScanned"},
+		KeyPrefixes: []string{"kubernetes.io/"},
+	}
+	expected := []map[string]interface{}{
+		{
+			"key":                 "Name",
+			"value":               "foo",
+			"propagate_at_launch": true,
+		},
+	}
+	if actual := autoscalingTagDescriptionsToSlice(autoscalingTagDescriptionsIgnoreConfig(tags, config)); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	if actual := autoscalingTagDescriptionsIgnoreConfig(tags, nil); len(actual) != len(tags) {
+		t.Fatalf("expected no tags to be ignored without a configuration, got %d of %d", len(actual), len(tags))
+	}
+}
@@ -8,10 +8,12 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -22,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
@@ -73,6 +76,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/ses"
@@ -100,37 +104,28 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AssumeRoleARN             string
+	AssumeRoleExternalID      string
+	AssumeRoleSessionName     string
+	AssumeRolePolicy          string
+	AssumeRolePolicyARNs      []string
+	AssumeRoleDurationSeconds int
+
+	// AssumeRoleChain holds the roles assumed in order after AssumeRoleARN,
+	// each with the credentials of the previous role.
+	AssumeRoleChain []*AssumeRole
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	AcmEndpoint              string
-	ApigatewayEndpoint       string
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	EcsEndpoint              string
-	EcrEndpoint              string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	LambdaEndpoint           string
-	RdsEndpoint              string
-	R53Endpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	StsEndpoint              string
-	Insecure                 bool
+	DefaultTags map[string]interface{}
+	IgnoreTags  *ignoreTagsConfig
+	Retry       *retryConfig
+
+	Insecure bool
+
+	// Endpoints holds endpoint overrides keyed by service name.
+	Endpoints map[string]string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -140,6 +135,48 @@ type Config struct {
 	S3ForcePathStyle        bool
 }
 
+// AssumeRole holds the settings for one role of an assume role chain.
+type AssumeRole struct {
+	RoleARN         string
+	ExternalID      string
+	SessionName     string
+	Policy          string
+	PolicyARNs      []string
+	DurationSeconds int
+}
+
+// assumeRoleChainedMaxDurationSeconds is the longest session STS allows for a
+// role assumed with the credentials of another role.
+const assumeRoleChainedMaxDurationSeconds = 3600
+
+// assumeRoles returns every role to assume, in order.
+func (c *Config) assumeRoles() ([]*AssumeRole, error) {
+	if c.AssumeRoleARN == "" {
+		return nil, nil
+	}
+
+	roles := []*AssumeRole{{
+		RoleARN:         c.AssumeRoleARN,
+		ExternalID:      c.AssumeRoleExternalID,
+		SessionName:     c.AssumeRoleSessionName,
+		Policy:          c.AssumeRolePolicy,
+		PolicyARNs:      c.AssumeRolePolicyARNs,
+		DurationSeconds: c.AssumeRoleDurationSeconds,
+	}}
+	roles = append(roles, c.AssumeRoleChain...)
+
+	// Every role after the first is assumed with the credentials of the
+	// previous one.
+	for i, role := range roles[1:] {
+		if role.DurationSeconds > assumeRoleChainedMaxDurationSeconds {
+			return nil, fmt.Errorf("assume_role %d (%s): duration_seconds of a chained role must be at most %d, got %d",
+				i+2, role.RoleARN, assumeRoleChainedMaxDurationSeconds, role.DurationSeconds)
+		}
+	}
+
+	return roles, nil
+}
+
 type AWSClient struct {
 	cfconn                *cloudformation.CloudFormation
 	cloud9conn            *cloud9.Cloud9
@@ -168,7 +205,9 @@ type AWSClient struct {
 	apigateway            *apigateway.APIGateway
 	appautoscalingconn    *applicationautoscaling.ApplicationAutoScaling
 	autoscalingconn       *autoscaling.AutoScaling
+	budgetconn            *budgets.Budgets
 	s3conn                *s3.S3
+	sagemakerconn         *sagemaker.SageMaker
 	scconn                *servicecatalog.ServiceCatalog
 	sesConn               *ses.SES
 	simpledbconn          *simpledb.SimpleDB
@@ -181,6 +220,8 @@ type AWSClient struct {
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	defaulttags           map[string]interface{}
+	ignoretags            *ignoreTagsConfig
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
@@ -214,6 +255,19 @@ type AWSClient struct {
 	dxconn                *directconnect.DirectConnect
 	mediastoreconn        *mediastore.MediaStore
 	appsyncconn           *appsync.AppSync
+
+	// config and sess are kept to create the clients of other regions, which
+	// are shared by every AWSClient of the provider through regionalclients.
+	config          *Config
+	sess            *session.Session
+	regionalclients *awsRegionalClients
+}
+
+// awsRegionalClients caches the AWSClient of each region used by resources
+// that override the provider region.
+type awsRegionalClients struct {
+	sync.Mutex
+	clients map[string]*AWSClient
 }
 
 func (c *AWSClient) S3() *s3.S3 {
@@ -234,6 +288,439 @@ func (c *AWSClient) IsChinaCloud() bool {
 	return isChinaCloud
 }
 
+// regionalClient returns the AWSClient to use for the given region, creating
+// and caching it on first use. The client shares the session, credentials and
+// account details of the provider, so credential validation and account ID
+// lookups are not repeated for each region.
+func (c *AWSClient) regionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == c.region {
+		return c, nil
+	}
+	if c.regionalclients == nil {
+		return nil, fmt.Errorf("region %q cannot be used: the provider is not configured", region)
+	}
+
+	c.regionalclients.Lock()
+	defer c.regionalclients.Unlock()
+
+	if client, ok := c.regionalclients.clients[region]; ok {
+		return client, nil
+	}
+
+	if !c.config.SkipRegionValidation && !isValidRegion(region) {
+		return nil, fmt.Errorf("Not a valid region: %s", region)
+	}
+
+	log.Printf("[INFO] Initializing AWS client for region %s", region)
+	client := &AWSClient{
+		region:      region,
+		accountid:   c.accountid,
+		defaulttags: c.defaulttags,
+		ignoretags:  c.ignoretags,
+		// EC2-Classic support is not looked up again; the platforms of the
+		// provider region are assumed.
+		supportedplatforms: c.supportedplatforms,
+		config:             c.config,
+		sess:               c.sess.Copy(&aws.Config{Region: aws.String(region)}),
+		regionalclients:    c.regionalclients,
+	}
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		client.partition = partition.ID()
+	}
+
+	if err := c.config.configureServiceClients(client, client.sess); err != nil {
+		return nil, err
+	}
+
+	c.regionalclients.clients[region] = client
+	return client, nil
+}
+
+// awsServiceClients creates the service connections of AWSClient. Each
+// service is keyed by its name in the provider endpoints and retry blocks, and
+// New returns the underlying SDK client so retry behaviour can be applied.
+var awsServiceClients = []struct {
+	Name string
+	New  func(c *AWSClient, s *session.Session) *client.Client
+}{
+	{"acm", func(c *AWSClient, s *session.Session) *client.Client {
+		c.acmconn = acm.New(s)
+		return c.acmconn.Client
+	}},
+	{"apigateway", func(c *AWSClient, s *session.Session) *client.Client {
+		c.apigateway = apigateway.New(s)
+		return c.apigateway.Client
+	}},
+	{"applicationautoscaling", func(c *AWSClient, s *session.Session) *client.Client {
+		c.appautoscalingconn = applicationautoscaling.New(s)
+		return c.appautoscalingconn.Client
+	}},
+	{"appsync", func(c *AWSClient, s *session.Session) *client.Client {
+		c.appsyncconn = appsync.New(s)
+		return c.appsyncconn.Client
+	}},
+	{"athena", func(c *AWSClient, s *session.Session) *client.Client {
+		c.athenaconn = athena.New(s)
+		return c.athenaconn.Client
+	}},
+	{"autoscaling", func(c *AWSClient, s *session.Session) *client.Client {
+		c.autoscalingconn = autoscaling.New(s)
+		return c.autoscalingconn.Client
+	}},
+	{"batch", func(c *AWSClient, s *session.Session) *client.Client {
+		c.batchconn = batch.New(s)
+		return c.batchconn.Client
+	}},
+	{"budgets", func(c *AWSClient, s *session.Session) *client.Client {
+		c.budgetconn = budgets.New(s)
+		return c.budgetconn.Client
+	}},
+	{"cloud9", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cloud9conn = cloud9.New(s)
+		return c.cloud9conn.Client
+	}},
+	{"cloudformation", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cfconn = cloudformation.New(s)
+		return c.cfconn.Client
+	}},
+	{"cloudfront", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cloudfrontconn = cloudfront.New(s)
+		return c.cloudfrontconn.Client
+	}},
+	{"cloudtrail", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cloudtrailconn = cloudtrail.New(s)
+		return c.cloudtrailconn.Client
+	}},
+	{"cloudwatch", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cloudwatchconn = cloudwatch.New(s)
+		return c.cloudwatchconn.Client
+	}},
+	{"cloudwatchevents", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cloudwatcheventsconn = cloudwatchevents.New(s)
+		return c.cloudwatcheventsconn.Client
+	}},
+	{"cloudwatchlogs", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cloudwatchlogsconn = cloudwatchlogs.New(s)
+		return c.cloudwatchlogsconn.Client
+	}},
+	{"codebuild", func(c *AWSClient, s *session.Session) *client.Client {
+		c.codebuildconn = codebuild.New(s)
+		return c.codebuildconn.Client
+	}},
+	{"codecommit", func(c *AWSClient, s *session.Session) *client.Client {
+		c.codecommitconn = codecommit.New(s)
+		return c.codecommitconn.Client
+	}},
+	{"codedeploy", func(c *AWSClient, s *session.Session) *client.Client {
+		c.codedeployconn = codedeploy.New(s)
+		return c.codedeployconn.Client
+	}},
+	{"codepipeline", func(c *AWSClient, s *session.Session) *client.Client {
+		c.codepipelineconn = codepipeline.New(s)
+		return c.codepipelineconn.Client
+	}},
+	{"cognitoidentity", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cognitoconn = cognitoidentity.New(s)
+		return c.cognitoconn.Client
+	}},
+	{"cognitoidp", func(c *AWSClient, s *session.Session) *client.Client {
+		c.cognitoidpconn = cognitoidentityprovider.New(s)
+		return c.cognitoidpconn.Client
+	}},
+	{"configservice", func(c *AWSClient, s *session.Session) *client.Client {
+		c.configconn = configservice.New(s)
+		return c.configconn.Client
+	}},
+	{"dax", func(c *AWSClient, s *session.Session) *client.Client {
+		c.daxconn = dax.New(s)
+		return c.daxconn.Client
+	}},
+	{"devicefarm", func(c *AWSClient, s *session.Session) *client.Client {
+		c.devicefarmconn = devicefarm.New(s)
+		return c.devicefarmconn.Client
+	}},
+	{"directconnect", func(c *AWSClient, s *session.Session) *client.Client {
+		c.dxconn = directconnect.New(s)
+		return c.dxconn.Client
+	}},
+	{"directoryservice", func(c *AWSClient, s *session.Session) *client.Client {
+		c.dsconn = directoryservice.New(s)
+		return c.dsconn.Client
+	}},
+	{"dms", func(c *AWSClient, s *session.Session) *client.Client {
+		c.dmsconn = databasemigrationservice.New(s)
+		return c.dmsconn.Client
+	}},
+	{"dynamodb", func(c *AWSClient, s *session.Session) *client.Client {
+		c.dynamodbconn = dynamodb.New(s)
+		return c.dynamodbconn.Client
+	}},
+	{"ec2", func(c *AWSClient, s *session.Session) *client.Client {
+		c.ec2conn = ec2.New(s)
+		return c.ec2conn.Client
+	}},
+	{"ecr", func(c *AWSClient, s *session.Session) *client.Client {
+		c.ecrconn = ecr.New(s)
+		return c.ecrconn.Client
+	}},
+	{"ecs", func(c *AWSClient, s *session.Session) *client.Client {
+		c.ecsconn = ecs.New(s)
+		return c.ecsconn.Client
+	}},
+	{"efs", func(c *AWSClient, s *session.Session) *client.Client {
+		c.efsconn = efs.New(s)
+		return c.efsconn.Client
+	}},
+	{"elasticache", func(c *AWSClient, s *session.Session) *client.Client {
+		c.elasticacheconn = elasticache.New(s)
+		return c.elasticacheconn.Client
+	}},
+	{"elasticbeanstalk", func(c *AWSClient, s *session.Session) *client.Client {
+		c.elasticbeanstalkconn = elasticbeanstalk.New(s)
+		return c.elasticbeanstalkconn.Client
+	}},
+	{"elastictranscoder", func(c *AWSClient, s *session.Session) *client.Client {
+		c.elastictranscoderconn = elastictranscoder.New(s)
+		return c.elastictranscoderconn.Client
+	}},
+	{"elb", func(c *AWSClient, s *session.Session) *client.Client {
+		c.elbconn = elb.New(s)
+		return c.elbconn.Client
+	}},
+	{"elbv2", func(c *AWSClient, s *session.Session) *client.Client {
+		c.elbv2conn = elbv2.New(s)
+		return c.elbv2conn.Client
+	}},
+	{"emr", func(c *AWSClient, s *session.Session) *client.Client {
+		c.emrconn = emr.New(s)
+		return c.emrconn.Client
+	}},
+	{"es", func(c *AWSClient, s *session.Session) *client.Client {
+		c.esconn = elasticsearch.New(s)
+		return c.esconn.Client
+	}},
+	{"firehose", func(c *AWSClient, s *session.Session) *client.Client {
+		c.firehoseconn = firehose.New(s)
+		return c.firehoseconn.Client
+	}},
+	{"gamelift", func(c *AWSClient, s *session.Session) *client.Client {
+		c.gameliftconn = gamelift.New(s)
+		return c.gameliftconn.Client
+	}},
+	{"glacier", func(c *AWSClient, s *session.Session) *client.Client {
+		c.glacierconn = glacier.New(s)
+		return c.glacierconn.Client
+	}},
+	{"glue", func(c *AWSClient, s *session.Session) *client.Client {
+		c.glueconn = glue.New(s)
+		return c.glueconn.Client
+	}},
+	{"guardduty", func(c *AWSClient, s *session.Session) *client.Client {
+		c.guarddutyconn = guardduty.New(s)
+		return c.guarddutyconn.Client
+	}},
+	{"iam", func(c *AWSClient, s *session.Session) *client.Client {
+		c.iamconn = iam.New(s)
+		return c.iamconn.Client
+	}},
+	{"inspector", func(c *AWSClient, s *session.Session) *client.Client {
+		c.inspectorconn = inspector.New(s)
+		return c.inspectorconn.Client
+	}},
+	{"iot", func(c *AWSClient, s *session.Session) *client.Client {
+		c.iotconn = iot.New(s)
+		return c.iotconn.Client
+	}},
+	{"kinesis", func(c *AWSClient, s *session.Session) *client.Client {
+		c.kinesisconn = kinesis.New(s)
+		return c.kinesisconn.Client
+	}},
+	{"kms", func(c *AWSClient, s *session.Session) *client.Client {
+		c.kmsconn = kms.New(s)
+		return c.kmsconn.Client
+	}},
+	{"lambda", func(c *AWSClient, s *session.Session) *client.Client {
+		c.lambdaconn = lambda.New(s)
+		return c.lambdaconn.Client
+	}},
+	{"lightsail", func(c *AWSClient, s *session.Session) *client.Client {
+		c.lightsailconn = lightsail.New(s)
+		return c.lightsailconn.Client
+	}},
+	{"mediastore", func(c *AWSClient, s *session.Session) *client.Client {
+		c.mediastoreconn = mediastore.New(s)
+		return c.mediastoreconn.Client
+	}},
+	{"mq", func(c *AWSClient, s *session.Session) *client.Client {
+		c.mqconn = mq.New(s)
+		return c.mqconn.Client
+	}},
+	{"opsworks", func(c *AWSClient, s *session.Session) *client.Client {
+		c.opsworksconn = opsworks.New(s)
+		return c.opsworksconn.Client
+	}},
+	{"organizations", func(c *AWSClient, s *session.Session) *client.Client {
+		c.organizationsconn = organizations.New(s)
+		return c.organizationsconn.Client
+	}},
+	// Route 53 is a global service. The region is only restricted for its own
+	// session: other resources that have restrictions should allow the API to
+	// fail, rather than Terraform abstracting the region for the user.
+	{"r53", func(c *AWSClient, s *session.Session) *client.Client {
+		c.r53conn = route53.New(s.Copy(&aws.Config{Region: aws.String("us-east-1")}))
+		return c.r53conn.Client
+	}},
+	{"rds", func(c *AWSClient, s *session.Session) *client.Client {
+		c.rdsconn = rds.New(s)
+		return c.rdsconn.Client
+	}},
+	{"redshift", func(c *AWSClient, s *session.Session) *client.Client {
+		c.redshiftconn = redshift.New(s)
+		return c.redshiftconn.Client
+	}},
+	{"s3", func(c *AWSClient, s *session.Session) *client.Client {
+		c.s3conn = s3.New(s)
+		return c.s3conn.Client
+	}},
+	{"sagemaker", func(c *AWSClient, s *session.Session) *client.Client {
+		c.sagemakerconn = sagemaker.New(s)
+		return c.sagemakerconn.Client
+	}},
+	{"servicecatalog", func(c *AWSClient, s *session.Session) *client.Client {
+		c.scconn = servicecatalog.New(s)
+		return c.scconn.Client
+	}},
+	{"servicediscovery", func(c *AWSClient, s *session.Session) *client.Client {
+		c.sdconn = servicediscovery.New(s)
+		return c.sdconn.Client
+	}},
+	{"ses", func(c *AWSClient, s *session.Session) *client.Client {
+		c.sesConn = ses.New(s)
+		return c.sesConn.Client
+	}},
+	{"sfn", func(c *AWSClient, s *session.Session) *client.Client {
+		c.sfnconn = sfn.New(s)
+		return c.sfnconn.Client
+	}},
+	{"simpledb", func(c *AWSClient, s *session.Session) *client.Client {
+		c.simpledbconn = simpledb.New(s)
+		return c.simpledbconn.Client
+	}},
+	{"sns", func(c *AWSClient, s *session.Session) *client.Client {
+		c.snsconn = sns.New(s)
+		return c.snsconn.Client
+	}},
+	{"sqs", func(c *AWSClient, s *session.Session) *client.Client {
+		c.sqsconn = sqs.New(s)
+		return c.sqsconn.Client
+	}},
+	{"ssm", func(c *AWSClient, s *session.Session) *client.Client {
+		c.ssmconn = ssm.New(s)
+		return c.ssmconn.Client
+	}},
+	{"sts", func(c *AWSClient, s *session.Session) *client.Client {
+		c.stsconn = sts.New(s)
+		return c.stsconn.Client
+	}},
+	{"waf", func(c *AWSClient, s *session.Session) *client.Client {
+		c.wafconn = waf.New(s)
+		return c.wafconn.Client
+	}},
+	{"wafregional", func(c *AWSClient, s *session.Session) *client.Client {
+		c.wafregionalconn = wafregional.New(s)
+		return c.wafregionalconn.Client
+	}},
+}
+
+// configureServiceClients creates every service connection from the base
+// session, applying any configured endpoint override and retry behaviour.
+func (c *Config) configureServiceClients(conn *AWSClient, sess *session.Session) error {
+	serviceClients := make(map[string]*client.Client, len(awsServiceClients))
+	for _, s := range awsServiceClients {
+		serviceSess := sess
+		if endpoint := c.endpoint(s.Name); endpoint != "" {
+			log.Printf("[INFO] Using custom %s endpoint: %s", s.Name, endpoint)
+			serviceSess = sess.Copy(&aws.Config{Endpoint: aws.String(endpoint)})
+		}
+		serviceClients[s.Name] = s.New(conn, serviceSess)
+	}
+
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
+	conn.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
+		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+			return
+		}
+		err, ok := r.Error.(awserr.Error)
+		if !ok || err == nil {
+			return
+		}
+		if err.Code() == kinesis.ErrCodeLimitExceededException {
+			r.Retryable = aws.Bool(true)
+		}
+	})
+
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	conn.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
+		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+			return
+		}
+		err, ok := r.Error.(awserr.Error)
+		if !ok || err == nil {
+			return
+		}
+		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
+			r.Retryable = aws.Bool(true)
+		}
+	})
+
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	conn.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+			return
+		}
+		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+			r.Retryable = aws.Bool(true)
+		}
+	})
+
+	conn.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.Operation.Name == "CreateStream" {
+			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	})
+
+	if c.Retry != nil {
+		return c.Retry.apply(serviceClients, c.MaxRetries)
+	}
+
+	return nil
+}
+
+// endpoint returns the endpoint override for a service.
+func (c *Config) endpoint(name string) string {
+	if v := c.Endpoints[name]; v != "" {
+		return v
+	}
+
+	switch name {
+	case "dax":
+		// DAX has always used the DynamoDB endpoint.
+		return c.endpoint("dynamodb")
+	case "elbv2":
+		// ELBv2 has always used the ELB endpoint.
+		return c.endpoint("elb")
+	}
+
+	return ""
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -252,6 +739,8 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.defaulttags = c.DefaultTags
+	client.ignoretags = c.IgnoreTags
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -329,41 +818,9 @@ func (c *Config) Client() (interface{}, error) {
 		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
 	}
 
-	// This restriction should only be used for Route53 sessions.
-	// Other resources that have restrictions should allow the API to fail, rather
-	// than Terraform abstracting the region for the user. This can lead to breaking
-	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.R53Endpoint)})
-
-	// Some services have user-configurable endpoints
-	awsAcmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AcmEndpoint)})
-	awsApigatewaySess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ApigatewayEndpoint)})
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsEcrSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcrEndpoint)})
-	awsEcsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcsEndpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsLambdaSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.LambdaEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsStsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.StsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-
-	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
-
-	// These two services need to be set up early so we can check on AccountID
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(awsStsSess)
+	if err := c.configureServiceClients(&client, sess); err != nil {
+		return nil, err
+	}
 
 	if !c.SkipCredsValidation {
 		err = c.ValidateCredentials(client.stsconn)
@@ -389,8 +846,6 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, authErr
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
-
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
 		if err != nil {
@@ -402,122 +857,11 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.acmconn = acm.New(awsAcmSess)
-	client.apigateway = apigateway.New(awsApigatewaySess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(sess)
-	client.cloud9conn = cloud9.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.cognitoidpconn = cognitoidentityprovider.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.daxconn = dax.New(awsDynamoSess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(awsEcrSess)
-	client.ecsconn = ecs.New(awsEcsSess)
-	client.efsconn = efs.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(sess)
-	client.firehoseconn = firehose.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.gameliftconn = gamelift.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.guarddutyconn = guardduty.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(awsLambdaSess)
-	client.lightsailconn = lightsail.New(sess)
-	client.mqconn = mq.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.organizationsconn = organizations.New(sess)
-	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sdconn = servicediscovery.New(sess)
-	client.sesConn = ses.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-	client.glueconn = glue.New(sess)
-	client.athenaconn = athena.New(sess)
-	client.dxconn = directconnect.New(sess)
-	client.mediastoreconn = mediastore.New(sess)
-	client.appsyncconn = appsync.New(sess)
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == kinesis.ErrCodeLimitExceededException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	client.config = c
+	client.sess = sess
+	client.regionalclients = &awsRegionalClients{
+		clients: map[string]*AWSClient{c.Region: &client},
+	}
 
 	return &client, nil
 }
@@ -534,15 +878,24 @@ func hasEc2Classic(platforms []string) bool {
 // ValidateRegion returns an error if the configured region is not a
 // valid aws region and nil otherwise.
 func (c *Config) ValidateRegion() error {
+	if isValidRegion(c.Region) {
+		return nil
+	}
+
+	return fmt.Errorf("Not a valid region: %s", c.Region)
+}
+
+// isValidRegion returns true if the region is known to the SDK.
+func isValidRegion(name string) bool {
 	for _, partition := range endpoints.DefaultPartitions() {
 		for _, region := range partition.Regions() {
-			if c.Region == region.ID() {
-				return nil
+			if name == region.ID() {
+				return true
 			}
 		}
 	}
 
-	return fmt.Errorf("Not a valid region: %s", c.Region)
+	return false
 }
 
 // Validate credentials early and fail before we do any graph walking.
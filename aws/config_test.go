@@ -41,6 +41,56 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigureServiceClients(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: awsCredentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{
+		Endpoints: map[string]string{
+			"dynamodb": "http://localhost:8000",
+			"glue":     "http://localhost:4566",
+			"s3":       "http://localhost:9000",
+		},
+	}
+
+	var client AWSClient
+	c.configureServiceClients(&client, sess)
+
+	for _, service := range awsServiceClients {
+		if sc := service.New(&AWSClient{}, sess); sc == nil {
+			t.Fatalf("Service %q returned no client", service.Name)
+		}
+	}
+
+	actualEndpoints := map[string]string{
+		"glue":     client.glueconn.Endpoint,
+		"s3":       client.s3conn.Endpoint,
+		"dynamodb": client.dynamodbconn.Endpoint,
+		"dax":      client.daxconn.Endpoint,
+		"ssm":      client.ssmconn.Endpoint,
+	}
+	for name, expected := range map[string]string{
+		"glue":     "http://localhost:4566",
+		"s3":       "http://localhost:9000",
+		"dynamodb": "http://localhost:8000",
+		"dax":      "http://localhost:8000",
+		"ssm":      "https://ssm.us-west-2.amazonaws.com",
+	} {
+		if actual := actualEndpoints[name]; actual != expected {
+			t.Fatalf("Expected %s endpoint %q, got %q", name, expected, actual)
+		}
+	}
+
+	if region := aws.StringValue(client.r53conn.Config.Region); region != "us-east-1" {
+		t.Fatalf("Expected Route 53 region us-east-1, got %q", region)
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
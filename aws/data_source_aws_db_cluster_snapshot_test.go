@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestMostRecentDbClusterSnapshot(t *testing.T) {
+	now := time.Now()
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotIdentifier: aws.String("older"),
+			SnapshotCreateTime:          aws.Time(now.Add(-1 * time.Hour)),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("in-progress"),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("newest"),
+			SnapshotCreateTime:          aws.Time(now),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("oldest"),
+			SnapshotCreateTime:          aws.Time(now.Add(-2 * time.Hour)),
+		},
+	}
+
+	snapshot := mostRecentDbClusterSnapshot(snapshots)
+	if got, want := aws.StringValue(snapshot.DBClusterSnapshotIdentifier), "newest"; got != want {
+		t.Fatalf("Expected most recent snapshot %q, got %q", want, got)
+	}
+}
+
+func TestAccAWSDbClusterSnapshotDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_db_cluster_snapshot.test"
+	resourceName := "aws_db_cluster_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsDbClusterSnapshotDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDbSnapshotDataSourceID(dataSourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_identifier", resourceName, "db_cluster_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshot_type", "manual"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "snapshot_create_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDbClusterSnapshotDataSourceConfig(rInt int) string {
+	return testAccAwsDbClusterSnapshotConfig(rInt) + `
+data "aws_db_cluster_snapshot" "test" {
+  most_recent           = true
+  db_cluster_identifier = "${aws_db_cluster_snapshot.test.db_cluster_identifier}"
+  snapshot_type         = "manual"
+}
+`
+}
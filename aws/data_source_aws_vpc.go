@@ -55,6 +55,27 @@ func dataSourceAwsVpc() *schema.Resource {
 				Computed: true,
 			},
 
+			"cidr_block_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -141,6 +162,10 @@ func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("state", vpc.State)
 	d.Set("tags", tagsToMap(vpc.Tags))
 
+	if err := d.Set("cidr_block_associations", flattenVpcCidrBlockAssociations(vpc.CidrBlockAssociationSet)); err != nil {
+		return fmt.Errorf("error setting cidr_block_associations: %s", err)
+	}
+
 	if vpc.Ipv6CidrBlockAssociationSet != nil {
 		d.Set("ipv6_association_id", vpc.Ipv6CidrBlockAssociationSet[0].AssociationId)
 		d.Set("ipv6_cidr_block", vpc.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock)
@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsVpnConnectionDeviceConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsVpnConnectionDeviceConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_connection_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"customer_gateway_configuration"},
+			},
+			"customer_gateway_configuration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"vpn_connection_id"},
+			},
+			"device_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(vpnConnectionDeviceTypes(), false),
+				ConflictsWith: []string{"template"},
+			},
+			"template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"device_type"},
+			},
+			"outside_interface": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_cidr_blocks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
+
+			"rendered": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"customer_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpn_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_gateway_outside_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"customer_gateway_inside_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_gateway_outside_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_gateway_inside_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inside_network_mask": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inside_network_cidr": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pre_shared_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"ike_authentication_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_encryption_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_lifetime": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ike_perfect_forward_secrecy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ike_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_authentication_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_encryption_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_lifetime": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ipsec_perfect_forward_secrecy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipsec_clear_df_bit": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ipsec_fragmentation_before_encryption": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ipsec_tcp_mss_adjustment": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dpd_delay": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dpd_retry": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"customer_gateway_bgp_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_gateway_bgp_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bgp_hold_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bgp_neighbor_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsVpnConnectionDeviceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	xmlConfig := d.Get("customer_gateway_configuration").(string)
+	if v, ok := d.GetOk("vpn_connection_id"); ok {
+		id := v.(string)
+
+		log.Printf("[DEBUG] Reading VPN connection: %s", id)
+		resp, err := conn.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{
+			VpnConnectionIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading VPN connection (%s): %s", id, err)
+		}
+		if len(resp.VpnConnections) != 1 {
+			return fmt.Errorf("VPN connection (%s) not found", id)
+		}
+
+		xmlConfig = aws.StringValue(resp.VpnConnections[0].CustomerGatewayConfiguration)
+		if xmlConfig == "" {
+			return fmt.Errorf("VPN connection (%s) has no customer gateway configuration yet", id)
+		}
+	}
+	if xmlConfig == "" {
+		return fmt.Errorf("One of vpn_connection_id or customer_gateway_configuration must be assigned")
+	}
+
+	vpnConfig, err := parseXmlVpnConnectionConfig(xmlConfig)
+	if err != nil {
+		return err
+	}
+
+	data := &vpnConnectionDeviceConfigData{
+		XmlVpnConnectionConfig: *vpnConfig,
+		OutsideInterface:       d.Get("outside_interface").(string),
+	}
+	for _, v := range d.Get("vpc_cidr_blocks").([]interface{}) {
+		data.VpcCidrBlocks = append(data.VpcCidrBlocks, v.(string))
+	}
+
+	text := d.Get("template").(string)
+	if text == "" {
+		deviceType := "generic"
+		if v, ok := d.GetOk("device_type"); ok {
+			deviceType = v.(string)
+		}
+		text = vpnConnectionDeviceTemplates[deviceType]
+	}
+
+	rendered, err := renderVpnConnectionDeviceConfig(text, data)
+	if err != nil {
+		return fmt.Errorf("Error rendering VPN connection device configuration: %s", err)
+	}
+
+	if vpnConfig.ID != "" {
+		d.SetId(vpnConfig.ID)
+	} else {
+		d.SetId(fmt.Sprintf("%d", hashcode.String(xmlConfig)))
+	}
+
+	d.Set("rendered", rendered)
+	d.Set("customer_gateway_id", vpnConfig.CustomerGatewayID)
+	d.Set("vpn_gateway_id", vpnConfig.VpnGatewayID)
+	d.Set("type", vpnConfig.Type)
+	if err := d.Set("tunnel", flattenVpnConnectionDeviceConfigTunnels(vpnConfig.Tunnels)); err != nil {
+		return fmt.Errorf("error setting tunnel: %s", err)
+	}
+
+	return nil
+}
+
+func flattenVpnConnectionDeviceConfigTunnels(tunnels []XmlIpsecTunnel) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(tunnels))
+	for _, t := range tunnels {
+		// Statically routed connections have no BGP peering.
+		bgpNeighborAddress := ""
+		if t.CgwBGPASN != "" {
+			bgpNeighborAddress = t.VgwInsideAddress
+		}
+
+		result = append(result, map[string]interface{}{
+			"customer_gateway_outside_address":      t.CgwOutsideAddress,
+			"customer_gateway_inside_address":       t.CgwInsideAddress,
+			"vpn_gateway_outside_address":           t.OutsideAddress,
+			"vpn_gateway_inside_address":            t.VgwInsideAddress,
+			"inside_network_mask":                   t.InsideNetworkMask,
+			"inside_network_cidr":                   t.InsideNetworkCidr,
+			"pre_shared_key":                        t.PreSharedKey,
+			"ike_authentication_protocol":           t.IkeAuthenticationProtocol,
+			"ike_encryption_protocol":               t.IkeEncryptionProtocol,
+			"ike_lifetime":                          t.IkeLifetime,
+			"ike_perfect_forward_secrecy":           t.IkePerfectForwardSecrecy,
+			"ike_mode":                              t.IkeMode,
+			"ipsec_protocol":                        t.IpsecProtocol,
+			"ipsec_authentication_protocol":         t.IpsecAuthenticationProtocol,
+			"ipsec_encryption_protocol":             t.IpsecEncryptionProtocol,
+			"ipsec_lifetime":                        t.IpsecLifetime,
+			"ipsec_perfect_forward_secrecy":         t.IpsecPerfectForwardSecrecy,
+			"ipsec_mode":                            t.IpsecMode,
+			"ipsec_clear_df_bit":                    t.IpsecClearDfBit,
+			"ipsec_fragmentation_before_encryption": t.IpsecFragmentationBeforeEncryption,
+			"ipsec_tcp_mss_adjustment":              t.IpsecTCPMSSAdjustment,
+			"dpd_delay":                             t.DPDDelay,
+			"dpd_retry":                             t.DPDRetry,
+			"customer_gateway_bgp_asn":              t.CgwBGPASN,
+			"vpn_gateway_bgp_asn":                   t.BGPASN,
+			"bgp_hold_time":                         t.BGPHoldTime,
+			"bgp_neighbor_address":                  bgpNeighborAddress,
+		})
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpnConnectionDeviceConfig_basic(t *testing.T) {
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceAwsVpnConnectionDeviceConfigConfig(rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection_device_config.generic", "id",
+						"aws_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection_device_config.generic", "customer_gateway_id",
+						"aws_customer_gateway.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection_device_config.generic", "tunnel.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_vpn_connection_device_config.generic", "tunnel.0.vpn_gateway_outside_address",
+						"aws_vpn_connection.test", "tunnel1_address"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection_device_config.generic", "tunnel.0.customer_gateway_outside_address", "178.0.0.1"),
+					resource.TestCheckResourceAttr("data.aws_vpn_connection_device_config.generic", "tunnel.0.customer_gateway_bgp_asn", fmt.Sprintf("%d", rBgpAsn)),
+					resource.TestCheckResourceAttrSet("data.aws_vpn_connection_device_config.generic", "tunnel.0.bgp_neighbor_address"),
+					resource.TestMatchResourceAttr("data.aws_vpn_connection_device_config.generic", "rendered", regexp.MustCompile("conn vpn-")),
+					resource.TestMatchResourceAttr("data.aws_vpn_connection_device_config.juniper", "rendered", regexp.MustCompile("external-interface ge-0/0/1.0")),
+					resource.TestMatchResourceAttr("data.aws_vpn_connection_device_config.custom", "rendered", regexp.MustCompile("^vpn-")),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpnConnectionDeviceConfigConfig(rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "terraform-testacc-vpn-connection-device-config"
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+  tags {
+    Name = "terraform-testacc-vpn-connection-device-config"
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  vpn_gateway_id      = "${aws_vpn_gateway.test.id}"
+  customer_gateway_id = "${aws_customer_gateway.test.id}"
+  type                = "ipsec.1"
+}
+
+data "aws_vpn_connection_device_config" "generic" {
+  vpn_connection_id = "${aws_vpn_connection.test.id}"
+}
+
+data "aws_vpn_connection_device_config" "juniper" {
+  customer_gateway_configuration = "${aws_vpn_connection.test.customer_gateway_configuration}"
+  device_type                    = "juniper_srx"
+  outside_interface              = "ge-0/0/1.0"
+}
+
+data "aws_vpn_connection_device_config" "custom" {
+  vpn_connection_id = "${aws_vpn_connection.test.id}"
+  template          = "{{.ID}}{{range .Tunnels}} {{.OutsideAddress}}{{end}}"
+}
+`, rBgpAsn)
+}
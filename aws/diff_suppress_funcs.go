@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -60,6 +61,22 @@ func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) boo
 	return jsonBytesEqual(ob.Bytes(), nb.Bytes())
 }
 
+// Budgets returns limit amounts with a fixed number of decimal places, e.g.
+// "100.0" for a configured "100".
+func suppressEquivalentBudgetsLimitAmountDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+
+	n, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+
+	return o == n
+}
+
 func suppressOpenIdURL(k, old, new string, d *schema.ResourceData) bool {
 	oldUrl, err := url.Parse(old)
 	if err != nil {
@@ -29,3 +29,23 @@ func TestSuppressEquivalentJsonDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected suppressEquivalentJsonDiffs to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestSuppressEquivalentBudgetsLimitAmountDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	cases := []struct {
+		Old, New string
+		Equal    bool
+	}{
+		{"100.0", "100", true},
+		{"100.5", "100.50", true},
+		{"100.0", "101", false},
+		{"", "100", false},
+	}
+
+	for _, tc := range cases {
+		if got := suppressEquivalentBudgetsLimitAmountDiffs("limit_amount", tc.Old, tc.New, d); got != tc.Equal {
+			t.Errorf("Expected suppressEquivalentBudgetsLimitAmountDiffs(%q, %q) to return %t", tc.Old, tc.New, tc.Equal)
+		}
+	}
+}
@@ -0,0 +1,699 @@
+// The tagsgen command generates aws/tags_gen.go, the per-service adapters
+// between keyValueTags and the AWS service tag types and tagging APIs.
+//
+// Tagging support for a new service is added with an entry in services below
+// and a `go generate` run from the aws directory.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const filename = "tags_gen.go"
+
+// service describes the tag type and tagging API of an AWS service.
+type service struct {
+	// Name is used in the generated adapter names, e.g. updateTagsACM.
+	Name string
+	// Suffix is used in the generated tag helper names, e.g. tagsFromMapACM.
+	Suffix string
+	// IgnoredSuffix overrides Suffix in the tagIgnored helper name.
+	IgnoredSuffix string
+	// Helpers generates the tagsFromMap, tagsToMap, diffTags and tagIgnored
+	// helpers for the service tag type.
+	Helpers bool
+	// DiffKeepsChanged is set when diffTags only destroys the tags that are
+	// no longer present, rather than also those whose value changed.
+	DiffKeepsChanged bool
+
+	// Package is the aws-sdk-go service package and Alias the name it is
+	// imported under, if different.
+	Package string
+	Alias   string
+	// Client is the service client type.
+	Client string
+
+	// TagType is the service tag type. When empty the service uses a map of
+	// strings for tags.
+	TagType    string
+	KeyField   string
+	ValueField string
+	// TagsWrapper is set when lists of tags and tag keys are wrapped in an
+	// Items struct.
+	TagsWrapper bool
+
+	// IdentifierField is the API input field identifying the resource and
+	// IdentifierSlice is set when it takes a list of resources.
+	IdentifierField string
+	IdentifierSlice bool
+	// ResourceTypeField is the API input field for the resource type, for
+	// the APIs requiring one.
+	ResourceTypeField string
+
+	ListFunc        string
+	ListOutputField string
+
+	AddFunc      string
+	AddTagsField string
+	AddTagsMap   bool
+
+	RemoveFunc       string
+	RemoveTagsField  string
+	RemoveWithValues bool
+	RemoveTagKeyOnly bool
+
+	// Limit is the maximum number of tags to add or remove per call. When
+	// zero, all the tags are added or removed in a single call.
+	Limit int
+	// RetryOn is an expression of err, set when the tagging calls should be
+	// retried on errors while a new resource becomes visible to the API.
+	RetryOn string
+
+	// SetTags generates a setTags helper for resources, reading the changes
+	// to "tags_all". Identifier is the expression of d identifying the
+	// resource, if not passed to the helper.
+	SetTags    bool
+	Identifier string
+}
+
+var services = []service{
+	{
+		Name: "ACM", Suffix: "ACM", Helpers: true,
+		Package: "acm", Client: "ACM",
+		TagType:         "Tag",
+		IdentifierField: "CertificateArn",
+		ListFunc:        "ListTagsForCertificate", ListOutputField: "Tags",
+		AddFunc: "AddTagsToCertificate", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTagsFromCertificate", RemoveTagsField: "Tags", RemoveWithValues: true,
+		Limit:   50,
+		SetTags: true, Identifier: `d.Get("arn").(string)`,
+	},
+	{
+		Name: "Beanstalk", Suffix: "Beanstalk", Helpers: true, DiffKeepsChanged: true,
+		Package: "elasticbeanstalk", Client: "ElasticBeanstalk",
+		TagType:         "Tag",
+		IdentifierField: "ResourceArn",
+		ListFunc:        "ListTagsForResource", ListOutputField: "ResourceTags",
+		AddFunc: "UpdateTagsForResource", AddTagsField: "TagsToAdd",
+		RemoveFunc: "UpdateTagsForResource", RemoveTagsField: "TagsToRemove",
+		Limit: 50,
+	},
+	{
+		Name: "CloudFront", Suffix: "CloudFront", Helpers: true,
+		Package: "cloudfront", Client: "CloudFront",
+		TagType: "Tag", TagsWrapper: true,
+		IdentifierField: "Resource",
+		ListFunc:        "ListTagsForResource", ListOutputField: "Tags",
+		AddFunc: "TagResource", AddTagsField: "Tags",
+		RemoveFunc: "UntagResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "Cloudtrail", Suffix: "Cloudtrail", Helpers: true,
+		Package: "cloudtrail", Client: "CloudTrail",
+		TagType:         "Tag",
+		IdentifierField: "ResourceId",
+		AddFunc:         "AddTags", AddTagsField: "TagsList",
+		RemoveFunc: "RemoveTags", RemoveTagsField: "TagsList", RemoveWithValues: true,
+		Limit:   50,
+		SetTags: true, Identifier: `d.Get("arn").(string)`,
+	},
+	{
+		Name: "CodeBuild", Suffix: "CodeBuild", Helpers: true,
+		Package: "codebuild", Client: "CodeBuild",
+		TagType: "Tag",
+	},
+	{
+		Name: "Dax", Suffix: "Dax", Helpers: true,
+		Package: "dax", Client: "DAX",
+		TagType:         "Tag",
+		IdentifierField: "ResourceName",
+		ListFunc:        "ListTags", ListOutputField: "Tags",
+		AddFunc: "TagResource", AddTagsField: "Tags",
+		RemoveFunc: "UntagResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "DMS", Suffix: "DMS", Helpers: true,
+		Package: "databasemigrationservice", Alias: "dms", Client: "DatabaseMigrationService",
+		TagType:         "Tag",
+		IdentifierField: "ResourceArn",
+		ListFunc:        "ListTagsForResource", ListOutputField: "TagList",
+		AddFunc: "AddTagsToResource", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTagsFromResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "DS", Suffix: "DS", Helpers: true,
+		Package: "directoryservice", Client: "DirectoryService",
+		TagType:         "Tag",
+		IdentifierField: "ResourceId",
+		ListFunc:        "ListTagsForResource", ListOutputField: "Tags",
+		AddFunc: "AddTagsToResource", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTagsFromResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "DX", Suffix: "DX", Helpers: true,
+		Package: "directconnect", Client: "DirectConnect",
+		TagType:         "Tag",
+		IdentifierField: "ResourceArn",
+		AddFunc:         "TagResource", AddTagsField: "Tags",
+		RemoveFunc: "UntagResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "DynamoDb", Suffix: "DynamoDb", Helpers: true,
+		Package: "dynamodb", Client: "DynamoDB",
+		TagType:         "Tag",
+		IdentifierField: "ResourceArn",
+		ListFunc:        "ListTagsOfResource", ListOutputField: "Tags",
+		AddFunc: "TagResource", AddTagsField: "Tags",
+		RemoveFunc: "UntagResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		RetryOn: `isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "")`,
+		SetTags: true, Identifier: `d.Get("arn").(string)`,
+	},
+	{
+		Name: "EC", Suffix: "EC", Helpers: true,
+		Package: "elasticache", Client: "ElastiCache",
+		TagType:         "Tag",
+		IdentifierField: "ResourceName",
+		ListFunc:        "ListTagsForResource", ListOutputField: "TagList",
+		AddFunc: "AddTagsToResource", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTagsFromResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "EC2", Suffix: "", Helpers: true,
+		Package: "ec2", Client: "EC2",
+		TagType:         "Tag",
+		IdentifierField: "Resources", IdentifierSlice: true,
+		AddFunc: "CreateTags", AddTagsField: "Tags",
+		RemoveFunc: "DeleteTags", RemoveTagsField: "Tags", RemoveWithValues: true,
+		Limit:   50,
+		RetryOn: `isEC2NotFoundErr(err)`,
+		SetTags: true, Identifier: `d.Id()`,
+	},
+	{
+		Name: "EFS", Suffix: "EFS", Helpers: true,
+		Package: "efs", Client: "EFS",
+		TagType:         "Tag",
+		IdentifierField: "FileSystemId",
+		ListFunc:        "DescribeTags", ListOutputField: "Tags",
+		AddFunc: "CreateTags", AddTagsField: "Tags",
+		RemoveFunc: "DeleteTags", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true, Identifier: `d.Id()`,
+	},
+	{
+		Name: "ELB", Suffix: "ELB", Helpers: true,
+		Package: "elb", Client: "ELB",
+		TagType:         "Tag",
+		IdentifierField: "LoadBalancerNames", IdentifierSlice: true,
+		AddFunc: "AddTags", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTags", RemoveTagsField: "Tags", RemoveTagKeyOnly: true,
+		Limit:   10,
+		SetTags: true, Identifier: `d.Get("name").(string)`,
+	},
+	{
+		Name: "ELBv2", Suffix: "ELBv2", Helpers: true,
+		Package: "elbv2", Client: "ELBV2",
+		TagType:         "Tag",
+		IdentifierField: "ResourceArns", IdentifierSlice: true,
+		AddFunc: "AddTags", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTags", RemoveTagsField: "TagKeys",
+		Limit:   10,
+		SetTags: true, Identifier: `d.Id()`,
+	},
+	{
+		Name: "EMR", Suffix: "EMR", Helpers: true,
+		Package: "emr", Client: "EMR",
+		TagType:         "Tag",
+		IdentifierField: "ResourceId",
+		AddFunc:         "AddTags", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTags", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true, Identifier: `d.Id()`,
+	},
+	{
+		Name: "ElasticsearchService", Suffix: "ElasticsearchService", Helpers: true,
+		Package: "elasticsearchservice", Alias: "elasticsearch", Client: "ElasticsearchService",
+		TagType:         "Tag",
+		IdentifierField: "ARN",
+		ListFunc:        "ListTags", ListOutputField: "TagList",
+		AddFunc: "AddTags", AddTagsField: "TagList",
+		RemoveFunc: "RemoveTags", RemoveTagsField: "TagKeys",
+		Limit:   10,
+		SetTags: true,
+	},
+	{
+		Name: "Inspector", Suffix: "Inspector", Helpers: true,
+		Package: "inspector", Client: "Inspector",
+		TagType: "ResourceGroupTag",
+	},
+	{
+		Name: "KMS", Suffix: "KMS", Helpers: true,
+		Package: "kms", Client: "KMS",
+		TagType: "Tag", KeyField: "TagKey", ValueField: "TagValue",
+		IdentifierField: "KeyId",
+		ListFunc:        "ListResourceTags", ListOutputField: "Tags",
+		AddFunc: "TagResource", AddTagsField: "Tags",
+		RemoveFunc: "UntagResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "Kinesis", Suffix: "Kinesis", Helpers: true,
+		Package: "kinesis", Client: "Kinesis",
+		TagType:         "Tag",
+		IdentifierField: "StreamName",
+		ListFunc:        "ListTagsForStream", ListOutputField: "Tags",
+		AddFunc: "AddTagsToStream", AddTagsField: "Tags", AddTagsMap: true,
+		RemoveFunc: "RemoveTagsFromStream", RemoveTagsField: "TagKeys",
+		Limit:   10,
+		SetTags: true, Identifier: `d.Get("name").(string)`,
+	},
+	{
+		Name: "Lambda", Suffix: "Lambda",
+		Package: "lambda", Client: "Lambda",
+		IdentifierField: "Resource",
+		ListFunc:        "ListTags", ListOutputField: "Tags",
+		AddFunc: "TagResource", AddTagsField: "Tags",
+		RemoveFunc: "UntagResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "Opsworks", Suffix: "Opsworks",
+		Package: "opsworks", Client: "OpsWorks",
+		IdentifierField: "ResourceArn",
+		ListFunc:        "ListTags", ListOutputField: "Tags",
+		AddFunc: "TagResource", AddTagsField: "Tags",
+		RemoveFunc: "UntagResource", RemoveTagsField: "TagKeys",
+		Limit:   40,
+		SetTags: true,
+	},
+	{
+		Name: "RDS", Suffix: "RDS", Helpers: true,
+		Package: "rds", Client: "RDS",
+		TagType:         "Tag",
+		IdentifierField: "ResourceName",
+		ListFunc:        "ListTagsForResource", ListOutputField: "TagList",
+		AddFunc: "AddTagsToResource", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTagsFromResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "Redshift", Suffix: "Redshift", Helpers: true,
+		Package: "redshift", Client: "Redshift",
+		TagType:         "Tag",
+		IdentifierField: "ResourceName",
+		AddFunc:         "CreateTags", AddTagsField: "Tags",
+		RemoveFunc: "DeleteTags", RemoveTagsField: "TagKeys",
+		Limit:   10,
+		SetTags: true,
+	},
+	{
+		Name: "Route53", Suffix: "R53", IgnoredSuffix: "Route53", Helpers: true,
+		Package: "route53", Client: "Route53",
+		TagType:         "Tag",
+		IdentifierField: "ResourceId", ResourceTypeField: "ResourceType",
+		AddFunc: "ChangeTagsForResource", AddTagsField: "AddTags",
+		RemoveFunc: "ChangeTagsForResource", RemoveTagsField: "RemoveTagKeys",
+		Limit:   10,
+		SetTags: true, Identifier: `d.Id()`,
+	},
+	{
+		Name: "S3", Suffix: "S3", Helpers: true,
+		Package: "s3", Client: "S3",
+		TagType: "Tag",
+	},
+	{
+		Name: "SageMaker", Suffix: "SageMaker", Helpers: true,
+		Package: "sagemaker", Client: "SageMaker",
+		TagType:         "Tag",
+		IdentifierField: "ResourceArn",
+		ListFunc:        "ListTags", ListOutputField: "Tags",
+		AddFunc: "AddTags", AddTagsField: "Tags",
+		RemoveFunc: "DeleteTags", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+	{
+		Name: "SQS", Suffix: "SQS",
+		Package: "sqs", Client: "SQS",
+		IdentifierField: "QueueUrl",
+		ListFunc:        "ListQueueTags", ListOutputField: "Tags",
+		AddFunc: "TagQueue", AddTagsField: "Tags",
+		RemoveFunc: "UntagQueue", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true, Identifier: `d.Id()`,
+	},
+	{
+		Name: "SSM", Suffix: "SSM", Helpers: true,
+		Package: "ssm", Client: "SSM",
+		TagType:         "Tag",
+		IdentifierField: "ResourceId", ResourceTypeField: "ResourceType",
+		ListFunc: "ListTagsForResource", ListOutputField: "TagList",
+		AddFunc: "AddTagsToResource", AddTagsField: "Tags",
+		RemoveFunc: "RemoveTagsFromResource", RemoveTagsField: "TagKeys",
+		Limit:   50,
+		SetTags: true,
+	},
+}
+
+// Q returns the qualifier for the service package.
+func (s service) Q() string {
+	if s.Alias != "" {
+		return s.Alias
+	}
+	return s.Package
+}
+
+// TagsType returns the type of a list of service tags.
+func (s service) TagsType() string {
+	switch {
+	case s.TagType == "":
+		return "map[string]*string"
+	case s.TagsWrapper:
+		return "*" + s.Q() + ".Tags"
+	default:
+		return "[]*" + s.Q() + "." + s.TagType
+	}
+}
+
+func (s service) Key() string {
+	if s.KeyField != "" {
+		return s.KeyField
+	}
+	return "Key"
+}
+
+func (s service) Value() string {
+	if s.ValueField != "" {
+		return s.ValueField
+	}
+	return "Value"
+}
+
+type templateData struct {
+	Services   []service
+	StdImports []string
+	Imports    []string
+}
+
+func main() {
+	imports := map[string]bool{
+		`"fmt"`:                           true,
+		`"log"`:                           true,
+		`"github.com/aws/aws-sdk-go/aws"`: true,
+	}
+	for _, s := range services {
+		path := `"github.com/aws/aws-sdk-go/service/` + s.Package + `"`
+		if s.Alias != "" {
+			path = s.Alias + " " + path
+		}
+		imports[path] = true
+		if s.RetryOn != "" {
+			imports[`"time"`] = true
+			imports[`"github.com/hashicorp/terraform/helper/resource"`] = true
+		}
+		if s.SetTags {
+			imports[`"github.com/hashicorp/terraform/helper/schema"`] = true
+		}
+	}
+
+	data := templateData{Services: services}
+	for k := range imports {
+		if strings.Contains(k, ".") {
+			data.Imports = append(data.Imports, k)
+		} else {
+			data.StdImports = append(data.StdImports, k)
+		}
+	}
+	sort.Strings(data.StdImports)
+	sort.Strings(data.Imports)
+
+	tmpl := template.Must(template.New("tags").Parse(tagsTemplate))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting generated source: %s\n%s", err, buf.String())
+	}
+
+	if err := ioutil.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", filename, err)
+	}
+}
+
+var tagsTemplate = `// Code generated by internal/tagsgen/main.go; DO NOT EDIT.
+
+package aws
+
+import (
+{{- range .StdImports}}
+	{{.}}
+{{- end}}
+{{range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{range $s := .Services}}
+{{- if $s.TagType}}
+// keyValueTags{{$s.Name}} creates keyValueTags from {{$s.Package}} service tags.
+func keyValueTags{{$s.Name}}(tags {{$s.TagsType}}) keyValueTags {
+	result := make(keyValueTags)
+{{- if $s.TagsWrapper}}
+	if tags == nil {
+		return result
+	}
+	for _, tag := range tags.Items {
+{{- else}}
+	for _, tag := range tags {
+{{- end}}
+		result[aws.StringValue(tag.{{$s.Key}})] = tag.{{$s.Value}}
+	}
+
+	return result
+}
+
+// tags{{$s.Name}} returns {{$s.Package}} service tags.
+func (tags keyValueTags) tags{{$s.Name}}() {{$s.TagsType}} {
+	result := make([]*{{$s.Q}}.{{$s.TagType}}, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &{{$s.Q}}.{{$s.TagType}}{
+			{{$s.Key}}: aws.String(k),
+			{{$s.Value}}: tags[k],
+		})
+	}
+
+{{- if $s.TagsWrapper}}
+
+	return &{{$s.Q}}.Tags{Items: result}
+{{- else}}
+
+	return result
+{{- end}}
+}
+{{- end}}
+{{- if $s.Helpers}}
+
+// tagsFromMap{{$s.Suffix}} returns the {{$s.Package}} service tags for the given map of data.
+func tagsFromMap{{$s.Suffix}}(m map[string]interface{}) {{$s.TagsType}} {
+	return newKeyValueTags(m).IgnoreAws().tags{{$s.Name}}()
+}
+
+// tagsToMap{{$s.Suffix}} turns the {{$s.Package}} service tags into a map.
+func tagsToMap{{$s.Suffix}}(ts {{$s.TagsType}}) map[string]string {
+	return keyValueTags{{$s.Name}}(ts).IgnoreAws().Map()
+}
+
+// diffTags{{$s.Suffix}} takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTags{{$s.Suffix}}(oldTags, newTags {{$s.TagsType}}) ({{$s.TagsType}}, {{$s.TagsType}}) {
+	o, n := keyValueTags{{$s.Name}}(oldTags), keyValueTags{{$s.Name}}(newTags)
+
+{{- if $s.DiffKeepsChanged}}
+
+	return o.Updated(n).tags{{$s.Name}}(), o.Removed(n).tags{{$s.Name}}()
+{{- else}}
+
+	return o.Updated(n).tags{{$s.Name}}(), o.Outdated(n).tags{{$s.Name}}()
+{{- end}}
+}
+
+// tagIgnored{{or $s.IgnoredSuffix $s.Suffix}} returns whether the tag is reserved for use by AWS.
+func tagIgnored{{or $s.IgnoredSuffix $s.Suffix}}(t *{{$s.Q}}.{{$s.TagType}}) bool {
+	return tagIgnoredAws(aws.StringValue(t.{{$s.Key}}))
+}
+{{- end}}
+{{- if $s.ListFunc}}
+
+// listTags{{$s.Name}} lists {{$s.Package}} service tags.
+func listTags{{$s.Name}}(conn *{{$s.Q}}.{{$s.Client}}, identifier string{{if $s.ResourceTypeField}}, resourceType string{{end}}) (keyValueTags, error) {
+	input := &{{$s.Q}}.{{$s.ListFunc}}Input{
+{{- if $s.IdentifierSlice}}
+		{{$s.IdentifierField}}: aws.StringSlice([]string{identifier}),
+{{- else}}
+		{{$s.IdentifierField}}: aws.String(identifier),
+{{- end}}
+{{- if $s.ResourceTypeField}}
+		{{$s.ResourceTypeField}}: aws.String(resourceType),
+{{- end}}
+	}
+
+	output, err := conn.{{$s.ListFunc}}(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+{{- if $s.TagType}}
+
+	return keyValueTags{{$s.Name}}(output.{{$s.ListOutputField}}).IgnoreAws(), nil
+{{- else}}
+
+	return newKeyValueTags(output.{{$s.ListOutputField}}).IgnoreAws(), nil
+{{- end}}
+}
+{{- end}}
+{{- if $s.AddFunc}}
+
+// updateTags{{$s.Name}} updates {{$s.Package}} service tags, removing and then
+// adding tags{{if $s.Limit}} in batches of at most {{$s.Limit}}{{end}}. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTags{{$s.Name}}(conn *{{$s.Q}}.{{$s.Client}}, identifier string{{if $s.ResourceTypeField}}, resourceType string{{end}}, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+{{if $s.Limit}}
+	for _, removedTags := range oldTags.Removed(newTags).Chunks({{$s.Limit}}) {
+{{- else}}
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+{{- end}}
+{{- if $s.RemoveTagKeyOnly}}
+		tagKeys := make([]*{{$s.Q}}.TagKeyOnly, 0, len(removedTags))
+		for _, k := range removedTags.Keys() {
+			tagKeys = append(tagKeys, &{{$s.Q}}.TagKeyOnly{Key: aws.String(k)})
+		}
+
+{{- end}}
+		input := &{{$s.Q}}.{{$s.RemoveFunc}}Input{
+{{- if $s.IdentifierSlice}}
+			{{$s.IdentifierField}}: aws.StringSlice([]string{identifier}),
+{{- else}}
+			{{$s.IdentifierField}}: aws.String(identifier),
+{{- end}}
+{{- if $s.ResourceTypeField}}
+			{{$s.ResourceTypeField}}: aws.String(resourceType),
+{{- end}}
+{{- if $s.RemoveWithValues}}
+			{{$s.RemoveTagsField}}: removedTags.tags{{$s.Name}}(),
+{{- else if $s.RemoveTagKeyOnly}}
+			{{$s.RemoveTagsField}}: tagKeys,
+{{- else if $s.TagsWrapper}}
+			{{$s.RemoveTagsField}}: &{{$s.Q}}.TagKeys{Items: aws.StringSlice(removedTags.Keys())},
+{{- else}}
+			{{$s.RemoveTagsField}}: aws.StringSlice(removedTags.Keys()),
+{{- end}}
+		}
+
+		log.Printf("[DEBUG] Removing {{$s.Name}} tags: %s", input)
+{{- if $s.RetryOn}}
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.{{$s.RemoveFunc}}(input)
+			if err != nil {
+				if {{$s.RetryOn}} {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+{{- else}}
+		_, err := conn.{{$s.RemoveFunc}}(input)
+{{- end}}
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+{{if $s.Limit}}
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks({{$s.Limit}}) {
+{{- else}}
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+{{- end}}
+		input := &{{$s.Q}}.{{$s.AddFunc}}Input{
+{{- if $s.IdentifierSlice}}
+			{{$s.IdentifierField}}: aws.StringSlice([]string{identifier}),
+{{- else}}
+			{{$s.IdentifierField}}: aws.String(identifier),
+{{- end}}
+{{- if $s.ResourceTypeField}}
+			{{$s.ResourceTypeField}}: aws.String(resourceType),
+{{- end}}
+{{- if or $s.AddTagsMap (not $s.TagType)}}
+			{{$s.AddTagsField}}: map[string]*string(updatedTags),
+{{- else}}
+			{{$s.AddTagsField}}: updatedTags.tags{{$s.Name}}(),
+{{- end}}
+		}
+
+		log.Printf("[DEBUG] Adding {{$s.Name}} tags: %s", input)
+{{- if $s.RetryOn}}
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.{{$s.AddFunc}}(input)
+			if err != nil {
+				if {{$s.RetryOn}} {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+{{- else}}
+		_, err := conn.{{$s.AddFunc}}(input)
+{{- end}}
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+{{- end}}
+{{- if $s.SetTags}}
+
+// setTags{{$s.Suffix}} is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTags{{$s.Suffix}}(conn *{{$s.Q}}.{{$s.Client}}, d *schema.ResourceData{{if not $s.Identifier}}, identifier string{{end}}{{if $s.ResourceTypeField}}, resourceType string{{end}}) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTags{{$s.Name}}(conn, {{if $s.Identifier}}{{$s.Identifier}}{{else}}identifier{{end}}{{if $s.ResourceTypeField}}, resourceType{{end}}, o, n)
+}
+{{- end}}
+{{end}}
+`
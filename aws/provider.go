@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +19,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -157,6 +158,121 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: descriptions["default_tags_tags"],
+						},
+					},
+				},
+			},
+
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["ignore_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: descriptions["ignore_tags_keys"],
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: descriptions["ignore_tags_key_prefixes"],
+						},
+					},
+				},
+			},
+
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["retry"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  descriptions["retry_max_attempts"],
+						},
+						"min_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDuration,
+							Description:  descriptions["retry_min_backoff"],
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDuration,
+							Description:  descriptions["retry_max_backoff"],
+						},
+						"jitter": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: descriptions["retry_jitter"],
+						},
+						"rate_limit": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: descriptions["retry_rate_limit"],
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  descriptions["retry_burst"],
+						},
+						"service": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: descriptions["retry_service"],
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"retryable_error_codes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+									"rate_limit": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+									"burst": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -171,6 +287,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_canonical_user_id":                dataSourceAwsCanonicalUserId(),
 			"aws_cloudformation_stack":             dataSourceAwsCloudFormationStack(),
 			"aws_cloudtrail_service_account":       dataSourceAwsCloudTrailServiceAccount(),
+			"aws_db_cluster_snapshot":              dataSourceAwsDbClusterSnapshot(),
 			"aws_db_instance":                      dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                      dataSourceAwsDbSnapshot(),
 			"aws_dynamodb_table":                   dataSourceAwsDynamoDbTable(),
@@ -209,6 +326,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_kms_ciphertext":                   dataSourceAwsKmsCiphertext(),
 			"aws_kms_key":                          dataSourceAwsKmsKey(),
 			"aws_kms_secret":                       dataSourceAwsKmsSecret(),
+			"aws_launch_template":                  dataSourceAwsLaunchTemplate(),
 			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
 			"aws_network_interface":                dataSourceAwsNetworkInterface(),
 			"aws_partition":                        dataSourceAwsPartition(),
@@ -229,6 +347,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc_endpoint":                     dataSourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_service":             dataSourceAwsVpcEndpointService(),
 			"aws_vpc_peering_connection":           dataSourceAwsVpcPeeringConnection(),
+			"aws_vpn_connection_device_config":     dataSourceAwsVpnConnectionDeviceConfig(),
 			"aws_vpn_gateway":                      dataSourceAwsVpnGateway(),
 
 			// Adding the Aliases for the ALB -> LB Rename
@@ -241,330 +360,360 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
-			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
-			"aws_ami":                                      resourceAwsAmi(),
-			"aws_ami_copy":                                 resourceAwsAmiCopy(),
-			"aws_ami_from_instance":                        resourceAwsAmiFromInstance(),
-			"aws_ami_launch_permission":                    resourceAwsAmiLaunchPermission(),
-			"aws_api_gateway_account":                      resourceAwsApiGatewayAccount(),
-			"aws_api_gateway_api_key":                      resourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_authorizer":                   resourceAwsApiGatewayAuthorizer(),
-			"aws_api_gateway_base_path_mapping":            resourceAwsApiGatewayBasePathMapping(),
-			"aws_api_gateway_client_certificate":           resourceAwsApiGatewayClientCertificate(),
-			"aws_api_gateway_deployment":                   resourceAwsApiGatewayDeployment(),
-			"aws_api_gateway_documentation_part":           resourceAwsApiGatewayDocumentationPart(),
-			"aws_api_gateway_documentation_version":        resourceAwsApiGatewayDocumentationVersion(),
-			"aws_api_gateway_domain_name":                  resourceAwsApiGatewayDomainName(),
-			"aws_api_gateway_gateway_response":             resourceAwsApiGatewayGatewayResponse(),
-			"aws_api_gateway_integration":                  resourceAwsApiGatewayIntegration(),
-			"aws_api_gateway_integration_response":         resourceAwsApiGatewayIntegrationResponse(),
-			"aws_api_gateway_method":                       resourceAwsApiGatewayMethod(),
-			"aws_api_gateway_method_response":              resourceAwsApiGatewayMethodResponse(),
-			"aws_api_gateway_method_settings":              resourceAwsApiGatewayMethodSettings(),
-			"aws_api_gateway_model":                        resourceAwsApiGatewayModel(),
-			"aws_api_gateway_request_validator":            resourceAwsApiGatewayRequestValidator(),
-			"aws_api_gateway_resource":                     resourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":                     resourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_stage":                        resourceAwsApiGatewayStage(),
-			"aws_api_gateway_usage_plan":                   resourceAwsApiGatewayUsagePlan(),
-			"aws_api_gateway_usage_plan_key":               resourceAwsApiGatewayUsagePlanKey(),
-			"aws_api_gateway_vpc_link":                     resourceAwsApiGatewayVpcLink(),
-			"aws_app_cookie_stickiness_policy":             resourceAwsAppCookieStickinessPolicy(),
-			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
-			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
-			"aws_appautoscaling_scheduled_action":          resourceAwsAppautoscalingScheduledAction(),
-			"aws_appsync_graphql_api":                      resourceAwsAppsyncGraphqlApi(),
-			"aws_athena_database":                          resourceAwsAthenaDatabase(),
-			"aws_athena_named_query":                       resourceAwsAthenaNamedQuery(),
-			"aws_autoscaling_attachment":                   resourceAwsAutoscalingAttachment(),
-			"aws_autoscaling_group":                        resourceAwsAutoscalingGroup(),
-			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
-			"aws_autoscaling_notification":                 resourceAwsAutoscalingNotification(),
-			"aws_autoscaling_policy":                       resourceAwsAutoscalingPolicy(),
-			"aws_autoscaling_schedule":                     resourceAwsAutoscalingSchedule(),
-			"aws_cloud9_environment_ec2":                   resourceAwsCloud9EnvironmentEc2(),
-			"aws_cloudformation_stack":                     resourceAwsCloudFormationStack(),
-			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
-			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
-			"aws_cloudtrail":                               resourceAwsCloudTrail(),
-			"aws_cloudwatch_event_permission":              resourceAwsCloudWatchEventPermission(),
-			"aws_cloudwatch_event_rule":                    resourceAwsCloudWatchEventRule(),
-			"aws_cloudwatch_event_target":                  resourceAwsCloudWatchEventTarget(),
-			"aws_cloudwatch_log_destination":               resourceAwsCloudWatchLogDestination(),
-			"aws_cloudwatch_log_destination_policy":        resourceAwsCloudWatchLogDestinationPolicy(),
-			"aws_cloudwatch_log_group":                     resourceAwsCloudWatchLogGroup(),
-			"aws_cloudwatch_log_metric_filter":             resourceAwsCloudWatchLogMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":           resourceAwsCloudWatchLogResourcePolicy(),
-			"aws_cloudwatch_log_stream":                    resourceAwsCloudWatchLogStream(),
-			"aws_cloudwatch_log_subscription_filter":       resourceAwsCloudwatchLogSubscriptionFilter(),
-			"aws_config_config_rule":                       resourceAwsConfigConfigRule(),
-			"aws_config_configuration_recorder":            resourceAwsConfigConfigurationRecorder(),
-			"aws_config_configuration_recorder_status":     resourceAwsConfigConfigurationRecorderStatus(),
-			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
-			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
-			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
-			"aws_cognito_user_group":                       resourceAwsCognitoUserGroup(),
-			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
-			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
-			"aws_cognito_user_pool_domain":                 resourceAwsCognitoUserPoolDomain(),
-			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
-			"aws_cloudwatch_dashboard":                     resourceAwsCloudWatchDashboard(),
-			"aws_codedeploy_app":                           resourceAwsCodeDeployApp(),
-			"aws_codedeploy_deployment_config":             resourceAwsCodeDeployDeploymentConfig(),
-			"aws_codedeploy_deployment_group":              resourceAwsCodeDeployDeploymentGroup(),
-			"aws_codecommit_repository":                    resourceAwsCodeCommitRepository(),
-			"aws_codecommit_trigger":                       resourceAwsCodeCommitTrigger(),
-			"aws_codebuild_project":                        resourceAwsCodeBuildProject(),
-			"aws_codepipeline":                             resourceAwsCodePipeline(),
-			"aws_customer_gateway":                         resourceAwsCustomerGateway(),
-			"aws_dax_cluster":                              resourceAwsDaxCluster(),
-			"aws_db_event_subscription":                    resourceAwsDbEventSubscription(),
-			"aws_db_instance":                              resourceAwsDbInstance(),
-			"aws_db_option_group":                          resourceAwsDbOptionGroup(),
-			"aws_db_parameter_group":                       resourceAwsDbParameterGroup(),
-			"aws_db_security_group":                        resourceAwsDbSecurityGroup(),
-			"aws_db_snapshot":                              resourceAwsDbSnapshot(),
-			"aws_db_subnet_group":                          resourceAwsDbSubnetGroup(),
-			"aws_devicefarm_project":                       resourceAwsDevicefarmProject(),
-			"aws_directory_service_directory":              resourceAwsDirectoryServiceDirectory(),
-			"aws_dms_certificate":                          resourceAwsDmsCertificate(),
-			"aws_dms_endpoint":                             resourceAwsDmsEndpoint(),
-			"aws_dms_replication_instance":                 resourceAwsDmsReplicationInstance(),
-			"aws_dms_replication_subnet_group":             resourceAwsDmsReplicationSubnetGroup(),
-			"aws_dms_replication_task":                     resourceAwsDmsReplicationTask(),
-			"aws_dx_lag":                                   resourceAwsDxLag(),
-			"aws_dx_connection":                            resourceAwsDxConnection(),
-			"aws_dx_connection_association":                resourceAwsDxConnectionAssociation(),
-			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
-			"aws_dynamodb_table_item":                      resourceAwsDynamoDbTableItem(),
-			"aws_dynamodb_global_table":                    resourceAwsDynamoDbGlobalTable(),
-			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
-			"aws_ebs_volume":                               resourceAwsEbsVolume(),
-			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
-			"aws_ecr_repository":                           resourceAwsEcrRepository(),
-			"aws_ecr_repository_policy":                    resourceAwsEcrRepositoryPolicy(),
-			"aws_ecs_cluster":                              resourceAwsEcsCluster(),
-			"aws_ecs_service":                              resourceAwsEcsService(),
-			"aws_ecs_task_definition":                      resourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                          resourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                         resourceAwsEfsMountTarget(),
-			"aws_egress_only_internet_gateway":             resourceAwsEgressOnlyInternetGateway(),
-			"aws_eip":                                      resourceAwsEip(),
-			"aws_eip_association":                          resourceAwsEipAssociation(),
-			"aws_elasticache_cluster":                      resourceAwsElasticacheCluster(),
-			"aws_elasticache_parameter_group":              resourceAwsElasticacheParameterGroup(),
-			"aws_elasticache_replication_group":            resourceAwsElasticacheReplicationGroup(),
-			"aws_elasticache_security_group":               resourceAwsElasticacheSecurityGroup(),
-			"aws_elasticache_subnet_group":                 resourceAwsElasticacheSubnetGroup(),
-			"aws_elastic_beanstalk_application":            resourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_application_version":    resourceAwsElasticBeanstalkApplicationVersion(),
-			"aws_elastic_beanstalk_configuration_template": resourceAwsElasticBeanstalkConfigurationTemplate(),
-			"aws_elastic_beanstalk_environment":            resourceAwsElasticBeanstalkEnvironment(),
-			"aws_elasticsearch_domain":                     resourceAwsElasticSearchDomain(),
-			"aws_elasticsearch_domain_policy":              resourceAwsElasticSearchDomainPolicy(),
-			"aws_elastictranscoder_pipeline":               resourceAwsElasticTranscoderPipeline(),
-			"aws_elastictranscoder_preset":                 resourceAwsElasticTranscoderPreset(),
-			"aws_elb":                                      resourceAwsElb(),
-			"aws_elb_attachment":                           resourceAwsElbAttachment(),
-			"aws_emr_cluster":                              resourceAwsEMRCluster(),
-			"aws_emr_instance_group":                       resourceAwsEMRInstanceGroup(),
-			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
-			"aws_flow_log":                                 resourceAwsFlowLog(),
-			"aws_gamelift_alias":                           resourceAwsGameliftAlias(),
-			"aws_gamelift_build":                           resourceAwsGameliftBuild(),
-			"aws_gamelift_fleet":                           resourceAwsGameliftFleet(),
-			"aws_glacier_vault":                            resourceAwsGlacierVault(),
-			"aws_glue_catalog_database":                    resourceAwsGlueCatalogDatabase(),
-			"aws_guardduty_detector":                       resourceAwsGuardDutyDetector(),
-			"aws_guardduty_ipset":                          resourceAwsGuardDutyIpset(),
-			"aws_guardduty_member":                         resourceAwsGuardDutyMember(),
-			"aws_guardduty_threatintelset":                 resourceAwsGuardDutyThreatintelset(),
-			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
-			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
-			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
-			"aws_iam_group_policy":                         resourceAwsIamGroupPolicy(),
-			"aws_iam_group":                                resourceAwsIamGroup(),
-			"aws_iam_group_membership":                     resourceAwsIamGroupMembership(),
-			"aws_iam_group_policy_attachment":              resourceAwsIamGroupPolicyAttachment(),
-			"aws_iam_instance_profile":                     resourceAwsIamInstanceProfile(),
-			"aws_iam_openid_connect_provider":              resourceAwsIamOpenIDConnectProvider(),
-			"aws_iam_policy":                               resourceAwsIamPolicy(),
-			"aws_iam_policy_attachment":                    resourceAwsIamPolicyAttachment(),
-			"aws_iam_role_policy_attachment":               resourceAwsIamRolePolicyAttachment(),
-			"aws_iam_role_policy":                          resourceAwsIamRolePolicy(),
-			"aws_iam_role":                                 resourceAwsIamRole(),
-			"aws_iam_saml_provider":                        resourceAwsIamSamlProvider(),
-			"aws_iam_server_certificate":                   resourceAwsIAMServerCertificate(),
-			"aws_iam_user_policy_attachment":               resourceAwsIamUserPolicyAttachment(),
-			"aws_iam_user_policy":                          resourceAwsIamUserPolicy(),
-			"aws_iam_user_ssh_key":                         resourceAwsIamUserSshKey(),
-			"aws_iam_user":                                 resourceAwsIamUser(),
-			"aws_iam_user_login_profile":                   resourceAwsIamUserLoginProfile(),
-			"aws_inspector_assessment_target":              resourceAWSInspectorAssessmentTarget(),
-			"aws_inspector_assessment_template":            resourceAWSInspectorAssessmentTemplate(),
-			"aws_inspector_resource_group":                 resourceAWSInspectorResourceGroup(),
-			"aws_instance":                                 resourceAwsInstance(),
-			"aws_internet_gateway":                         resourceAwsInternetGateway(),
-			"aws_iot_certificate":                          resourceAwsIotCertificate(),
-			"aws_iot_policy":                               resourceAwsIotPolicy(),
-			"aws_iot_thing":                                resourceAwsIotThing(),
-			"aws_iot_thing_type":                           resourceAwsIotThingType(),
-			"aws_iot_topic_rule":                           resourceAwsIotTopicRule(),
-			"aws_key_pair":                                 resourceAwsKeyPair(),
-			"aws_kinesis_firehose_delivery_stream":         resourceAwsKinesisFirehoseDeliveryStream(),
-			"aws_kinesis_stream":                           resourceAwsKinesisStream(),
-			"aws_kms_alias":                                resourceAwsKmsAlias(),
-			"aws_kms_grant":                                resourceAwsKmsGrant(),
-			"aws_kms_key":                                  resourceAwsKmsKey(),
-			"aws_lambda_function":                          resourceAwsLambdaFunction(),
-			"aws_lambda_event_source_mapping":              resourceAwsLambdaEventSourceMapping(),
-			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
-			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
-			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
-			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
-			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
-			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
-			"aws_lightsail_static_ip":                      resourceAwsLightsailStaticIp(),
-			"aws_lightsail_static_ip_attachment":           resourceAwsLightsailStaticIpAttachment(),
-			"aws_lb_cookie_stickiness_policy":              resourceAwsLBCookieStickinessPolicy(),
-			"aws_load_balancer_policy":                     resourceAwsLoadBalancerPolicy(),
-			"aws_load_balancer_backend_server_policy":      resourceAwsLoadBalancerBackendServerPolicies(),
-			"aws_load_balancer_listener_policy":            resourceAwsLoadBalancerListenerPolicies(),
-			"aws_lb_ssl_negotiation_policy":                resourceAwsLBSSLNegotiationPolicy(),
-			"aws_main_route_table_association":             resourceAwsMainRouteTableAssociation(),
-			"aws_mq_broker":                                resourceAwsMqBroker(),
-			"aws_mq_configuration":                         resourceAwsMqConfiguration(),
-			"aws_media_store_container":                    resourceAwsMediaStoreContainer(),
-			"aws_nat_gateway":                              resourceAwsNatGateway(),
-			"aws_network_acl":                              resourceAwsNetworkAcl(),
-			"aws_default_network_acl":                      resourceAwsDefaultNetworkAcl(),
-			"aws_network_acl_rule":                         resourceAwsNetworkAclRule(),
-			"aws_network_interface":                        resourceAwsNetworkInterface(),
-			"aws_network_interface_attachment":             resourceAwsNetworkInterfaceAttachment(),
-			"aws_opsworks_application":                     resourceAwsOpsworksApplication(),
-			"aws_opsworks_stack":                           resourceAwsOpsworksStack(),
-			"aws_opsworks_java_app_layer":                  resourceAwsOpsworksJavaAppLayer(),
-			"aws_opsworks_haproxy_layer":                   resourceAwsOpsworksHaproxyLayer(),
-			"aws_opsworks_static_web_layer":                resourceAwsOpsworksStaticWebLayer(),
-			"aws_opsworks_php_app_layer":                   resourceAwsOpsworksPhpAppLayer(),
-			"aws_opsworks_rails_app_layer":                 resourceAwsOpsworksRailsAppLayer(),
-			"aws_opsworks_nodejs_app_layer":                resourceAwsOpsworksNodejsAppLayer(),
-			"aws_opsworks_memcached_layer":                 resourceAwsOpsworksMemcachedLayer(),
-			"aws_opsworks_mysql_layer":                     resourceAwsOpsworksMysqlLayer(),
-			"aws_opsworks_ganglia_layer":                   resourceAwsOpsworksGangliaLayer(),
-			"aws_opsworks_custom_layer":                    resourceAwsOpsworksCustomLayer(),
-			"aws_opsworks_instance":                        resourceAwsOpsworksInstance(),
-			"aws_opsworks_user_profile":                    resourceAwsOpsworksUserProfile(),
-			"aws_opsworks_permission":                      resourceAwsOpsworksPermission(),
-			"aws_opsworks_rds_db_instance":                 resourceAwsOpsworksRdsDbInstance(),
-			"aws_organizations_organization":               resourceAwsOrganizationsOrganization(),
-			"aws_placement_group":                          resourceAwsPlacementGroup(),
-			"aws_proxy_protocol_policy":                    resourceAwsProxyProtocolPolicy(),
-			"aws_rds_cluster":                              resourceAwsRDSCluster(),
-			"aws_rds_cluster_instance":                     resourceAwsRDSClusterInstance(),
-			"aws_rds_cluster_parameter_group":              resourceAwsRDSClusterParameterGroup(),
-			"aws_redshift_cluster":                         resourceAwsRedshiftCluster(),
-			"aws_redshift_security_group":                  resourceAwsRedshiftSecurityGroup(),
-			"aws_redshift_parameter_group":                 resourceAwsRedshiftParameterGroup(),
-			"aws_redshift_subnet_group":                    resourceAwsRedshiftSubnetGroup(),
-			"aws_route53_delegation_set":                   resourceAwsRoute53DelegationSet(),
-			"aws_route53_query_log":                        resourceAwsRoute53QueryLog(),
-			"aws_route53_record":                           resourceAwsRoute53Record(),
-			"aws_route53_zone_association":                 resourceAwsRoute53ZoneAssociation(),
-			"aws_route53_zone":                             resourceAwsRoute53Zone(),
-			"aws_route53_health_check":                     resourceAwsRoute53HealthCheck(),
-			"aws_route":                                    resourceAwsRoute(),
-			"aws_route_table":                              resourceAwsRouteTable(),
-			"aws_default_route_table":                      resourceAwsDefaultRouteTable(),
-			"aws_route_table_association":                  resourceAwsRouteTableAssociation(),
-			"aws_ses_active_receipt_rule_set":              resourceAwsSesActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                      resourceAwsSesDomainIdentity(),
-			"aws_ses_domain_dkim":                          resourceAwsSesDomainDkim(),
-			"aws_ses_domain_mail_from":                     resourceAwsSesDomainMailFrom(),
-			"aws_ses_receipt_filter":                       resourceAwsSesReceiptFilter(),
-			"aws_ses_receipt_rule":                         resourceAwsSesReceiptRule(),
-			"aws_ses_receipt_rule_set":                     resourceAwsSesReceiptRuleSet(),
-			"aws_ses_configuration_set":                    resourceAwsSesConfigurationSet(),
-			"aws_ses_event_destination":                    resourceAwsSesEventDestination(),
-			"aws_ses_template":                             resourceAwsSesTemplate(),
-			"aws_s3_bucket":                                resourceAwsS3Bucket(),
-			"aws_s3_bucket_policy":                         resourceAwsS3BucketPolicy(),
-			"aws_s3_bucket_object":                         resourceAwsS3BucketObject(),
-			"aws_s3_bucket_notification":                   resourceAwsS3BucketNotification(),
-			"aws_s3_bucket_metric":                         resourceAwsS3BucketMetric(),
-			"aws_security_group":                           resourceAwsSecurityGroup(),
-			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
-			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
-			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
-			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
-			"aws_service_discovery_public_dns_namespace":   resourceAwsServiceDiscoveryPublicDnsNamespace(),
-			"aws_service_discovery_service":                resourceAwsServiceDiscoveryService(),
-			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
-			"aws_ssm_activation":                           resourceAwsSsmActivation(),
-			"aws_ssm_association":                          resourceAwsSsmAssociation(),
-			"aws_ssm_document":                             resourceAwsSsmDocument(),
-			"aws_ssm_maintenance_window":                   resourceAwsSsmMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":            resourceAwsSsmMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":              resourceAwsSsmMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                       resourceAwsSsmPatchBaseline(),
-			"aws_ssm_patch_group":                          resourceAwsSsmPatchGroup(),
-			"aws_ssm_parameter":                            resourceAwsSsmParameter(),
-			"aws_ssm_resource_data_sync":                   resourceAwsSsmResourceDataSync(),
-			"aws_spot_datafeed_subscription":               resourceAwsSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                    resourceAwsSpotInstanceRequest(),
-			"aws_spot_fleet_request":                       resourceAwsSpotFleetRequest(),
-			"aws_sqs_queue":                                resourceAwsSqsQueue(),
-			"aws_sqs_queue_policy":                         resourceAwsSqsQueuePolicy(),
-			"aws_snapshot_create_volume_permission":        resourceAwsSnapshotCreateVolumePermission(),
-			"aws_sns_platform_application":                 resourceAwsSnsPlatformApplication(),
-			"aws_sns_topic":                                resourceAwsSnsTopic(),
-			"aws_sns_topic_policy":                         resourceAwsSnsTopicPolicy(),
-			"aws_sns_topic_subscription":                   resourceAwsSnsTopicSubscription(),
-			"aws_sfn_activity":                             resourceAwsSfnActivity(),
-			"aws_sfn_state_machine":                        resourceAwsSfnStateMachine(),
-			"aws_default_subnet":                           resourceAwsDefaultSubnet(),
-			"aws_subnet":                                   resourceAwsSubnet(),
-			"aws_volume_attachment":                        resourceAwsVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":             resourceAwsVpcDhcpOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                 resourceAwsDefaultVpcDhcpOptions(),
-			"aws_vpc_dhcp_options":                         resourceAwsVpcDhcpOptions(),
-			"aws_vpc_peering_connection":                   resourceAwsVpcPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":          resourceAwsVpcPeeringConnectionAccepter(),
-			"aws_default_vpc":                              resourceAwsDefaultVpc(),
-			"aws_vpc":                                      resourceAwsVpc(),
-			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_connection_notification":     resourceAwsVpcEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_subnet_association":          resourceAwsVpcEndpointSubnetAssociation(),
-			"aws_vpc_endpoint_service":                     resourceAwsVpcEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":   resourceAwsVpcEndpointServiceAllowedPrincipal(),
-			"aws_vpn_connection":                           resourceAwsVpnConnection(),
-			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
-			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
-			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
-			"aws_waf_byte_match_set":                       resourceAwsWafByteMatchSet(),
-			"aws_waf_ipset":                                resourceAwsWafIPSet(),
-			"aws_waf_rule":                                 resourceAwsWafRule(),
-			"aws_waf_rate_based_rule":                      resourceAwsWafRateBasedRule(),
-			"aws_waf_size_constraint_set":                  resourceAwsWafSizeConstraintSet(),
-			"aws_waf_web_acl":                              resourceAwsWafWebAcl(),
-			"aws_waf_xss_match_set":                        resourceAwsWafXssMatchSet(),
-			"aws_waf_sql_injection_match_set":              resourceAwsWafSqlInjectionMatchSet(),
-			"aws_waf_geo_match_set":                        resourceAwsWafGeoMatchSet(),
-			"aws_wafregional_byte_match_set":               resourceAwsWafRegionalByteMatchSet(),
-			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
-			"aws_wafregional_size_constraint_set":          resourceAwsWafRegionalSizeConstraintSet(),
-			"aws_wafregional_sql_injection_match_set":      resourceAwsWafRegionalSqlInjectionMatchSet(),
-			"aws_wafregional_xss_match_set":                resourceAwsWafRegionalXssMatchSet(),
-			"aws_wafregional_rule":                         resourceAwsWafRegionalRule(),
-			"aws_wafregional_web_acl":                      resourceAwsWafRegionalWebAcl(),
-			"aws_wafregional_web_acl_association":          resourceAwsWafRegionalWebAclAssociation(),
-			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_definition":                     resourceAwsBatchJobDefinition(),
-			"aws_batch_job_queue":                          resourceAwsBatchJobQueue(),
+			"aws_acm_certificate":                                     resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":                          resourceAwsAcmCertificateValidation(),
+			"aws_ami":                                                 resourceAwsAmi(),
+			"aws_ami_copy":                                            resourceAwsAmiCopy(),
+			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
+			"aws_ami_launch_permission":                               resourceAwsAmiLaunchPermission(),
+			"aws_api_gateway_account":                                 resourceAwsApiGatewayAccount(),
+			"aws_api_gateway_api_key":                                 resourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_authorizer":                              resourceAwsApiGatewayAuthorizer(),
+			"aws_api_gateway_base_path_mapping":                       resourceAwsApiGatewayBasePathMapping(),
+			"aws_api_gateway_client_certificate":                      resourceAwsApiGatewayClientCertificate(),
+			"aws_api_gateway_deployment":                              resourceAwsApiGatewayDeployment(),
+			"aws_api_gateway_documentation_part":                      resourceAwsApiGatewayDocumentationPart(),
+			"aws_api_gateway_documentation_version":                   resourceAwsApiGatewayDocumentationVersion(),
+			"aws_api_gateway_domain_name":                             resourceAwsApiGatewayDomainName(),
+			"aws_api_gateway_gateway_response":                        resourceAwsApiGatewayGatewayResponse(),
+			"aws_api_gateway_integration":                             resourceAwsApiGatewayIntegration(),
+			"aws_api_gateway_integration_response":                    resourceAwsApiGatewayIntegrationResponse(),
+			"aws_api_gateway_method":                                  resourceAwsApiGatewayMethod(),
+			"aws_api_gateway_method_response":                         resourceAwsApiGatewayMethodResponse(),
+			"aws_api_gateway_method_settings":                         resourceAwsApiGatewayMethodSettings(),
+			"aws_api_gateway_model":                                   resourceAwsApiGatewayModel(),
+			"aws_api_gateway_request_validator":                       resourceAwsApiGatewayRequestValidator(),
+			"aws_api_gateway_resource":                                resourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                                resourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_stage":                                   resourceAwsApiGatewayStage(),
+			"aws_api_gateway_usage_plan":                              resourceAwsApiGatewayUsagePlan(),
+			"aws_api_gateway_usage_plan_key":                          resourceAwsApiGatewayUsagePlanKey(),
+			"aws_api_gateway_vpc_link":                                resourceAwsApiGatewayVpcLink(),
+			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
+			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
+			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
+			"aws_appautoscaling_scheduled_action":                     resourceAwsAppautoscalingScheduledAction(),
+			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
+			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
+			"aws_appsync_graphql_api":                                 resourceAwsAppsyncGraphqlApi(),
+			"aws_appsync_resolver":                                    resourceAwsAppsyncResolver(),
+			"aws_athena_database":                                     resourceAwsAthenaDatabase(),
+			"aws_athena_named_query":                                  resourceAwsAthenaNamedQuery(),
+			"aws_autoscaling_attachment":                              resourceAwsAutoscalingAttachment(),
+			"aws_autoscaling_group":                                   resourceAwsAutoscalingGroup(),
+			"aws_autoscaling_lifecycle_hook":                          resourceAwsAutoscalingLifecycleHook(),
+			"aws_autoscaling_notification":                            resourceAwsAutoscalingNotification(),
+			"aws_autoscaling_policy":                                  resourceAwsAutoscalingPolicy(),
+			"aws_autoscaling_schedule":                                resourceAwsAutoscalingSchedule(),
+			"aws_budgets_budget":                                      resourceAwsBudgetsBudget(),
+			"aws_cloud9_environment_ec2":                              resourceAwsCloud9EnvironmentEc2(),
+			"aws_cloudformation_stack":                                resourceAwsCloudFormationStack(),
+			"aws_cloudformation_stack_set":                            resourceAwsCloudFormationStackSet(),
+			"aws_cloudformation_stack_set_instance":                   resourceAwsCloudFormationStackSetInstance(),
+			"aws_cloudfront_distribution":                             resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
+			"aws_cloudwatch_event_rule":                               resourceAwsCloudWatchEventRule(),
+			"aws_cloudwatch_event_target":                             resourceAwsCloudWatchEventTarget(),
+			"aws_cloudwatch_log_destination":                          resourceAwsCloudWatchLogDestination(),
+			"aws_cloudwatch_log_destination_policy":                   resourceAwsCloudWatchLogDestinationPolicy(),
+			"aws_cloudwatch_log_group":                                resourceAwsCloudWatchLogGroup(),
+			"aws_cloudwatch_log_metric_filter":                        resourceAwsCloudWatchLogMetricFilter(),
+			"aws_cloudwatch_log_resource_policy":                      resourceAwsCloudWatchLogResourcePolicy(),
+			"aws_cloudwatch_log_stream":                               resourceAwsCloudWatchLogStream(),
+			"aws_cloudwatch_log_subscription_filter":                  resourceAwsCloudwatchLogSubscriptionFilter(),
+			"aws_config_config_rule":                                  resourceAwsConfigConfigRule(),
+			"aws_config_configuration_recorder":                       resourceAwsConfigConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":                resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_delivery_channel":                             resourceAwsConfigDeliveryChannel(),
+			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
+			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_resource_server":                             resourceAwsCognitoResourceServer(),
+			"aws_cognito_user_group":                                  resourceAwsCognitoUserGroup(),
+			"aws_cognito_user_pool":                                   resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                            resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                            resourceAwsCognitoUserPoolDomain(),
+			"aws_cognito_user_pool_ui_customization":                  resourceAwsCognitoUserPoolUICustomization(),
+			"aws_cloudwatch_metric_alarm":                             resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_dashboard":                                resourceAwsCloudWatchDashboard(),
+			"aws_codedeploy_app":                                      resourceAwsCodeDeployApp(),
+			"aws_codedeploy_deployment_config":                        resourceAwsCodeDeployDeploymentConfig(),
+			"aws_codedeploy_deployment_group":                         resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codecommit_repository":                               resourceAwsCodeCommitRepository(),
+			"aws_codecommit_trigger":                                  resourceAwsCodeCommitTrigger(),
+			"aws_codebuild_project":                                   resourceAwsCodeBuildProject(),
+			"aws_codepipeline":                                        resourceAwsCodePipeline(),
+			"aws_customer_gateway":                                    resourceAwsCustomerGateway(),
+			"aws_dax_cluster":                                         resourceAwsDaxCluster(),
+			"aws_db_cluster_snapshot":                                 resourceAwsDbClusterSnapshot(),
+			"aws_db_cluster_snapshot_copy":                            resourceAwsDbClusterSnapshotCopy(),
+			"aws_db_event_subscription":                               resourceAwsDbEventSubscription(),
+			"aws_db_instance":                                         resourceAwsDbInstance(),
+			"aws_db_option_group":                                     resourceAwsDbOptionGroup(),
+			"aws_db_parameter_group":                                  resourceAwsDbParameterGroup(),
+			"aws_db_security_group":                                   resourceAwsDbSecurityGroup(),
+			"aws_db_snapshot":                                         resourceAwsDbSnapshot(),
+			"aws_db_snapshot_copy":                                    resourceAwsDbSnapshotCopy(),
+			"aws_db_subnet_group":                                     resourceAwsDbSubnetGroup(),
+			"aws_devicefarm_project":                                  resourceAwsDevicefarmProject(),
+			"aws_directory_service_directory":                         resourceAwsDirectoryServiceDirectory(),
+			"aws_dms_certificate":                                     resourceAwsDmsCertificate(),
+			"aws_dms_endpoint":                                        resourceAwsDmsEndpoint(),
+			"aws_dms_replication_instance":                            resourceAwsDmsReplicationInstance(),
+			"aws_dms_replication_subnet_group":                        resourceAwsDmsReplicationSubnetGroup(),
+			"aws_dms_replication_task":                                resourceAwsDmsReplicationTask(),
+			"aws_dx_lag":                                              resourceAwsDxLag(),
+			"aws_dx_connection":                                       resourceAwsDxConnection(),
+			"aws_dx_connection_association":                           resourceAwsDxConnectionAssociation(),
+			"aws_dynamodb_table":                                      resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_backup":                               resourceAwsDynamoDbTableBackup(),
+			"aws_dynamodb_table_item":                                 resourceAwsDynamoDbTableItem(),
+			"aws_dynamodb_global_table":                               resourceAwsDynamoDbGlobalTable(),
+			"aws_ebs_snapshot":                                        resourceAwsEbsSnapshot(),
+			"aws_ebs_volume":                                          resourceAwsEbsVolume(),
+			"aws_ecr_lifecycle_policy":                                resourceAwsEcrLifecyclePolicy(),
+			"aws_ecr_repository":                                      resourceAwsEcrRepository(),
+			"aws_ecr_repository_policy":                               resourceAwsEcrRepositoryPolicy(),
+			"aws_ecs_cluster":                                         resourceAwsEcsCluster(),
+			"aws_ecs_service":                                         resourceAwsEcsService(),
+			"aws_ecs_task_definition":                                 resourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                                     resourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                                    resourceAwsEfsMountTarget(),
+			"aws_egress_only_internet_gateway":                        resourceAwsEgressOnlyInternetGateway(),
+			"aws_eip":                                                 resourceAwsEip(),
+			"aws_eip_association":                                     resourceAwsEipAssociation(),
+			"aws_elasticache_cluster":                                 resourceAwsElasticacheCluster(),
+			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
+			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
+			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
+			"aws_elastic_beanstalk_application":                       resourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_application_version":               resourceAwsElasticBeanstalkApplicationVersion(),
+			"aws_elastic_beanstalk_configuration_template":            resourceAwsElasticBeanstalkConfigurationTemplate(),
+			"aws_elastic_beanstalk_environment":                       resourceAwsElasticBeanstalkEnvironment(),
+			"aws_elasticsearch_domain":                                resourceAwsElasticSearchDomain(),
+			"aws_elasticsearch_domain_policy":                         resourceAwsElasticSearchDomainPolicy(),
+			"aws_elastictranscoder_pipeline":                          resourceAwsElasticTranscoderPipeline(),
+			"aws_elastictranscoder_preset":                            resourceAwsElasticTranscoderPreset(),
+			"aws_elb":                                                 resourceAwsElb(),
+			"aws_elb_attachment":                                      resourceAwsElbAttachment(),
+			"aws_emr_cluster":                                         resourceAwsEMRCluster(),
+			"aws_emr_instance_group":                                  resourceAwsEMRInstanceGroup(),
+			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
+			"aws_flow_log":                                            resourceAwsFlowLog(),
+			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
+			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
+			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
+			"aws_glacier_vault":                                       resourceAwsGlacierVault(),
+			"aws_glue_catalog_database":                               resourceAwsGlueCatalogDatabase(),
+			"aws_glue_catalog_table":                                  resourceAwsGlueCatalogTable(),
+			"aws_glue_classifier":                                     resourceAwsGlueClassifier(),
+			"aws_glue_connection":                                     resourceAwsGlueConnection(),
+			"aws_glue_crawler":                                        resourceAwsGlueCrawler(),
+			"aws_glue_job":                                            resourceAwsGlueJob(),
+			"aws_glue_trigger":                                        resourceAwsGlueTrigger(),
+			"aws_guardduty_detector":                                  resourceAwsGuardDutyDetector(),
+			"aws_guardduty_ipset":                                     resourceAwsGuardDutyIpset(),
+			"aws_guardduty_member":                                    resourceAwsGuardDutyMember(),
+			"aws_guardduty_threatintelset":                            resourceAwsGuardDutyThreatintelset(),
+			"aws_iam_access_key":                                      resourceAwsIamAccessKey(),
+			"aws_iam_account_alias":                                   resourceAwsIamAccountAlias(),
+			"aws_iam_account_password_policy":                         resourceAwsIamAccountPasswordPolicy(),
+			"aws_iam_group_policy":                                    resourceAwsIamGroupPolicy(),
+			"aws_iam_group":                                           resourceAwsIamGroup(),
+			"aws_iam_group_membership":                                resourceAwsIamGroupMembership(),
+			"aws_iam_group_policy_attachment":                         resourceAwsIamGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                                resourceAwsIamInstanceProfile(),
+			"aws_iam_openid_connect_provider":                         resourceAwsIamOpenIDConnectProvider(),
+			"aws_iam_policy":                                          resourceAwsIamPolicy(),
+			"aws_iam_policy_attachment":                               resourceAwsIamPolicyAttachment(),
+			"aws_iam_role_policy_attachment":                          resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_role_policy":                                     resourceAwsIamRolePolicy(),
+			"aws_iam_role":                                            resourceAwsIamRole(),
+			"aws_iam_saml_provider":                                   resourceAwsIamSamlProvider(),
+			"aws_iam_server_certificate":                              resourceAwsIAMServerCertificate(),
+			"aws_iam_user_policy_attachment":                          resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_user_policy":                                     resourceAwsIamUserPolicy(),
+			"aws_iam_user_ssh_key":                                    resourceAwsIamUserSshKey(),
+			"aws_iam_user":                                            resourceAwsIamUser(),
+			"aws_iam_user_login_profile":                              resourceAwsIamUserLoginProfile(),
+			"aws_inspector_assessment_target":                         resourceAWSInspectorAssessmentTarget(),
+			"aws_inspector_assessment_template":                       resourceAWSInspectorAssessmentTemplate(),
+			"aws_inspector_resource_group":                            resourceAWSInspectorResourceGroup(),
+			"aws_instance":                                            resourceAwsInstance(),
+			"aws_internet_gateway":                                    resourceAwsInternetGateway(),
+			"aws_iot_certificate":                                     resourceAwsIotCertificate(),
+			"aws_iot_policy":                                          resourceAwsIotPolicy(),
+			"aws_iot_thing":                                           resourceAwsIotThing(),
+			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
+			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
+			"aws_key_pair":                                            resourceAwsKeyPair(),
+			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
+			"aws_kms_alias":                                           resourceAwsKmsAlias(),
+			"aws_kms_grant":                                           resourceAwsKmsGrant(),
+			"aws_kms_key":                                             resourceAwsKmsKey(),
+			"aws_lambda_function":                                     resourceAwsLambdaFunction(),
+			"aws_lambda_event_source_mapping":                         resourceAwsLambdaEventSourceMapping(),
+			"aws_lambda_alias":                                        resourceAwsLambdaAlias(),
+			"aws_lambda_permission":                                   resourceAwsLambdaPermission(),
+			"aws_launch_configuration":                                resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                                     resourceAwsLaunchTemplate(),
+			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
+			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
+			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
+			"aws_lightsail_static_ip":                                 resourceAwsLightsailStaticIp(),
+			"aws_lightsail_static_ip_attachment":                      resourceAwsLightsailStaticIpAttachment(),
+			"aws_lb_cookie_stickiness_policy":                         resourceAwsLBCookieStickinessPolicy(),
+			"aws_load_balancer_policy":                                resourceAwsLoadBalancerPolicy(),
+			"aws_load_balancer_backend_server_policy":                 resourceAwsLoadBalancerBackendServerPolicies(),
+			"aws_load_balancer_listener_policy":                       resourceAwsLoadBalancerListenerPolicies(),
+			"aws_lb_ssl_negotiation_policy":                           resourceAwsLBSSLNegotiationPolicy(),
+			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
+			"aws_mq_broker":                                           resourceAwsMqBroker(),
+			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
+			"aws_media_store_container":                               resourceAwsMediaStoreContainer(),
+			"aws_nat_gateway":                                         resourceAwsNatGateway(),
+			"aws_network_acl":                                         resourceAwsNetworkAcl(),
+			"aws_default_network_acl":                                 resourceAwsDefaultNetworkAcl(),
+			"aws_network_acl_rule":                                    resourceAwsNetworkAclRule(),
+			"aws_network_interface":                                   resourceAwsNetworkInterface(),
+			"aws_network_interface_attachment":                        resourceAwsNetworkInterfaceAttachment(),
+			"aws_opsworks_application":                                resourceAwsOpsworksApplication(),
+			"aws_opsworks_stack":                                      resourceAwsOpsworksStack(),
+			"aws_opsworks_java_app_layer":                             resourceAwsOpsworksJavaAppLayer(),
+			"aws_opsworks_haproxy_layer":                              resourceAwsOpsworksHaproxyLayer(),
+			"aws_opsworks_static_web_layer":                           resourceAwsOpsworksStaticWebLayer(),
+			"aws_opsworks_php_app_layer":                              resourceAwsOpsworksPhpAppLayer(),
+			"aws_opsworks_rails_app_layer":                            resourceAwsOpsworksRailsAppLayer(),
+			"aws_opsworks_nodejs_app_layer":                           resourceAwsOpsworksNodejsAppLayer(),
+			"aws_opsworks_memcached_layer":                            resourceAwsOpsworksMemcachedLayer(),
+			"aws_opsworks_mysql_layer":                                resourceAwsOpsworksMysqlLayer(),
+			"aws_opsworks_ganglia_layer":                              resourceAwsOpsworksGangliaLayer(),
+			"aws_opsworks_custom_layer":                               resourceAwsOpsworksCustomLayer(),
+			"aws_opsworks_instance":                                   resourceAwsOpsworksInstance(),
+			"aws_opsworks_user_profile":                               resourceAwsOpsworksUserProfile(),
+			"aws_opsworks_permission":                                 resourceAwsOpsworksPermission(),
+			"aws_opsworks_rds_db_instance":                            resourceAwsOpsworksRdsDbInstance(),
+			"aws_organizations_account":                               resourceAwsOrganizationsAccount(),
+			"aws_organizations_organization":                          resourceAwsOrganizationsOrganization(),
+			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
+			"aws_organizations_policy":                                resourceAwsOrganizationsPolicy(),
+			"aws_organizations_policy_attachment":                     resourceAwsOrganizationsPolicyAttachment(),
+			"aws_placement_group":                                     resourceAwsPlacementGroup(),
+			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
+			"aws_rds_cluster":                                         resourceAwsRDSCluster(),
+			"aws_rds_cluster_instance":                                resourceAwsRDSClusterInstance(),
+			"aws_rds_cluster_parameter_group":                         resourceAwsRDSClusterParameterGroup(),
+			"aws_redshift_cluster":                                    resourceAwsRedshiftCluster(),
+			"aws_redshift_security_group":                             resourceAwsRedshiftSecurityGroup(),
+			"aws_redshift_parameter_group":                            resourceAwsRedshiftParameterGroup(),
+			"aws_redshift_subnet_group":                               resourceAwsRedshiftSubnetGroup(),
+			"aws_route53_delegation_set":                              resourceAwsRoute53DelegationSet(),
+			"aws_route53_query_log":                                   resourceAwsRoute53QueryLog(),
+			"aws_route53_record":                                      resourceAwsRoute53Record(),
+			"aws_route53_zone_association":                            resourceAwsRoute53ZoneAssociation(),
+			"aws_route53_zone":                                        resourceAwsRoute53Zone(),
+			"aws_route53_health_check":                                resourceAwsRoute53HealthCheck(),
+			"aws_route":                                               resourceAwsRoute(),
+			"aws_route_table":                                         resourceAwsRouteTable(),
+			"aws_default_route_table":                                 resourceAwsDefaultRouteTable(),
+			"aws_route_table_association":                             resourceAwsRouteTableAssociation(),
+			"aws_sagemaker_endpoint":                                  resourceAwsSagemakerEndpoint(),
+			"aws_sagemaker_endpoint_configuration":                    resourceAwsSagemakerEndpointConfiguration(),
+			"aws_sagemaker_model":                                     resourceAwsSagemakerModel(),
+			"aws_sagemaker_notebook_instance":                         resourceAwsSagemakerNotebookInstance(),
+			"aws_sagemaker_notebook_instance_lifecycle_configuration": resourceAwsSagemakerNotebookInstanceLifecycleConfiguration(),
+			"aws_ses_active_receipt_rule_set":                         resourceAwsSesActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":                                 resourceAwsSesDomainIdentity(),
+			"aws_ses_domain_dkim":                                     resourceAwsSesDomainDkim(),
+			"aws_ses_domain_mail_from":                                resourceAwsSesDomainMailFrom(),
+			"aws_ses_receipt_filter":                                  resourceAwsSesReceiptFilter(),
+			"aws_ses_receipt_rule":                                    resourceAwsSesReceiptRule(),
+			"aws_ses_receipt_rule_set":                                resourceAwsSesReceiptRuleSet(),
+			"aws_ses_configuration_set":                               resourceAwsSesConfigurationSet(),
+			"aws_ses_event_destination":                               resourceAwsSesEventDestination(),
+			"aws_ses_template":                                        resourceAwsSesTemplate(),
+			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
+			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
+			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
+			"aws_security_group":                                      resourceAwsSecurityGroup(),
+			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
+			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_rule":                                 resourceAwsSecurityGroupRule(),
+			"aws_servicecatalog_portfolio":                            resourceAwsServiceCatalogPortfolio(),
+			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_service_discovery_service":                           resourceAwsServiceDiscoveryService(),
+			"aws_simpledb_domain":                                     resourceAwsSimpleDBDomain(),
+			"aws_ssm_activation":                                      resourceAwsSsmActivation(),
+			"aws_ssm_association":                                     resourceAwsSsmAssociation(),
+			"aws_ssm_document":                                        resourceAwsSsmDocument(),
+			"aws_ssm_maintenance_window":                              resourceAwsSsmMaintenanceWindow(),
+			"aws_ssm_maintenance_window_target":                       resourceAwsSsmMaintenanceWindowTarget(),
+			"aws_ssm_maintenance_window_task":                         resourceAwsSsmMaintenanceWindowTask(),
+			"aws_ssm_patch_baseline":                                  resourceAwsSsmPatchBaseline(),
+			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
+			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
+			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_spot_datafeed_subscription":                          resourceAwsSpotDataFeedSubscription(),
+			"aws_spot_instance_request":                               resourceAwsSpotInstanceRequest(),
+			"aws_spot_fleet_request":                                  resourceAwsSpotFleetRequest(),
+			"aws_sqs_queue":                                           resourceAwsSqsQueue(),
+			"aws_sqs_queue_policy":                                    resourceAwsSqsQueuePolicy(),
+			"aws_snapshot_create_volume_permission":                   resourceAwsSnapshotCreateVolumePermission(),
+			"aws_sns_platform_application":                            resourceAwsSnsPlatformApplication(),
+			"aws_sns_topic":                                           resourceAwsSnsTopic(),
+			"aws_sns_topic_policy":                                    resourceAwsSnsTopicPolicy(),
+			"aws_sns_topic_subscription":                              resourceAwsSnsTopicSubscription(),
+			"aws_sfn_activity":                                        resourceAwsSfnActivity(),
+			"aws_sfn_state_machine":                                   resourceAwsSfnStateMachine(),
+			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
+			"aws_subnet":                                              resourceAwsSubnet(),
+			"aws_volume_attachment":                                   resourceAwsVolumeAttachment(),
+			"aws_vpc_dhcp_options_association":                        resourceAwsVpcDhcpOptionsAssociation(),
+			"aws_default_vpc_dhcp_options":                            resourceAwsDefaultVpcDhcpOptions(),
+			"aws_vpc_dhcp_options":                                    resourceAwsVpcDhcpOptions(),
+			"aws_vpc_peering_connection":                              resourceAwsVpcPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                     resourceAwsVpcPeeringConnectionAccepter(),
+			"aws_default_vpc":                                         resourceAwsDefaultVpc(),
+			"aws_vpc":                                                 resourceAwsVpc(),
+			"aws_vpc_endpoint":                                        resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_connection_notification":                resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":                resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_subnet_association":                     resourceAwsVpcEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_service":                                resourceAwsVpcEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":              resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpc_ipv4_cidr_block_association":                     resourceAwsVpcIpv4CidrBlockAssociation(),
+			"aws_vpn_connection":                                      resourceAwsVpnConnection(),
+			"aws_vpn_connection_route":                                resourceAwsVpnConnectionRoute(),
+			"aws_vpn_gateway":                                         resourceAwsVpnGateway(),
+			"aws_vpn_gateway_attachment":                              resourceAwsVpnGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                       resourceAwsVpnGatewayRoutePropagation(),
+			"aws_waf_byte_match_set":                                  resourceAwsWafByteMatchSet(),
+			"aws_waf_ipset":                                           resourceAwsWafIPSet(),
+			"aws_waf_rule":                                            resourceAwsWafRule(),
+			"aws_waf_rate_based_rule":                                 resourceAwsWafRateBasedRule(),
+			"aws_waf_size_constraint_set":                             resourceAwsWafSizeConstraintSet(),
+			"aws_waf_web_acl":                                         resourceAwsWafWebAcl(),
+			"aws_waf_xss_match_set":                                   resourceAwsWafXssMatchSet(),
+			"aws_waf_sql_injection_match_set":                         resourceAwsWafSqlInjectionMatchSet(),
+			"aws_waf_geo_match_set":                                   resourceAwsWafGeoMatchSet(),
+			"aws_wafregional_byte_match_set":                          resourceAwsWafRegionalByteMatchSet(),
+			"aws_wafregional_ipset":                                   resourceAwsWafRegionalIPSet(),
+			"aws_wafregional_size_constraint_set":                     resourceAwsWafRegionalSizeConstraintSet(),
+			"aws_wafregional_sql_injection_match_set":                 resourceAwsWafRegionalSqlInjectionMatchSet(),
+			"aws_wafregional_xss_match_set":                           resourceAwsWafRegionalXssMatchSet(),
+			"aws_wafregional_rule":                                    resourceAwsWafRegionalRule(),
+			"aws_wafregional_web_acl":                                 resourceAwsWafRegionalWebAcl(),
+			"aws_wafregional_web_acl_association":                     resourceAwsWafRegionalWebAclAssociation(),
+			"aws_batch_compute_environment":                           resourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_definition":                                resourceAwsBatchJobDefinition(),
+			"aws_batch_job_queue":                                     resourceAwsBatchJobQueue(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
@@ -585,6 +734,21 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, r := range provider.DataSourcesMap {
+		dataSourceIgnoreTags(r)
+		if !isGlobalResource(name) {
+			dataSourceRegionOverride(r)
+		}
+	}
+	for name, r := range provider.ResourcesMap {
+		resourceDefaultTags(r)
+		if !isGlobalResource(name) {
+			resourceRegionOverride(r)
+		}
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -594,6 +758,9 @@ func init() {
 		"region": "The region where AWS operations will take place. Examples\n" +
 			"are us-east-1, us-west-2, etc.",
 
+		"resource_region": "The region in which to manage the resource. Defaults to the\n" +
+			"region of the provider.",
+
 		"access_key": "The access key for API operations. You can retrieve this\n" +
 			"from the 'Security & Credentials' section of the AWS console.",
 
@@ -672,6 +839,36 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"default_tags": "Configuration block with settings to apply tags to all taggable resources.",
+
+		"default_tags_tags": "Resource tags to default across all taggable resources. Tags set\n" +
+			"on an individual resource take precedence over these.",
+
+		"ignore_tags": "Configuration block with settings to ignore tags across all resources.",
+
+		"ignore_tags_keys": "Tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Tag key prefixes to ignore across all resources.",
+
+		"retry": "Configuration block with settings for retrying and rate limiting AWS API requests.",
+
+		"retry_max_attempts": "The maximum number of attempts for an AWS API request, including\n" +
+			"the first one. Overrides max_retries when set.",
+
+		"retry_min_backoff": "The delay before the first retry, e.g. 100ms.",
+
+		"retry_max_backoff": "The maximum delay between retries, e.g. 30s.",
+
+		"retry_jitter": "Whether to randomise retry delays.",
+
+		"retry_rate_limit": "The maximum number of AWS API requests per second made through\n" +
+			"each service client. 0 means unlimited.",
+
+		"retry_burst": "The number of requests each service client may make in a burst\n" +
+			"above rate_limit.",
+
+		"retry_service": "Per-service retryable error codes and rate limits.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -683,6 +880,12 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_policy_arns": "The ARNs of managed policies to use as session policies when" +
+			" assuming the role.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. If omitted," +
+			" the session lasts 15 minutes.",
 	}
 }
 
@@ -710,20 +913,27 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
-
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
+	for i, raw := range d.Get("assume_role").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		assumeRole := expandProviderAssumeRole(raw.(map[string]interface{}))
+
+		if i == 0 {
+			config.AssumeRoleARN = assumeRole.RoleARN
+			config.AssumeRoleSessionName = assumeRole.SessionName
+			config.AssumeRoleExternalID = assumeRole.ExternalID
+			config.AssumeRolePolicy = assumeRole.Policy
+			config.AssumeRolePolicyARNs = assumeRole.PolicyARNs
+			config.AssumeRoleDurationSeconds = assumeRole.DurationSeconds
+		} else {
+			config.AssumeRoleChain = append(config.AssumeRoleChain, assumeRole)
 		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
-	} else {
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, PolicyARNs: %q, DurationSeconds: %d)",
+			assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.Policy, assumeRole.PolicyARNs, assumeRole.DurationSeconds)
+	}
+	if config.AssumeRoleARN == "" {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
@@ -731,28 +941,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.AcmEndpoint = endpoints["acm"].(string)
-		config.ApigatewayEndpoint = endpoints["apigateway"].(string)
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.EcrEndpoint = endpoints["ecr"].(string)
-		config.EcsEndpoint = endpoints["ecs"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.LambdaEndpoint = endpoints["lambda"].(string)
-		config.R53Endpoint = endpoints["r53"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
-		config.StsEndpoint = endpoints["sts"].(string)
+		config.Endpoints = make(map[string]string)
+		for _, service := range awsServiceClients {
+			if v := endpoints[service.Name].(string); v != "" {
+				config.Endpoints[service.Name] = v
+			}
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -763,6 +957,25 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.ForbiddenAccountIds = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOk("default_tags"); ok {
+		defaultTags := v.([]interface{})
+		if len(defaultTags) > 0 && defaultTags[0] != nil {
+			config.DefaultTags = defaultTags[0].(map[string]interface{})["tags"].(map[string]interface{})
+		}
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok {
+		config.IgnoreTags = expandIgnoreTagsConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retry"); ok {
+		retry, err := expandRetryConfig(v.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		config.Retry = retry
+	}
+
 	return config.Client()
 }
 
@@ -771,9 +984,8 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -799,154 +1011,66 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+					Set:         schema.HashString,
+					Description: descriptions["assume_role_policy_arns"],
+				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
+				},
 			},
 		},
 	}
 }
 
+func expandProviderAssumeRole(m map[string]interface{}) *AssumeRole {
+	assumeRole := &AssumeRole{
+		RoleARN:         m["role_arn"].(string),
+		SessionName:     m["session_name"].(string),
+		ExternalID:      m["external_id"].(string),
+		Policy:          m["policy"].(string),
+		DurationSeconds: m["duration_seconds"].(int),
+	}
+
+	if v, ok := m["policy_arns"].(*schema.Set); ok {
+		for _, arn := range v.List() {
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, arn.(string))
+		}
+	}
+
+	return assumeRole
+}
+
 func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, service := range awsServiceClients {
+		description, ok := descriptions[service.Name+"_endpoint"]
+		if !ok {
+			description = "Use this to override the default endpoint URL constructed from the `region`.\n"
+		}
+
+		endpointsAttributes[service.Name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: description,
+		}
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"acm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["acm_endpoint"],
-				},
-				"apigateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["apigateway_endpoint"],
-				},
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
-				},
-				"cloudwatchevents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
-				},
-				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
-				},
-				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
-				},
-				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
-				},
-				"dynamodb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
-				},
-				"iam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
-				},
-
-				"ec2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
-				},
-
-				"ecr": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecr_endpoint"],
-				},
-
-				"ecs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecs_endpoint"],
-				},
-
-				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
-				},
-				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
-				},
-				"kms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
-				},
-				"lambda": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["lambda_endpoint"],
-				},
-				"r53": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["r53_endpoint"],
-				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
-				},
-				"s3": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
-				},
-				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
-				},
-				"sqs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
-				},
-				"sts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sts_endpoint"],
-				},
-			},
+			Schema: endpointsAttributes,
 		},
 		Set: endpointsToHash,
 	}
@@ -955,23 +1079,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["apigateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["lambda"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, service := range awsServiceClients {
+		buf.WriteString(fmt.Sprintf("%s-", m[service.Name].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -146,3 +148,47 @@ func testAccCheckWithProviders(f func(*terraform.State, *schema.Provider) error,
 		return nil
 	}
 }
+
+// testAccAWSImportStateIdFunc returns an ImportStateIdFunc which builds the
+// import ID of the named resource by joining the given attributes with "/".
+func testAccAWSImportStateIdFunc(resourceName string, attributes ...string) resource.ImportStateIdFunc {
+	return testAccAWSImportStateIdFuncWithSeparator(resourceName, "/", attributes...)
+}
+
+// testAccAWSImportStateIdFuncWithSeparator is testAccAWSImportStateIdFunc for
+// the resources whose attributes may themselves contain a "/".
+func testAccAWSImportStateIdFuncWithSeparator(resourceName, separator string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		parts := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			parts = append(parts, rs.Primary.Attributes[attribute])
+		}
+
+		return strings.Join(parts, separator), nil
+	}
+}
+
+// testAccCheckAWSImportStateAttributesSet returns an ImportStateCheckFunc which
+// verifies that a single resource was imported with the given attributes set.
+// It is used for the resources whose ID is generated on creation, which
+// ImportStateVerify is not able to match against the existing state.
+func testAccCheckAWSImportStateAttributesSet(attributes ...string) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d: %#v", len(s), s)
+		}
+
+		for _, attribute := range attributes {
+			if s[0].Attributes[attribute] == "" {
+				return fmt.Errorf("expected imported attribute %q to be set", attribute)
+			}
+		}
+
+		return nil
+	}
+}
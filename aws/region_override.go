@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// globalResourcePrefixes lists the resources of global services, which do
+// not accept a region override.
+var globalResourcePrefixes = []string{
+	"aws_budgets_",
+	"aws_cloudfront_",
+	"aws_iam_",
+	"aws_organizations_",
+	"aws_route53_",
+	"aws_waf_",
+}
+
+func isGlobalResource(name string) bool {
+	for _, prefix := range globalResourcePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceRegionOverride extends a resource with an optional "region"
+// argument. When set, the resource is managed in that region with a client
+// from AWSClient.regionalClient instead of the provider region. Resources
+// that already have a "region" attribute are left alone.
+//
+// Resources in another region are imported by appending "@<region>" to the
+// import ID.
+func resourceRegionOverride(r *schema.Resource) {
+	if _, ok := r.Schema["region"]; ok {
+		return
+	}
+
+	r.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: descriptions["resource_region"],
+	}
+
+	r.Create = withRegionalClient(r.Create)
+	r.Read = withRegionalClient(r.Read)
+	r.Delete = withRegionalClient(r.Delete)
+	if r.Update != nil {
+		r.Update = withRegionalClient(r.Update)
+	}
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, err := regionalMeta(meta, d.Get("region").(string))
+			if err != nil {
+				return false, err
+			}
+			return exists(d, client)
+		}
+	}
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+			client, err := regionalMeta(meta, diff.Get("region").(string))
+			if err != nil {
+				return err
+			}
+			return customizeDiff(diff, client)
+		}
+	}
+
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, region := parseRegionImportID(d.Id())
+			if region == "" {
+				return state(d, meta)
+			}
+
+			client, err := regionalMeta(meta, region)
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+			results, err := state(d, client)
+			for _, result := range results {
+				if serr := result.Set("region", region); serr != nil {
+					log.Printf("[DEBUG] Not setting region of imported resource %s: %s", result.Id(), serr)
+				}
+			}
+			return results, err
+		}
+	}
+}
+
+// dataSourceRegionOverride extends a data source with an optional "region"
+// argument, read with the client of that region.
+func dataSourceRegionOverride(r *schema.Resource) {
+	if _, ok := r.Schema["region"]; ok {
+		return
+	}
+
+	r.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: descriptions["resource_region"],
+	}
+
+	r.Read = withRegionalClient(r.Read)
+}
+
+func withRegionalClient(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, err := regionalMeta(meta, d.Get("region").(string))
+		if err != nil {
+			return err
+		}
+		return f(d, client)
+	}
+}
+
+// regionalMeta returns the provider meta to use for a region.
+func regionalMeta(meta interface{}, region string) (interface{}, error) {
+	client, ok := meta.(*AWSClient)
+	if !ok || region == "" {
+		return meta, nil
+	}
+	return client.regionalClient(region)
+}
+
+// parseRegionImportID splits an import ID of the form "<id>@<region>". The
+// suffix is only treated as a region if it names a known region, as IDs such
+// as SES email identities contain "@" themselves.
+func parseRegionImportID(id string) (string, string) {
+	i := strings.LastIndex(id, "@")
+	if i < 0 || !isValidRegion(id[i+1:]) {
+		return id, ""
+	}
+	return id[:i], id[i+1:]
+}
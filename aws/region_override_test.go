@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestParseRegionImportID(t *testing.T) {
+	cases := []struct {
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{"i-1234567890abcdef0", "i-1234567890abcdef0", ""},
+		{"i-1234567890abcdef0@eu-west-1", "i-1234567890abcdef0", "eu-west-1"},
+		{"user@example.com", "user@example.com", ""},
+		{"user@example.com@us-west-2", "user@example.com", "us-west-2"},
+		{"vpc-12345678@nowhere-1", "vpc-12345678@nowhere-1", ""},
+	}
+
+	for _, tc := range cases {
+		id, region := parseRegionImportID(tc.ID)
+		if id != tc.ExpectedID || region != tc.ExpectedRegion {
+			t.Fatalf("%s: expected (%q, %q), got (%q, %q)", tc.ID, tc.ExpectedID, tc.ExpectedRegion, id, region)
+		}
+	}
+}
+
+func TestResourceRegionOverride(t *testing.T) {
+	base := &AWSClient{region: "us-east-1"}
+	regional := &AWSClient{region: "eu-west-1"}
+	base.regionalclients = &awsRegionalClients{
+		clients: map[string]*AWSClient{"us-east-1": base, "eu-west-1": regional},
+	}
+	regional.regionalclients = base.regionalclients
+
+	var used *AWSClient
+	record := func(d *schema.ResourceData, meta interface{}) error {
+		used = meta.(*AWSClient)
+		return nil
+	}
+
+	r := &schema.Resource{
+		Create: record,
+		Read:   record,
+		Delete: record,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				used = meta.(*AWSClient)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+	resourceRegionOverride(r)
+
+	if s, ok := r.Schema["region"]; !ok || !s.Optional || !s.ForceNew {
+		t.Fatalf("Expected an optional ForceNew region argument, got %#v", s)
+	}
+
+	d := r.TestResourceData()
+	if err := r.Create(d, base); err != nil {
+		t.Fatal(err)
+	}
+	if used != base {
+		t.Fatalf("Expected the provider client without a region override, got %s", used.region)
+	}
+
+	d.Set("region", "eu-west-1")
+	if err := r.Read(d, base); err != nil {
+		t.Fatal(err)
+	}
+	if used != regional {
+		t.Fatalf("Expected the eu-west-1 client, got %s", used.region)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("example@eu-west-1")
+	results, err := r.Importer.State(d, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != regional {
+		t.Fatalf("Expected the eu-west-1 client on import, got %s", used.region)
+	}
+	if results[0].Id() != "example" {
+		t.Fatalf("Expected import ID %q, got %q", "example", results[0].Id())
+	}
+	if region := results[0].Get("region").(string); region != "eu-west-1" {
+		t.Fatalf("Expected imported region %q, got %q", "eu-west-1", region)
+	}
+}
+
+func TestResourceRegionOverride_provider(t *testing.T) {
+	p := Provider().(*schema.Provider)
+
+	if _, ok := p.ResourcesMap["aws_instance"].Schema["region"]; !ok {
+		t.Fatal("Expected aws_instance to accept a region argument")
+	}
+	if _, ok := p.DataSourcesMap["aws_ami"].Schema["region"]; !ok {
+		t.Fatal("Expected aws_ami data source to accept a region argument")
+	}
+	if _, ok := p.ResourcesMap["aws_iam_role"].Schema["region"]; ok {
+		t.Fatal("Expected global aws_iam_role not to accept a region argument")
+	}
+	if s := p.ResourcesMap["aws_s3_bucket"].Schema["region"]; !s.Computed {
+		t.Fatal("Expected the existing aws_s3_bucket region attribute to be kept")
+	}
+}
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: awsCredentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{Region: "us-west-2"}
+	client := &AWSClient{region: "us-west-2", accountid: "123456789012", config: c, sess: sess}
+	client.regionalclients = &awsRegionalClients{
+		clients: map[string]*AWSClient{"us-west-2": client},
+	}
+	if err := c.configureServiceClients(client, sess); err != nil {
+		t.Fatal(err)
+	}
+
+	if regional, err := client.regionalClient(""); err != nil || regional != client {
+		t.Fatalf("Expected the provider client for an empty region, got %v, %v", regional, err)
+	}
+
+	regional, err := client.regionalClient("eu-central-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regional.region != "eu-central-1" || regional.accountid != "123456789012" || regional.partition != "aws" {
+		t.Fatalf("Unexpected regional client: region %q, account %q, partition %q", regional.region, regional.accountid, regional.partition)
+	}
+	if region := aws.StringValue(regional.ec2conn.Config.Region); region != "eu-central-1" {
+		t.Fatalf("Expected EC2 connection in eu-central-1, got %q", region)
+	}
+
+	cached, err := client.regionalClient("eu-central-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached != regional {
+		t.Fatal("Expected the regional client to be cached")
+	}
+	if back, _ := regional.regionalClient("us-west-2"); back != client {
+		t.Fatal("Expected the provider client to be returned for the provider region")
+	}
+
+	if _, err := client.regionalClient("nowhere-1"); err == nil {
+		t.Fatal("Expected an error for an unknown region")
+	}
+}
@@ -97,7 +97,7 @@ func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(*resp.CertificateArn)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
 			Tags:           tagsFromMapACM(v.(map[string]interface{})),
@@ -176,7 +176,7 @@ func resourceAwsAcmCertificateGuessValidationMethod(domainValidationOptions []ma
 }
 
 func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		acmconn := meta.(*AWSClient).acmconn
 		err := setTagsACM(acmconn, d)
 		if err != nil {
@@ -30,6 +30,9 @@ func resourceAwsAmi() *schema.Resource {
 
 	return &schema.Resource{
 		Create: resourceAwsAmiCreate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(AWSAMIRetryTimeout),
@@ -17,6 +17,9 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 		Create: resourceAwsAmiLaunchPermissionCreate,
 		Read:   resourceAwsAmiLaunchPermissionRead,
 		Delete: resourceAwsAmiLaunchPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAmiLaunchPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"image_id": &schema.Schema{
@@ -41,6 +44,19 @@ func resourceAwsAmiLaunchPermissionExists(d *schema.ResourceData, meta interface
 	return hasLaunchPermission(conn, image_id, account_id)
 }
 
+func resourceAwsAmiLaunchPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected IMAGE-ID/ACCOUNT-ID", d.Id())
+	}
+
+	d.Set("image_id", idParts[0])
+	d.Set("account_id", idParts[1])
+	d.SetId(fmt.Sprintf("%s-%s", idParts[0], idParts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
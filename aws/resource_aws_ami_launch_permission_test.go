@@ -32,6 +32,12 @@ func TestAccAWSAMILaunchPermission_Basic(t *testing.T) {
 					testAccAWSAMILaunchPermissionExists(accountID, &imageID),
 				),
 			},
+			r.TestStep{
+				ResourceName:      "aws_ami_launch_permission.self-test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_ami_launch_permission.self-test", "image_id", "account_id"),
+				ImportStateVerify: true,
+			},
 			// Drop just launch permission to test destruction
 			r.TestStep{
 				Config: testAccAWSAMILaunchPermissionConfig(accountID, false),
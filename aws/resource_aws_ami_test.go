@@ -34,6 +34,12 @@ func TestAccAWSAMI_basic(t *testing.T) {
 						"aws_ami.foo", "root_snapshot_id", regexp.MustCompile("^snap-")),
 				),
 			},
+			{
+				ResourceName:            "aws_ami.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manage_ebs_snapshots"},
+			},
 		},
 	})
 }
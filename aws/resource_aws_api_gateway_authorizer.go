@@ -19,6 +19,9 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 		Update:        resourceAwsApiGatewayAuthorizerUpdate,
 		Delete:        resourceAwsApiGatewayAuthorizerDelete,
 		CustomizeDiff: resourceAwsApiGatewayAuthorizerCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayAuthorizerImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"authorizer_uri": {
@@ -71,6 +74,18 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayAuthorizerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/AUTHORIZER-ID", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayAuthorizerCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -69,6 +69,12 @@ func TestAccAWSAPIGatewayAuthorizer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_authorizer.acctest", "identity_validation_expression", ".*"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_authorizer.acctest",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_authorizer.acctest", "rest_api_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
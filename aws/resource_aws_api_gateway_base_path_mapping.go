@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 		Create: resourceAwsApiGatewayBasePathMappingCreate,
 		Read:   resourceAwsApiGatewayBasePathMappingRead,
 		Delete: resourceAwsApiGatewayBasePathMappingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayBasePathMappingImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"api_id": {
@@ -45,6 +49,18 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayBasePathMappingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected DOMAIN-NAME/BASE-PATH", d.Id())
+	}
+
+	d.Set("domain_name", idParts[0])
+	d.Set("base_path", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayBasePathMappingCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
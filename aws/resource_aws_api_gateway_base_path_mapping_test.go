@@ -29,6 +29,12 @@ func TestAccAWSAPIGatewayBasePath_basic(t *testing.T) {
 					testAccCheckAWSAPIGatewayBasePathExists("aws_api_gateway_base_path_mapping.test", name, &conf),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_base_path_mapping.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_base_path_mapping.test", "domain_name", "base_path"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
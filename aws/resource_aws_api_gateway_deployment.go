@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 		Read:   resourceAwsApiGatewayDeploymentRead,
 		Update: resourceAwsApiGatewayDeploymentUpdate,
 		Delete: resourceAwsApiGatewayDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayDeploymentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -68,6 +72,18 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayDeploymentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/DEPLOYMENT-ID", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	// Create the gateway
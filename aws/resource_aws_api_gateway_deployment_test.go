@@ -33,6 +33,13 @@ func TestAccAWSAPIGatewayDeployment_basic(t *testing.T) {
 						"aws_api_gateway_deployment.test", "created_date"),
 				),
 			},
+			{
+				ResourceName:            "aws_api_gateway_deployment.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSImportStateIdFunc("aws_api_gateway_deployment.test", "rest_api_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"execution_arn", "invoke_url", "stage_description", "stage_name", "variables"},
+			},
 		},
 	})
 }
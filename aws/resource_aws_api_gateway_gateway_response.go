@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsApiGatewayGatewayResponse() *schema.Resource {
 		Read:   resourceAwsApiGatewayGatewayResponseRead,
 		Update: resourceAwsApiGatewayGatewayResponsePut,
 		Delete: resourceAwsApiGatewayGatewayResponseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayGatewayResponseImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -52,6 +56,19 @@ func resourceAwsApiGatewayGatewayResponse() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayGatewayResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESPONSE-TYPE", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("response_type", idParts[1])
+	d.SetId(fmt.Sprintf("aggr-%s-%s", idParts[0], idParts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayGatewayResponsePut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -43,6 +43,12 @@ func TestAccAWSAPIGatewayGatewayResponse_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr("aws_api_gateway_gateway_response.test", "response_parameters.gatewayresponse.header.Authorization"),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_gateway_response.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_gateway_response.test", "rest_api_id", "response_type"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -21,6 +21,9 @@ func resourceAwsApiGatewayIntegration() *schema.Resource {
 		Read:   resourceAwsApiGatewayIntegrationRead,
 		Update: resourceAwsApiGatewayIntegrationUpdate,
 		Delete: resourceAwsApiGatewayIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayIntegrationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -127,6 +130,20 @@ func resourceAwsApiGatewayIntegration() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayIntegrationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("resource_id", idParts[1])
+	d.Set("http_method", idParts[2])
+	d.SetId(fmt.Sprintf("agi-%s-%s-%s", idParts[0], idParts[1], idParts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
 		Read:   resourceAwsApiGatewayIntegrationResponseRead,
 		Update: resourceAwsApiGatewayIntegrationResponseCreate,
 		Delete: resourceAwsApiGatewayIntegrationResponseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayIntegrationResponseImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -79,6 +83,21 @@ func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayIntegrationResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("resource_id", idParts[1])
+	d.Set("http_method", idParts[2])
+	d.Set("status_code", idParts[3])
+	d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", idParts[0], idParts[1], idParts[2], idParts[3]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayIntegrationResponseCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
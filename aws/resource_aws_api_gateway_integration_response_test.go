@@ -46,6 +46,12 @@ func TestAccAWSAPIGatewayIntegrationResponse_basic(t *testing.T) {
 						"aws_api_gateway_integration_response.test", "content_handling", "CONVERT_TO_BINARY"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_integration_response.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_integration_response.test", "rest_api_id", "resource_id", "http_method", "status_code"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
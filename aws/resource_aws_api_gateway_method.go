@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,9 @@ func resourceAwsApiGatewayMethod() *schema.Resource {
 		Read:   resourceAwsApiGatewayMethodRead,
 		Update: resourceAwsApiGatewayMethodUpdate,
 		Delete: resourceAwsApiGatewayMethodDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayMethodImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
@@ -85,6 +89,20 @@ func resourceAwsApiGatewayMethod() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayMethodImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("resource_id", idParts[1])
+	d.Set("http_method", idParts[2])
+	d.SetId(fmt.Sprintf("agm-%s-%s-%s", idParts[0], idParts[1], idParts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayMethodCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
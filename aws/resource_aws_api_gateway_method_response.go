@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,6 +24,9 @@ func resourceAwsApiGatewayMethodResponse() *schema.Resource {
 		Read:   resourceAwsApiGatewayMethodResponseRead,
 		Update: resourceAwsApiGatewayMethodResponseUpdate,
 		Delete: resourceAwsApiGatewayMethodResponseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayMethodResponseImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
@@ -72,6 +76,21 @@ func resourceAwsApiGatewayMethodResponse() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayMethodResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("resource_id", idParts[1])
+	d.Set("http_method", idParts[2])
+	d.Set("status_code", idParts[3])
+	d.SetId(fmt.Sprintf("agmr-%s-%s-%s-%s", idParts[0], idParts[1], idParts[2], idParts[3]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayMethodResponseCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -42,6 +42,12 @@ func TestAccAWSAPIGatewayMethodResponse_basic(t *testing.T) {
 						"aws_api_gateway_method_response.error", "response_models.application/json", "Empty"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_method_response.error",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_method_response.error", "rest_api_id", "resource_id", "http_method", "status_code"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -44,6 +44,12 @@ func TestAccAWSAPIGatewayMethodSettings_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_method_settings.test", "settings.0.logging_level", "OFF"),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_method_settings.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_method_settings.test", "rest_api_id", "stage_name", "method_path"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
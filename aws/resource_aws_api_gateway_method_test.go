@@ -43,6 +43,12 @@ func TestAccAWSAPIGatewayMethod_basic(t *testing.T) {
 					testAccCheckAWSAPIGatewayMethodAttributesUpdate(&conf),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_method.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_method.test", "rest_api_id", "resource_id", "http_method"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -32,6 +32,12 @@ func TestAccAWSAPIGatewayModel_basic(t *testing.T) {
 						"aws_api_gateway_model.test", "content_type", "application/json"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_model.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_model.test", "rest_api_id", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
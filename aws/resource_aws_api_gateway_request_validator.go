@@ -16,6 +16,9 @@ func resourceAwsApiGatewayRequestValidator() *schema.Resource {
 		Read:   resourceAwsApiGatewayRequestValidatorRead,
 		Update: resourceAwsApiGatewayRequestValidatorUpdate,
 		Delete: resourceAwsApiGatewayRequestValidatorDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayRequestValidatorImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -44,6 +47,18 @@ func resourceAwsApiGatewayRequestValidator() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayRequestValidatorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/REQUEST-VALIDATOR-ID", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayRequestValidatorCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -43,6 +43,12 @@ func TestAccAWSAPIGatewayRequestValidator_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_request_validator.test", "validate_request_parameters", "true"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_request_validator.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_request_validator.test", "rest_api_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
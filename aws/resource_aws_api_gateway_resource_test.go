@@ -30,6 +30,12 @@ func TestAccAWSAPIGatewayResource_basic(t *testing.T) {
 						"aws_api_gateway_resource.test", "path", "/test"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_resource.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_resource.test", "rest_api_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
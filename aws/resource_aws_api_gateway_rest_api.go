@@ -20,6 +20,9 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 		Read:   resourceAwsApiGatewayRestApiRead,
 		Update: resourceAwsApiGatewayRestApiUpdate,
 		Delete: resourceAwsApiGatewayRestApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
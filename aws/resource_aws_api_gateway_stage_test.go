@@ -45,6 +45,12 @@ func TestAccAWSAPIGatewayStage_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_stage.test", "cache_cluster_size", "0.5"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_api_gateway_stage.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_stage.test", "rest_api_id", "stage_name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
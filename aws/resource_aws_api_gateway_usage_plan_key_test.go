@@ -59,6 +59,12 @@ func TestAccAWSAPIGatewayUsagePlanKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_usage_plan_key.main", "value", ""),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_usage_plan_key.main",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_api_gateway_usage_plan_key.main", "usage_plan_id", "key_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
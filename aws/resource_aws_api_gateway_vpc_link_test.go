@@ -36,6 +36,11 @@ func TestAccAWSAPIGatewayVpcLink_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_vpc_link.test", "target_arns.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_vpc_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -20,6 +20,9 @@ func resourceAwsAppCookieStickinessPolicy() *schema.Resource {
 		Create: resourceAwsAppCookieStickinessPolicyCreate,
 		Read:   resourceAwsAppCookieStickinessPolicyRead,
 		Delete: resourceAwsAppCookieStickinessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
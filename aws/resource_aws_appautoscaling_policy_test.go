@@ -38,6 +38,12 @@ func TestAccAWSAppautoScalingPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_appautoscaling_policy.foobar_simple", "scalable_dimension", "ecs:service:DesiredCount"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_appautoscaling_policy.foobar_simple",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_appautoscaling_policy.foobar_simple", "service_namespace", "resource_id", "scalable_dimension", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 		Create: resourceAwsAppautoscalingScheduledActionPut,
 		Read:   resourceAwsAppautoscalingScheduledActionRead,
 		Delete: resourceAwsAppautoscalingScheduledActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingScheduledActionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -83,6 +87,24 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 	}
 }
 
+func resourceAwsAppautoscalingScheduledActionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 3 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SERVICE-NAMESPACE/RESOURCE-ID/NAME", d.Id())
+	}
+
+	serviceNamespace := idParts[0]
+	resourceId := strings.Join(idParts[1:len(idParts)-1], "/")
+	name := idParts[len(idParts)-1]
+
+	d.Set("service_namespace", serviceNamespace)
+	d.Set("resource_id", resourceId)
+	d.Set("name", name)
+	d.SetId(name + "-" + serviceNamespace + "-" + resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAppautoscalingScheduledActionPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appautoscalingconn
 
@@ -165,7 +187,22 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 	if *resp.ScheduledActions[0].ScheduledActionName != saName {
 		return fmt.Errorf("Scheduled Action (%s) not found", saName)
 	}
-	d.Set("arn", resp.ScheduledActions[0].ScheduledActionARN)
+
+	sa := resp.ScheduledActions[0]
+	d.Set("arn", sa.ScheduledActionARN)
+	d.Set("resource_id", sa.ResourceId)
+	d.Set("scalable_dimension", sa.ScalableDimension)
+	d.Set("schedule", sa.Schedule)
+	if sa.StartTime != nil {
+		d.Set("start_time", sa.StartTime.Format(awsAppautoscalingScheduleTimeLayout))
+	}
+	if sa.EndTime != nil {
+		d.Set("end_time", sa.EndTime.Format(awsAppautoscalingScheduleTimeLayout))
+	}
+	if err := d.Set("scalable_target_action", flattenAppautoscalingScalableTargetAction(sa.ScalableTargetAction)); err != nil {
+		return fmt.Errorf("error setting scalable_target_action: %s", err)
+	}
+
 	return nil
 }
 
@@ -192,3 +229,19 @@ func resourceAwsAppautoscalingScheduledActionDelete(d *schema.ResourceData, meta
 	d.SetId("")
 	return nil
 }
+
+func flattenAppautoscalingScalableTargetAction(sta *applicationautoscaling.ScalableTargetAction) []interface{} {
+	if sta == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	if sta.MaxCapacity != nil {
+		m["max_capacity"] = int(aws.Int64Value(sta.MaxCapacity))
+	}
+	if sta.MinCapacity != nil {
+		m["min_capacity"] = int(aws.Int64Value(sta.MinCapacity))
+	}
+
+	return []interface{}{m}
+}
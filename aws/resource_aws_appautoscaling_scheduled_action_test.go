@@ -25,6 +25,12 @@ func TestAccAWSAppautoscalingScheduledAction_dynamo(t *testing.T) {
 					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.hoge"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_scheduled_action.hoge",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_appautoscaling_scheduled_action.hoge", "service_namespace", "resource_id", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -19,6 +20,9 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 		Read:   resourceAwsAppautoscalingTargetRead,
 		Update: resourceAwsAppautoscalingTargetPut,
 		Delete: resourceAwsAppautoscalingTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"max_capacity": {
@@ -53,6 +57,24 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 	}
 }
 
+func resourceAwsAppautoscalingTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 3 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SERVICE-NAMESPACE/RESOURCE-ID/SCALABLE-DIMENSION", d.Id())
+	}
+
+	serviceNamespace := idParts[0]
+	resourceId := strings.Join(idParts[1:len(idParts)-1], "/")
+	scalableDimension := idParts[len(idParts)-1]
+
+	d.Set("service_namespace", serviceNamespace)
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", scalableDimension)
+	d.SetId(resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAppautoscalingTargetPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appautoscalingconn
 
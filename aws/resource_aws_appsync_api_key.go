@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAppsyncApiKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncApiKeyCreate,
+		Read:   resourceAwsAppsyncApiKeyRead,
+		Update: resourceAwsAppsyncApiKeyUpdate,
+		Delete: resourceAwsAppsyncApiKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"expires": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateRFC3339TimeString,
+				// The expiration time is rounded down to the nearest hour
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldTime, err := time.Parse(time.RFC3339, old)
+					if err != nil {
+						return false
+					}
+					newTime, err := time.Parse(time.RFC3339, new)
+					if err != nil {
+						return false
+					}
+					return oldTime.Equal(newTime.Truncate(time.Hour))
+				},
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncApiKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId := d.Get("api_id").(string)
+
+	input := &appsync.CreateApiKeyInput{
+		ApiId:       aws.String(apiId),
+		Description: aws.String(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("expires"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.Expires = aws.Int64(t.Unix())
+	}
+
+	log.Printf("[DEBUG] Creating Appsync API Key: %s", input)
+	resp, err := conn.CreateApiKey(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Appsync API Key: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", apiId, aws.StringValue(resp.ApiKey.Id)))
+
+	return resourceAwsAppsyncApiKeyRead(d, meta)
+}
+
+func resourceAwsAppsyncApiKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, keyId, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	key, err := getAppsyncApiKey(apiId, keyId, conn)
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Appsync API Key (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Appsync API Key (%s): %s", d.Id(), err)
+	}
+	if key == nil {
+		log.Printf("[WARN] Appsync API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("api_id", apiId)
+	d.Set("description", key.Description)
+	d.Set("expires", time.Unix(aws.Int64Value(key.Expires), 0).UTC().Format(time.RFC3339))
+	d.Set("key", key.Id)
+
+	return nil
+}
+
+func resourceAwsAppsyncApiKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, keyId, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &appsync.UpdateApiKeyInput{
+		ApiId:       aws.String(apiId),
+		Description: aws.String(d.Get("description").(string)),
+		Id:          aws.String(keyId),
+	}
+
+	if d.HasChange("expires") {
+		t, _ := time.Parse(time.RFC3339, d.Get("expires").(string))
+		input.Expires = aws.Int64(t.Unix())
+	}
+
+	log.Printf("[DEBUG] Updating Appsync API Key: %s", input)
+	_, err = conn.UpdateApiKey(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Appsync API Key (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncApiKeyRead(d, meta)
+}
+
+func resourceAwsAppsyncApiKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, keyId, err := decodeAppsyncApiKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Appsync API Key: %s", d.Id())
+	_, err = conn.DeleteApiKey(&appsync.DeleteApiKeyInput{
+		ApiId: aws.String(apiId),
+		Id:    aws.String(keyId),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Appsync API Key (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getAppsyncApiKey returns the API key with the given ID, or nil if the API
+// has no such key. API keys can only be listed.
+func getAppsyncApiKey(apiId, keyId string, conn *appsync.AppSync) (*appsync.ApiKey, error) {
+	input := &appsync.ListApiKeysInput{
+		ApiId: aws.String(apiId),
+	}
+	for {
+		resp, err := conn.ListApiKeys(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range resp.ApiKeys {
+			if aws.StringValue(key.Id) == keyId {
+				return key, nil
+			}
+		}
+		if resp.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+func decodeAppsyncApiKeyID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected API-ID:API-KEY-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeAppsyncApiKeyID(t *testing.T) {
+	cases := []struct {
+		ID       string
+		ApiID    string
+		KeyID    string
+		ErrCount int
+	}{
+		{
+			ID:    "abcdefghijklmnopqrstuvwxyz:da2-abcdefghijklmnopqrstuvwxyz",
+			ApiID: "abcdefghijklmnopqrstuvwxyz",
+			KeyID: "da2-abcdefghijklmnopqrstuvwxyz",
+		},
+		{
+			ID:       "abcdefghijklmnopqrstuvwxyz",
+			ErrCount: 1,
+		},
+		{
+			ID:       "abcdefghijklmnopqrstuvwxyz:",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		apiID, keyID, err := decodeAppsyncApiKeyID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if apiID != tc.ApiID || keyID != tc.KeyID {
+			t.Fatalf("expected %q to parse as %s/%s, received: %s/%s",
+				tc.ID, tc.ApiID, tc.KeyID, apiID, keyID)
+		}
+	}
+}
+
+func TestAccAWSAppsyncApiKey_basic(t *testing.T) {
+	var apiKey appsync.ApiKey
+	rName := acctest.RandString(5)
+	resourceName := "aws_appsync_api_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires"),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncApiKey_expires(t *testing.T) {
+	var apiKey appsync.ApiKey
+	rName := acctest.RandString(5)
+	resourceName := "aws_appsync_api_key.test"
+
+	// Keys must expire between 1 and 365 days from now
+	expires1 := time.Now().Add(24 * 30 * time.Hour).UTC().Truncate(time.Hour).Format(time.RFC3339)
+	expires2 := time.Now().Add(24 * 60 * time.Hour).UTC().Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncApiKeyConfig_expires(rName, "description1", expires1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "expires", expires1),
+				),
+			},
+			{
+				Config: testAccAppsyncApiKeyConfig_expires(rName, "description2", expires2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncApiKeyExists(resourceName, &apiKey),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "expires", expires2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncApiKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_api_key" {
+			continue
+		}
+
+		apiID, keyID, err := decodeAppsyncApiKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		apiKey, err := getAppsyncApiKey(apiID, keyID, conn)
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if apiKey != nil {
+			return fmt.Errorf("Appsync API Key %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAppsyncApiKeyExists(name string, apiKey *appsync.ApiKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Appsync API Key ID is set")
+		}
+
+		apiID, keyID, err := decodeAppsyncApiKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		key, err := getAppsyncApiKey(apiID, keyID, conn)
+		if err != nil {
+			return err
+		}
+
+		if key == nil {
+			return fmt.Errorf("Appsync API Key %q not found", rs.Primary.ID)
+		}
+
+		*apiKey = *key
+		return nil
+	}
+}
+
+func testAccAppsyncApiKeyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+}
+`, rName)
+}
+
+func testAccAppsyncApiKeyConfig_expires(rName, description, expires string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%s"
+}
+
+resource "aws_appsync_api_key" "test" {
+  api_id      = "${aws_appsync_graphql_api.test.id}"
+  description = "%s"
+  expires     = "%s"
+}
+`, rName, description, expires)
+}
@@ -0,0 +1,378 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAppsyncDatasource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncDatasourceCreate,
+		Read:   resourceAwsAppsyncDatasourceRead,
+		Update: resourceAwsAppsyncDatasourceUpdate,
+		Delete: resourceAwsAppsyncDatasourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if !regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`).MatchString(value) {
+						errors = append(errors, fmt.Errorf("%q must match [_A-Za-z][_0-9A-Za-z]*", k))
+					}
+					return
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					appsync.DataSourceTypeAwsLambda,
+					appsync.DataSourceTypeAmazonDynamodb,
+					appsync.DataSourceTypeAmazonElasticsearch,
+					appsync.DataSourceTypeNone,
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dynamodb_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"use_caller_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+				ConflictsWith: []string{"elasticsearch_config", "lambda_config"},
+			},
+			"elasticsearch_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				ConflictsWith: []string{"dynamodb_config", "lambda_config"},
+			},
+			"lambda_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"function_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config"},
+			},
+			"service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncDatasourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+	region := meta.(*AWSClient).region
+
+	apiId := d.Get("api_id").(string)
+	name := d.Get("name").(string)
+
+	input := &appsync.CreateDataSourceInput{
+		ApiId: aws.String(apiId),
+		Name:  aws.String(name),
+		Type:  aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("dynamodb_config"); ok {
+		input.DynamodbConfig = expandAppsyncDynamodbDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("elasticsearch_config"); ok {
+		input.ElasticsearchConfig = expandAppsyncElasticsearchDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("lambda_config"); ok {
+		input.LambdaConfig = expandAppsyncLambdaDataSourceConfig(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Appsync Datasource: %s", input)
+	_, err := conn.CreateDataSource(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Appsync Datasource: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", apiId, name))
+
+	return resourceAwsAppsyncDatasourceRead(d, meta)
+}
+
+func resourceAwsAppsyncDatasourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, name, err := decodeAppsyncDataSourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetDataSource(&appsync.GetDataSourceInput{
+		ApiId: aws.String(apiId),
+		Name:  aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Appsync Datasource (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Appsync Datasource (%s): %s", d.Id(), err)
+	}
+
+	dataSource := resp.DataSource
+
+	d.Set("api_id", apiId)
+	d.Set("arn", dataSource.DataSourceArn)
+	d.Set("description", dataSource.Description)
+	d.Set("name", dataSource.Name)
+	d.Set("service_role_arn", dataSource.ServiceRoleArn)
+	d.Set("type", dataSource.Type)
+
+	if err := d.Set("dynamodb_config", flattenAppsyncDynamodbDataSourceConfig(dataSource.DynamodbConfig)); err != nil {
+		return fmt.Errorf("error setting dynamodb_config: %s", err)
+	}
+	if err := d.Set("elasticsearch_config", flattenAppsyncElasticsearchDataSourceConfig(dataSource.ElasticsearchConfig)); err != nil {
+		return fmt.Errorf("error setting elasticsearch_config: %s", err)
+	}
+	if err := d.Set("lambda_config", flattenAppsyncLambdaDataSourceConfig(dataSource.LambdaConfig)); err != nil {
+		return fmt.Errorf("error setting lambda_config: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppsyncDatasourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+	region := meta.(*AWSClient).region
+
+	apiId, name, err := decodeAppsyncDataSourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The whole data source configuration is replaced on update
+	input := &appsync.UpdateDataSourceInput{
+		ApiId: aws.String(apiId),
+		Name:  aws.String(name),
+		Type:  aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("dynamodb_config"); ok {
+		input.DynamodbConfig = expandAppsyncDynamodbDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("elasticsearch_config"); ok {
+		input.ElasticsearchConfig = expandAppsyncElasticsearchDataSourceConfig(v.([]interface{}), region)
+	}
+	if v, ok := d.GetOk("lambda_config"); ok {
+		input.LambdaConfig = expandAppsyncLambdaDataSourceConfig(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Appsync Datasource: %s", input)
+	_, err = conn.UpdateDataSource(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Appsync Datasource (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncDatasourceRead(d, meta)
+}
+
+func resourceAwsAppsyncDatasourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, name, err := decodeAppsyncDataSourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Appsync Datasource: %s", d.Id())
+	_, err = conn.DeleteDataSource(&appsync.DeleteDataSourceInput{
+		ApiId: aws.String(apiId),
+		Name:  aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Appsync Datasource (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAppsyncDataSourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected API-ID:DATASOURCE-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandAppsyncDynamodbDataSourceConfig(l []interface{}, currentRegion string) *appsync.DynamodbDataSourceConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.DynamodbDataSourceConfig{
+		AwsRegion: aws.String(currentRegion),
+		TableName: aws.String(m["table_name"].(string)),
+	}
+
+	if v, ok := m["region"].(string); ok && v != "" {
+		config.AwsRegion = aws.String(v)
+	}
+	if v, ok := m["use_caller_credentials"].(bool); ok {
+		config.UseCallerCredentials = aws.Bool(v)
+	}
+
+	return config
+}
+
+func flattenAppsyncDynamodbDataSourceConfig(config *appsync.DynamodbDataSourceConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"region":     aws.StringValue(config.AwsRegion),
+		"table_name": aws.StringValue(config.TableName),
+	}
+
+	if config.UseCallerCredentials != nil {
+		m["use_caller_credentials"] = aws.BoolValue(config.UseCallerCredentials)
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncElasticsearchDataSourceConfig(l []interface{}, currentRegion string) *appsync.ElasticsearchDataSourceConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.ElasticsearchDataSourceConfig{
+		AwsRegion: aws.String(currentRegion),
+		Endpoint:  aws.String(m["endpoint"].(string)),
+	}
+
+	if v, ok := m["region"].(string); ok && v != "" {
+		config.AwsRegion = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenAppsyncElasticsearchDataSourceConfig(config *appsync.ElasticsearchDataSourceConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"endpoint": aws.StringValue(config.Endpoint),
+		"region":   aws.StringValue(config.AwsRegion),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAppsyncLambdaDataSourceConfig(l []interface{}) *appsync.LambdaDataSourceConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.LambdaDataSourceConfig{
+		LambdaFunctionArn: aws.String(m["function_arn"].(string)),
+	}
+
+	return config
+}
+
+func flattenAppsyncLambdaDataSourceConfig(config *appsync.LambdaDataSourceConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"function_arn": aws.StringValue(config.LambdaFunctionArn),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,384 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeAppsyncDataSourceID(t *testing.T) {
+	cases := []struct {
+		ID       string
+		ApiID    string
+		Name     string
+		ErrCount int
+	}{
+		{
+			ID:    "abcdefghijklmnopqrstuvwxyz:tf_datasource",
+			ApiID: "abcdefghijklmnopqrstuvwxyz",
+			Name:  "tf_datasource",
+		},
+		{
+			ID:       "abcdefghijklmnopqrstuvwxyz",
+			ErrCount: 1,
+		},
+		{
+			ID:       ":tf_datasource",
+			ErrCount: 1,
+		},
+		{
+			ID:       "abcdefghijklmnopqrstuvwxyz:tf_datasource:extra",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		apiID, name, err := decodeAppsyncDataSourceID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if apiID != tc.ApiID || name != tc.Name {
+			t.Fatalf("expected %q to parse as %s/%s, received: %s/%s",
+				tc.ID, tc.ApiID, tc.Name, apiID, name)
+		}
+	}
+}
+
+func TestAccAWSAppsyncDatasource_dynamodb(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_appsync_datasource.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncDatasourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncDatasourceConfig_dynamodb(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "dynamodb_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "dynamodb_config.0.region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "dynamodb_config.0.table_name", "aws_dynamodb_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf_appsync_%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "service_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "AMAZON_DYNAMODB"),
+				),
+			},
+			{
+				Config: testAccAppsyncDatasourceConfig_dynamodb(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncDatasource_lambda(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_appsync_datasource.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncDatasourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncDatasourceConfig_lambda(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_config.0.function_arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "AWS_LAMBDA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncDatasource_none(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_appsync_datasource.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncDatasourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncDatasourceConfig_none(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncDatasourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dynamodb_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "elasticsearch_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "NONE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncDatasourceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_datasource" {
+			continue
+		}
+
+		apiID, name, err := decodeAppsyncDataSourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetDataSource(&appsync.GetDataSourceInput{
+			ApiId: aws.String(apiID),
+			Name:  aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Appsync Datasource %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAppsyncDatasourceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Appsync Datasource ID is set")
+		}
+
+		apiID, name, err := decodeAppsyncDataSourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		_, err = conn.GetDataSource(&appsync.GetDataSourceInput{
+			ApiId: aws.String(apiID),
+			Name:  aws.String(name),
+		})
+
+		return err
+	}
+}
+
+func testAccAppsyncDatasourceConfig_dynamodb(rName, description string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%[1]s"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name           = "tf-appsync-%[1]s"
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "UserId"
+
+  attribute {
+    name = "UserId"
+    type = "S"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = "tf-appsync-%[1]s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appsync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "dynamodb:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_dynamodb_table.test.arn}"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id           = "${aws_appsync_graphql_api.test.id}"
+  name             = "tf_appsync_%[1]s"
+  description      = "%[2]s"
+  service_role_arn = "${aws_iam_role.test.arn}"
+  type             = "AMAZON_DYNAMODB"
+
+  dynamodb_config {
+    table_name = "${aws_dynamodb_table.test.name}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, description)
+}
+
+func testAccAppsyncDatasourceConfig_lambda(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%[1]s"
+}
+
+resource "aws_iam_role" "lambda" {
+  name = "tf-appsync-lambda-%[1]s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "tf-appsync-%[1]s"
+  handler       = "exports.example"
+  role          = "${aws_iam_role.lambda.arn}"
+  runtime       = "nodejs6.10"
+}
+
+resource "aws_iam_role" "test" {
+  name = "tf-appsync-%[1]s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appsync.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "lambda:InvokeFunction"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_lambda_function.test.arn}"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id           = "${aws_appsync_graphql_api.test.id}"
+  name             = "tf_appsync_%[1]s"
+  service_role_arn = "${aws_iam_role.test.arn}"
+  type             = "AWS_LAMBDA"
+
+  lambda_config {
+    function_arn = "${aws_lambda_function.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccAppsyncDatasourceConfig_none(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%[1]s"
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  name   = "tf_appsync_%[1]s"
+  type   = "NONE"
+}
+`, rName)
+}
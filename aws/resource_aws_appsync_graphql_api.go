@@ -4,19 +4,31 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// Schema creation statuses reported by newer versions of the AppSync API,
+// which are not defined in the vendored aws-sdk-go.
+const (
+	appsyncSchemaStatusSuccess = "SUCCESS"
+	appsyncSchemaStatusFailed  = "FAILED"
+)
+
 func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsAppsyncGraphqlApiCreate,
 		Read:   resourceAwsAppsyncGraphqlApiRead,
 		Update: resourceAwsAppsyncGraphqlApiUpdate,
 		Delete: resourceAwsAppsyncGraphqlApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"authentication_type": {
@@ -68,6 +80,10 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 					},
 				},
 			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,6 +111,13 @@ func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(*resp.GraphqlApi.ApiId)
 	d.Set("arn", resp.GraphqlApi.Arn)
+
+	if v, ok := d.GetOk("schema"); ok {
+		if err := resourceAwsAppsyncSchemaPut(d.Id(), v.(string), conn); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -142,6 +165,14 @@ func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	if d.HasChange("schema") {
+		if v, ok := d.GetOk("schema"); ok {
+			if err := resourceAwsAppsyncSchemaPut(d.Id(), v.(string), conn); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAwsAppsyncGraphqlApiRead(d, meta)
 }
 
@@ -162,6 +193,48 @@ func resourceAwsAppsyncGraphqlApiDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceAwsAppsyncSchemaPut(apiId, definition string, conn *appsync.AppSync) error {
+	input := &appsync.StartSchemaCreationInput{
+		ApiId:      aws.String(apiId),
+		Definition: []byte(definition),
+	}
+	log.Printf("[DEBUG] Creating Appsync Graphql API (%s) schema", apiId)
+	if _, err := conn.StartSchemaCreation(input); err != nil {
+		return fmt.Errorf("Error creating Appsync Graphql API (%s) schema: %s", apiId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{appsync.SchemaStatusProcessing},
+		Target:     []string{appsync.SchemaStatusActive, appsyncSchemaStatusSuccess},
+		Refresh:    resourceAwsAppsyncSchemaRefreshFunc(apiId, conn),
+		Timeout:    2 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Appsync Graphql API (%s) schema creation: %s", apiId, err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppsyncSchemaRefreshFunc(apiId string, conn *appsync.AppSync) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetSchemaCreationStatus(&appsync.GetSchemaCreationStatusInput{
+			ApiId: aws.String(apiId),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(resp.Status)
+		if status == appsyncSchemaStatusFailed {
+			return resp, status, fmt.Errorf("%s", aws.StringValue(resp.Details))
+		}
+
+		return resp, status, nil
+	}
+}
+
 func expandAppsyncGraphqlApiUserPoolConfig(config []interface{}) *appsync.UserPoolConfig {
 	if len(config) < 1 {
 		return nil
@@ -24,6 +24,11 @@ func TestAccAWSAppsyncGraphqlApi_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_appsync_graphql_api.test_apikey", "arn"),
 				),
 			},
+			{
+				ResourceName:      "aws_appsync_graphql_api.test_apikey",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -62,6 +67,39 @@ func TestAccAWSAppsyncGraphqlApi_cognito(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppsyncGraphqlApi_schema(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_appsync_graphql_api.test_schema"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_schema(rName, "Post"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					testAccCheckAwsAppsyncGraphqlApiTypeExists(resourceName, "Post"),
+				),
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_schema(rName, "PostV2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName),
+					testAccCheckAwsAppsyncGraphqlApiTypeExists(resourceName, "PostV2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"schema"},
+			},
+		},
+	})
+}
+
 func testAccCheckAwsAppsyncGraphqlApiDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
 	for _, rs := range s.RootModule().Resources {
@@ -106,6 +144,26 @@ func testAccCheckAwsAppsyncGraphqlApiExists(name string) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckAwsAppsyncGraphqlApiTypeExists(name, typeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+		input := &appsync.GetTypeInput{
+			ApiId:    aws.String(rs.Primary.ID),
+			Format:   aws.String(appsync.TypeDefinitionFormatSdl),
+			TypeName: aws.String(typeName),
+		}
+
+		_, err := conn.GetType(input)
+		return err
+	}
+}
+
 func testAccAppsyncGraphqlApiConfig_apikey(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_appsync_graphql_api" "test_apikey" {
@@ -145,3 +203,32 @@ resource "aws_appsync_graphql_api" "test_cognito" {
 }
 `, rName, rName)
 }
+
+func testAccAppsyncGraphqlApiConfig_schema(rName, typeName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test_schema" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%s"
+
+  schema = <<SCHEMA
+type Mutation {
+  putPost(id: ID!, title: String!): %[2]s
+}
+
+type %[2]s {
+  id: ID!
+  title: String!
+}
+
+type Query {
+  singlePost(id: ID!): %[2]s
+}
+
+schema {
+  query: Query
+  mutation: Mutation
+}
+SCHEMA
+}
+`, rName, typeName)
+}
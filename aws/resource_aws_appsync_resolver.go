@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAppsyncResolver() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppsyncResolverCreate,
+		Read:   resourceAwsAppsyncResolverRead,
+		Update: resourceAwsAppsyncResolverUpdate,
+		Delete: resourceAwsAppsyncResolverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"request_template": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"response_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppsyncResolverCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId := d.Get("api_id").(string)
+	typeName := d.Get("type").(string)
+	fieldName := d.Get("field").(string)
+
+	input := &appsync.CreateResolverInput{
+		ApiId:                  aws.String(apiId),
+		DataSourceName:         aws.String(d.Get("data_source").(string)),
+		FieldName:              aws.String(fieldName),
+		RequestMappingTemplate: aws.String(d.Get("request_template").(string)),
+		TypeName:               aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("response_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Appsync Resolver: %s", input)
+	err := retryOnAppsyncConcurrentModification(func() error {
+		_, err := conn.CreateResolver(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Appsync Resolver: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", apiId, typeName, fieldName))
+
+	return resourceAwsAppsyncResolverRead(d, meta)
+}
+
+func resourceAwsAppsyncResolverRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetResolver(&appsync.GetResolverInput{
+		ApiId:     aws.String(apiId),
+		FieldName: aws.String(fieldName),
+		TypeName:  aws.String(typeName),
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Appsync Resolver (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Appsync Resolver (%s): %s", d.Id(), err)
+	}
+
+	resolver := resp.Resolver
+
+	d.Set("api_id", apiId)
+	d.Set("arn", resolver.ResolverArn)
+	d.Set("data_source", resolver.DataSourceName)
+	d.Set("field", resolver.FieldName)
+	d.Set("request_template", resolver.RequestMappingTemplate)
+	d.Set("response_template", resolver.ResponseMappingTemplate)
+	d.Set("type", resolver.TypeName)
+
+	return nil
+}
+
+func resourceAwsAppsyncResolverUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &appsync.UpdateResolverInput{
+		ApiId:                  aws.String(apiId),
+		DataSourceName:         aws.String(d.Get("data_source").(string)),
+		FieldName:              aws.String(fieldName),
+		RequestMappingTemplate: aws.String(d.Get("request_template").(string)),
+		TypeName:               aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("response_template"); ok {
+		input.ResponseMappingTemplate = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Appsync Resolver: %s", input)
+	err = retryOnAppsyncConcurrentModification(func() error {
+		_, err := conn.UpdateResolver(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Appsync Resolver (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsAppsyncResolverRead(d, meta)
+}
+
+func resourceAwsAppsyncResolverDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appsyncconn
+
+	apiId, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Appsync Resolver: %s", d.Id())
+	err = retryOnAppsyncConcurrentModification(func() error {
+		_, err := conn.DeleteResolver(&appsync.DeleteResolverInput{
+			ApiId:     aws.String(apiId),
+			FieldName: aws.String(fieldName),
+			TypeName:  aws.String(typeName),
+		})
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Appsync Resolver (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// retryOnAppsyncConcurrentModification retries f while the schema of the
+// API is being modified, e.g. by resolvers created in parallel.
+func retryOnAppsyncConcurrentModification(f func() error) error {
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		err := f()
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeConcurrentModificationException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func decodeAppsyncResolverID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected API-ID:TYPE-NAME:FIELD-NAME", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
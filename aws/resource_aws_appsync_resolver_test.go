@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeAppsyncResolverID(t *testing.T) {
+	cases := []struct {
+		ID        string
+		ApiID     string
+		TypeName  string
+		FieldName string
+		ErrCount  int
+	}{
+		{
+			ID:        "abcdefghijklmnopqrstuvwxyz:Query:singlePost",
+			ApiID:     "abcdefghijklmnopqrstuvwxyz",
+			TypeName:  "Query",
+			FieldName: "singlePost",
+		},
+		{
+			ID:       "abcdefghijklmnopqrstuvwxyz:Query",
+			ErrCount: 1,
+		},
+		{
+			ID:       "abcdefghijklmnopqrstuvwxyz::singlePost",
+			ErrCount: 1,
+		},
+		{
+			ID:       "abcdefghijklmnopqrstuvwxyz:Query:singlePost:extra",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if apiID != tc.ApiID || typeName != tc.TypeName || fieldName != tc.FieldName {
+			t.Fatalf("expected %q to parse as %s/%s/%s, received: %s/%s/%s",
+				tc.ID, tc.ApiID, tc.TypeName, tc.FieldName, apiID, typeName, fieldName)
+		}
+	}
+}
+
+func TestAccAWSAppsyncResolver_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_appsync_resolver.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncResolverConfig(rName, "2017-02-28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source", "aws_appsync_datasource.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "field", "singlePost"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Query"),
+				),
+			},
+			{
+				Config: testAccAppsyncResolverConfig(rName, "2018-05-29"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncResolverExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "request_template", regexp.MustCompile(`2018-05-29`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppsyncResolverDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_resolver" {
+			continue
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetResolver(&appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			FieldName: aws.String(fieldName),
+			TypeName:  aws.String(typeName),
+		})
+		if err != nil {
+			if isAWSErr(err, appsync.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Appsync Resolver %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAppsyncResolverExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Appsync Resolver ID is set")
+		}
+
+		apiID, typeName, fieldName, err := decodeAppsyncResolverID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		_, err = conn.GetResolver(&appsync.GetResolverInput{
+			ApiId:     aws.String(apiID),
+			FieldName: aws.String(fieldName),
+			TypeName:  aws.String(typeName),
+		})
+
+		return err
+	}
+}
+
+func testAccAppsyncResolverConfig(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = "tf_appsync_%[1]s"
+
+  schema = <<SCHEMA
+type Post {
+  id: ID!
+  title: String!
+}
+
+type Query {
+  singlePost(id: ID!): Post
+}
+
+schema {
+  query: Query
+}
+SCHEMA
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id = "${aws_appsync_graphql_api.test.id}"
+  name   = "tf_appsync_%[1]s"
+  type   = "NONE"
+}
+
+resource "aws_appsync_resolver" "test" {
+  api_id      = "${aws_appsync_graphql_api.test.id}"
+  field       = "singlePost"
+  type        = "Query"
+  data_source = "${aws_appsync_datasource.test.name}"
+
+  request_template = <<EOF
+{
+  "version": "%[2]s",
+  "payload": {
+    "id": "$${context.arguments.id}",
+    "title": "example"
+  }
+}
+EOF
+
+  response_template = "$util.toJson($context.result)"
+}
+`, rName, version)
+}
@@ -31,6 +31,12 @@ func TestAccAWSAutoscalingAttachment_elb(t *testing.T) {
 					testAccCheckAWSAutocalingElbAttachmentExists("aws_autoscaling_group.asg", 1),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_attachment.asg_attachment_foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_autoscaling_attachment.asg_attachment_foo", "autoscaling_group_name", "elb"),
+				ImportStateCheck:  testAccCheckAWSImportStateAttributesSet("autoscaling_group_name", "elb"),
+			},
 			{
 				Config: testAccAWSAutoscalingAttachment_elb_double_associated(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -48,8 +48,38 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			},
 
 			"launch_configuration": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"launch_template"},
+			},
+
+			"launch_template": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"launch_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "$Default",
+							ValidateFunc: validateLaunchTemplateVersion,
+						},
+					},
+				},
 			},
 
 			"desired_capacity": {
@@ -310,9 +340,15 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 
 	createOpts := autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
-		LaunchConfigurationName:          aws.String(d.Get("launch_configuration").(string)),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
 	}
+	if v, ok := d.GetOk("launch_configuration"); ok {
+		createOpts.LaunchConfigurationName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("launch_template"); ok {
+		createOpts.LaunchTemplate = expandAutoScalingLaunchTemplateSpecification(v.([]interface{}), false)
+	} else {
+		return fmt.Errorf("One of `launch_configuration` or `launch_template` must be set for an autoscaling group")
+	}
 	updateOpts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(asgName),
 	}
@@ -466,6 +502,9 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
 	d.Set("launch_configuration", g.LaunchConfigurationName)
+	if err := d.Set("launch_template", flattenAutoScalingLaunchTemplateSpecification(g.LaunchTemplate)); err != nil {
+		return fmt.Errorf("error setting launch_template: %s", err)
+	}
 	d.Set("load_balancers", flattenStringList(g.LoadBalancerNames))
 
 	if err := d.Set("suspended_processes", flattenAsgSuspendedProcesses(g.SuspendedProcesses)); err != nil {
@@ -480,13 +519,15 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("name", g.AutoScalingGroupName)
 	d.Set("service_linked_role_arn", g.ServiceLinkedRoleARN)
 
+	groupTags := autoscalingTagDescriptionsIgnoreConfig(g.Tags, providerIgnoreTags(meta))
+
 	var tagList, tagsList []*autoscaling.TagDescription
 	var tagOk, tagsOk bool
 	var v interface{}
 
 	if v, tagOk = d.GetOk("tag"); tagOk {
 		tags := setToMapByKey(v.(*schema.Set), "key")
-		for _, t := range g.Tags {
+		for _, t := range groupTags {
 			if _, ok := tags[*t.Key]; ok {
 				tagList = append(tagList, t)
 			}
@@ -510,7 +551,7 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 			tags[key] = struct{}{}
 		}
 
-		for _, t := range g.Tags {
+		for _, t := range groupTags {
 			if _, ok := tags[*t.Key]; ok {
 				tagsList = append(tagsList, t)
 			}
@@ -519,7 +560,7 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		d.Set("tag", autoscalingTagDescriptionsToSlice(groupTags))
 	}
 
 	if len(*g.VPCZoneIdentifier) > 0 {
@@ -569,8 +610,22 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		shouldWaitForCapacity = true
 	}
 
+	// Switching to another launch configuration or launch template version
+	// only affects instances launched from then on, the group is updated in
+	// place.
 	if d.HasChange("launch_configuration") {
-		opts.LaunchConfigurationName = aws.String(d.Get("launch_configuration").(string))
+		if v, ok := d.GetOk("launch_configuration"); ok {
+			opts.LaunchConfigurationName = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("launch_template") {
+		if v, ok := d.GetOk("launch_template"); ok {
+			// The computed ID of the previous template is still in state
+			// when switching to another template by name.
+			byName := d.HasChange("launch_template.0.name") && !d.HasChange("launch_template.0.id")
+			opts.LaunchTemplate = expandAutoScalingLaunchTemplateSpecification(v.([]interface{}), byName)
+		}
 	}
 
 	if d.HasChange("min_size") {
@@ -1043,3 +1098,43 @@ func expandVpcZoneIdentifiers(list []interface{}) *string {
 	}
 	return aws.String(strings.Join(strs, ","))
 }
+
+// expandAutoScalingLaunchTemplateSpecification builds the launch template of
+// an autoscaling group. Once the group has been read both the ID and the name
+// of its template are set, so only one of them is sent: the ID unless byName
+// is set.
+func expandAutoScalingLaunchTemplateSpecification(l []interface{}, byName bool) *autoscaling.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &autoscaling.LaunchTemplateSpecification{}
+
+	id, _ := m["id"].(string)
+	name, _ := m["name"].(string)
+	if id != "" && !(byName && name != "") {
+		spec.LaunchTemplateId = aws.String(id)
+	} else if name != "" {
+		spec.LaunchTemplateName = aws.String(name)
+	}
+	if v, ok := m["version"].(string); ok && v != "" {
+		spec.Version = aws.String(v)
+	}
+
+	return spec
+}
+
+func flattenAutoScalingLaunchTemplateSpecification(spec *autoscaling.LaunchTemplateSpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":      aws.StringValue(spec.LaunchTemplateId),
+			"name":    aws.StringValue(spec.LaunchTemplateName),
+			"version": aws.StringValue(spec.Version),
+		},
+	}
+}
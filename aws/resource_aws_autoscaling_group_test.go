@@ -898,6 +898,74 @@ func TestAccAWSAutoScalingGroup_emptyAvailabilityZones(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_launchTemplate(t *testing.T) {
+	var group, updated autoscaling.Group
+	rName := acctest.RandomWithPrefix("tf-asg")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate(rName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.test", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.test", "launch_configuration", ""),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.test", "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_autoscaling_group.test", "launch_template.0.id", "aws_launch_template.test", "id"),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.test", "launch_template.0.name", rName),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.test", "launch_template.0.version", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate(rName, "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.test", &updated),
+					testAccCheckAWSAutoScalingGroupNotRecreated(&group, &updated),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.test", "launch_template.0.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandAutoScalingLaunchTemplateSpecification(t *testing.T) {
+	l := []interface{}{
+		map[string]interface{}{
+			"id":      "lt-12345678",
+			"name":    "foo",
+			"version": "$Latest",
+		},
+	}
+
+	spec := expandAutoScalingLaunchTemplateSpecification(l, false)
+	if aws.StringValue(spec.LaunchTemplateId) != "lt-12345678" || spec.LaunchTemplateName != nil {
+		t.Fatalf("Expected the launch template ID only, got %s", spec)
+	}
+	if aws.StringValue(spec.Version) != "$Latest" {
+		t.Fatalf("Expected version $Latest, got %s", spec)
+	}
+
+	spec = expandAutoScalingLaunchTemplateSpecification(l, true)
+	if aws.StringValue(spec.LaunchTemplateName) != "foo" || spec.LaunchTemplateId != nil {
+		t.Fatalf("Expected the launch template name only, got %s", spec)
+	}
+
+	if spec := expandAutoScalingLaunchTemplateSpecification(nil, false); spec != nil {
+		t.Fatalf("Expected no launch template, got %s", spec)
+	}
+}
+
+func testAccCheckAWSAutoScalingGroupNotRecreated(before, after *autoscaling.Group) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(before.CreatedTime).Equal(aws.TimeValue(after.CreatedTime)) {
+			return fmt.Errorf("Autoscaling group %s was recreated", aws.StringValue(after.AutoScalingGroupName))
+		}
+		return nil
+	}
+}
+
 const testAccAWSAutoScalingGroupConfig_autoGeneratedName = `
 data "aws_ami" "test_ami" {
   most_recent = true
@@ -2221,3 +2289,42 @@ resource "aws_launch_configuration" "test" {
   instance_type = "t2.micro"
 }
 `
+
+func testAccAWSAutoScalingGroupConfig_launchTemplate(name, instanceType string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_launch_template" "test" {
+  name          = "%[1]s"
+  image_id      = "${data.aws_ami.test_ami.id}"
+  instance_type = "%[2]s"
+}
+
+resource "aws_autoscaling_group" "test" {
+  name               = "%[1]s"
+  availability_zones = ["${data.aws_availability_zones.available.names[0]}"]
+  desired_capacity   = 0
+  max_size           = 0
+  min_size           = 0
+
+  launch_template {
+    id      = "${aws_launch_template.test.id}"
+    version = "${aws_launch_template.test.latest_version}"
+  }
+}
+`, name, instanceType)
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -19,6 +20,9 @@ func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
 		Read:   resourceAwsAutoscalingLifecycleHookRead,
 		Update: resourceAwsAutoscalingLifecycleHookPut,
 		Delete: resourceAwsAutoscalingLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingLifecycleHookImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -76,6 +80,19 @@ func resourceAwsAutoscalingLifecycleHookPutOp(conn *autoscaling.AutoScaling, par
 	})
 }
 
+func resourceAwsAutoscalingLifecycleHookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ASG-NAME/LIFECYCLE-HOOK-NAME", d.Id())
+	}
+
+	d.Set("autoscaling_group_name", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingLifecycleHookPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 	params := getAwsAutoscalingPutLifecycleHookInput(d)
@@ -29,6 +29,12 @@ func TestAccAWSAutoscalingLifecycleHook_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_autoscaling_lifecycle_hook.foobar", "lifecycle_transition", "autoscaling:EC2_INSTANCE_LAUNCHING"),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_lifecycle_hook.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_autoscaling_lifecycle_hook.foobar", "autoscaling_group_name", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -16,6 +16,9 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 		Read:   resourceAwsAutoscalingNotificationRead,
 		Update: resourceAwsAutoscalingNotificationUpdate,
 		Delete: resourceAwsAutoscalingNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingNotificationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"topic_arn": &schema.Schema{
@@ -41,6 +44,12 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 	}
 }
 
+func resourceAwsAutoscalingNotificationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("topic_arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingNotificationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 	gl := convertSetToList(d.Get("group_names").(*schema.Set))
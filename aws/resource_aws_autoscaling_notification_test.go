@@ -29,6 +29,11 @@ func TestAccAWSASGNotification_basic(t *testing.T) {
 					testAccCheckAWSASGNotificationAttributes("aws_autoscaling_notification.example", &asgn),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_autoscaling_notification.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -19,6 +20,9 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 		Read:   resourceAwsAutoscalingPolicyRead,
 		Update: resourceAwsAutoscalingPolicyUpdate,
 		Delete: resourceAwsAutoscalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
@@ -177,6 +181,19 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsAutoscalingPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ASG-NAME/POLICY-NAME", d.Id())
+	}
+
+	d.Set("autoscaling_group_name", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	autoscalingconn := meta.(*AWSClient).autoscalingconn
 
@@ -44,6 +44,12 @@ func TestAccAWSAutoscalingPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_autoscaling_policy.foobar_step", "autoscaling_group_name", name),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_autoscaling_policy.foobar_simple",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_autoscaling_policy.foobar_simple", "autoscaling_group_name", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 		Read:   resourceAwsAutoscalingScheduleRead,
 		Update: resourceAwsAutoscalingScheduleCreate,
 		Delete: resourceAwsAutoscalingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingScheduleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -71,6 +75,18 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 	}
 }
 
+func resourceAwsAutoscalingScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ASG-NAME/SCHEDULED-ACTION-NAME", d.Id())
+	}
+
+	d.Set("autoscaling_group_name", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingScheduleCreate(d *schema.ResourceData, meta interface{}) error {
 	autoscalingconn := meta.(*AWSClient).autoscalingconn
 	params := &autoscaling.PutScheduledUpdateGroupActionInput{
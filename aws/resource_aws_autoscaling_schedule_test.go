@@ -29,6 +29,12 @@ func TestAccAWSAutoscalingSchedule_basic(t *testing.T) {
 					testAccCheckScalingScheduleExists("aws_autoscaling_schedule.foobar", &schedule),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_schedule.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_autoscaling_schedule.foobar", "autoscaling_group_name", "scheduled_action_name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -18,6 +18,9 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 		Read:   resourceAwsBatchComputeEnvironmentRead,
 		Update: resourceAwsBatchComputeEnvironmentUpdate,
 		Delete: resourceAwsBatchComputeEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBatchComputeEnvironmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"compute_environment_name": {
@@ -142,6 +145,12 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 	}
 }
 
+func resourceAwsBatchComputeEnvironmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("compute_environment_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 
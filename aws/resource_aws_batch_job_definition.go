@@ -17,6 +17,9 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 		Create: resourceAwsBatchJobDefinitionCreate,
 		Read:   resourceAwsBatchJobDefinitionRead,
 		Delete: resourceAwsBatchJobDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBatchJobDefinitionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -74,6 +77,12 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 	}
 }
 
+func resourceAwsBatchJobDefinitionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 	name := d.Get("name").(string)
@@ -121,6 +130,7 @@ func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("arn", job.JobDefinitionArn)
 	d.Set("container_properties", job.ContainerProperties)
+	d.Set("name", job.JobDefinitionName)
 	d.Set("parameters", aws.StringValueMap(job.Parameters))
 	d.Set("retry_strategy", flattenRetryStrategy(job.RetryStrategy))
 	d.Set("revision", job.Revision)
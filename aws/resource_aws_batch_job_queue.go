@@ -18,6 +18,9 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 		Read:   resourceAwsBatchJobQueueRead,
 		Update: resourceAwsBatchJobQueueUpdate,
 		Delete: resourceAwsBatchJobQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBatchJobQueueImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"compute_environments": {
@@ -48,6 +51,23 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 	}
 }
 
+func resourceAwsBatchJobQueueImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).batchconn
+
+	jq, err := getJobQueue(conn, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if jq == nil {
+		return nil, fmt.Errorf("Batch Job Queue (%s) not found", d.Id())
+	}
+
+	d.Set("name", jq.JobQueueName)
+	d.SetId(aws.StringValue(jq.JobQueueArn))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 	input := batch.CreateJobQueueInput{
@@ -0,0 +1,664 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const budgetsTimePeriodLayout = "2006-01-02_15:04"
+
+func resourceAwsBudgetsBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBudgetsBudgetCreate,
+		Read:   resourceAwsBudgetsBudgetRead,
+		Update: resourceAwsBudgetsBudgetUpdate,
+		Delete: resourceAwsBudgetsBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBudgetsBudgetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateBudgetsBudgetName,
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBudgetsBudgetName,
+			},
+			"budget_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.BudgetTypeCost,
+					budgets.BudgetTypeRiUtilization,
+					budgets.BudgetTypeUsage,
+				}, false),
+			},
+			"limit_amount": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentBudgetsLimitAmountDiffs,
+			},
+			"limit_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cost_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_credit": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_discount": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_other_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_recurring": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_refund": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_subscription": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_tax": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"include_upfront": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"use_amortized": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_blended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"cost_filters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"time_period_start": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateBudgetsTimePeriod,
+			},
+			"time_period_end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateBudgetsTimePeriod,
+			},
+			"time_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					budgets.TimeUnitAnnually,
+					budgets.TimeUnitDaily,
+					budgets.TimeUnitMonthly,
+					budgets.TimeUnitQuarterly,
+				}, false),
+			},
+			"notification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ComparisonOperatorEqualTo,
+								budgets.ComparisonOperatorGreaterThan,
+								budgets.ComparisonOperatorLessThan,
+							}, false),
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.NotificationTypeActual,
+								budgets.NotificationTypeForecasted,
+							}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  budgets.ThresholdTypePercentage,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypeAbsoluteValue,
+								budgets.ThresholdTypePercentage,
+							}, false),
+						},
+						"subscriber_email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"subscriber_sns_topic_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountId = v.(string)
+	}
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	} else {
+		name = resource.UniqueId()
+	}
+
+	budget, err := expandBudgetsBudget(d, name)
+	if err != nil {
+		return err
+	}
+
+	notifications, err := expandBudgetsNotificationsWithSubscribers(d.Get("notification").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.CreateBudgetInput{
+		AccountId:                    aws.String(accountId),
+		Budget:                       budget,
+		NotificationsWithSubscribers: notifications,
+	}
+
+	log.Printf("[DEBUG] Creating Budget: %s", input)
+	if _, err := conn.CreateBudget(input); err != nil {
+		return fmt.Errorf("Error creating Budget (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", accountId, name))
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId, name, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountId),
+		BudgetName: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Budget (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Budget (%s): %s", d.Id(), err)
+	}
+
+	budget := resp.Budget
+	if budget == nil {
+		log.Printf("[WARN] Budget (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountId)
+	d.Set("budget_type", budget.BudgetType)
+	d.Set("name", budget.BudgetName)
+	d.Set("time_unit", budget.TimeUnit)
+
+	if budget.BudgetLimit != nil {
+		d.Set("limit_amount", budget.BudgetLimit.Amount)
+		d.Set("limit_unit", budget.BudgetLimit.Unit)
+	}
+
+	if err := d.Set("cost_filters", flattenBudgetsCostFilters(budget.CostFilters)); err != nil {
+		return fmt.Errorf("error setting cost_filters: %s", err)
+	}
+
+	if err := d.Set("cost_types", flattenBudgetsCostTypes(budget.CostTypes)); err != nil {
+		return fmt.Errorf("error setting cost_types: %s", err)
+	}
+
+	if budget.TimePeriod != nil {
+		if budget.TimePeriod.Start != nil {
+			d.Set("time_period_start", budget.TimePeriod.Start.UTC().Format(budgetsTimePeriodLayout))
+		}
+		if budget.TimePeriod.End != nil {
+			d.Set("time_period_end", budget.TimePeriod.End.UTC().Format(budgetsTimePeriodLayout))
+		}
+	}
+
+	notifications, err := resourceAwsBudgetsBudgetReadNotifications(conn, accountId, name)
+	if err != nil {
+		return fmt.Errorf("Error reading Budget (%s) notifications: %s", d.Id(), err)
+	}
+
+	if err := d.Set("notification", notifications); err != nil {
+		return fmt.Errorf("error setting notification: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId, name, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("budget_type") || d.HasChange("limit_amount") || d.HasChange("limit_unit") ||
+		d.HasChange("cost_types") || d.HasChange("cost_filters") || d.HasChange("time_period_start") ||
+		d.HasChange("time_period_end") || d.HasChange("time_unit") {
+		budget, err := expandBudgetsBudget(d, name)
+		if err != nil {
+			return err
+		}
+
+		input := &budgets.UpdateBudgetInput{
+			AccountId: aws.String(accountId),
+			NewBudget: budget,
+		}
+
+		log.Printf("[DEBUG] Updating Budget: %s", input)
+		if _, err := conn.UpdateBudget(input); err != nil {
+			return fmt.Errorf("Error updating Budget (%s): %s", d.Id(), err)
+		}
+	}
+
+	// Notifications are identified by their settings, so changed notifications
+	// are replaced along with their subscribers.
+	if d.HasChange("notification") {
+		o, n := d.GetChange("notification")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, v := range os.Difference(ns).List() {
+			notification := expandBudgetsNotification(v.(map[string]interface{}))
+
+			log.Printf("[DEBUG] Deleting Budget (%s) notification: %s", d.Id(), notification)
+			_, err := conn.DeleteNotification(&budgets.DeleteNotificationInput{
+				AccountId:    aws.String(accountId),
+				BudgetName:   aws.String(name),
+				Notification: notification,
+			})
+			if err != nil && !isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				return fmt.Errorf("Error deleting Budget (%s) notification: %s", d.Id(), err)
+			}
+		}
+
+		added, err := expandBudgetsNotificationsWithSubscribers(ns.Difference(os).List())
+		if err != nil {
+			return err
+		}
+
+		for _, v := range added {
+			input := &budgets.CreateNotificationInput{
+				AccountId:    aws.String(accountId),
+				BudgetName:   aws.String(name),
+				Notification: v.Notification,
+				Subscribers:  v.Subscribers,
+			}
+
+			log.Printf("[DEBUG] Creating Budget (%s) notification: %s", d.Id(), input)
+			if _, err := conn.CreateNotification(input); err != nil {
+				return fmt.Errorf("Error creating Budget (%s) notification: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsBudgetsBudgetRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountId, name, err := decodeBudgetsBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Budget: %s", d.Id())
+	_, err = conn.DeleteBudget(&budgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountId),
+		BudgetName: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Budget (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsBudgetsBudgetImport accepts either ACCOUNTID:BUDGETNAME or a
+// budget name alone, which is assumed to be in the provider's account.
+func resourceAwsBudgetsBudgetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), ":") {
+		accountId := meta.(*AWSClient).accountid
+		if accountId == "" {
+			return nil, fmt.Errorf("Unable to determine the account ID for Budget %q, import it as ACCOUNTID:BUDGETNAME", d.Id())
+		}
+		d.SetId(fmt.Sprintf("%s:%s", accountId, d.Id()))
+	}
+
+	if _, _, err := decodeBudgetsBudgetID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsBudgetsBudgetReadNotifications(conn *budgets.Budgets, accountId, name string) ([]map[string]interface{}, error) {
+	var notifications []*budgets.Notification
+	input := &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountId),
+		BudgetName: aws.String(name),
+	}
+	for {
+		resp, err := conn.DescribeNotificationsForBudget(input)
+		if err != nil {
+			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				break
+			}
+			return nil, err
+		}
+		notifications = append(notifications, resp.Notifications...)
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	result := make([]map[string]interface{}, 0, len(notifications))
+	for _, notification := range notifications {
+		var subscribers []*budgets.Subscriber
+		subInput := &budgets.DescribeSubscribersForNotificationInput{
+			AccountId:    aws.String(accountId),
+			BudgetName:   aws.String(name),
+			Notification: notification,
+		}
+		for {
+			resp, err := conn.DescribeSubscribersForNotification(subInput)
+			if err != nil {
+				if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+					break
+				}
+				return nil, err
+			}
+			subscribers = append(subscribers, resp.Subscribers...)
+			if aws.StringValue(resp.NextToken) == "" {
+				break
+			}
+			subInput.NextToken = resp.NextToken
+		}
+
+		result = append(result, flattenBudgetsNotification(notification, subscribers))
+	}
+
+	return result, nil
+}
+
+func decodeBudgetsBudgetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected ACCOUNTID:BUDGETNAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandBudgetsBudget(d *schema.ResourceData, name string) (*budgets.Budget, error) {
+	budget := &budgets.Budget{
+		BudgetName: aws.String(name),
+		BudgetType: aws.String(d.Get("budget_type").(string)),
+		BudgetLimit: &budgets.Spend{
+			Amount: aws.String(d.Get("limit_amount").(string)),
+			Unit:   aws.String(d.Get("limit_unit").(string)),
+		},
+		CostFilters: expandBudgetsCostFilters(d.Get("cost_filters").(map[string]interface{})),
+		CostTypes:   expandBudgetsCostTypes(d.Get("cost_types").([]interface{})),
+		TimeUnit:    aws.String(d.Get("time_unit").(string)),
+	}
+
+	start, err := time.Parse(budgetsTimePeriodLayout, d.Get("time_period_start").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing time_period_start: %s", err)
+	}
+	budget.TimePeriod = &budgets.TimePeriod{
+		Start: aws.Time(start),
+	}
+
+	if v, ok := d.GetOk("time_period_end"); ok {
+		end, err := time.Parse(budgetsTimePeriodLayout, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing time_period_end: %s", err)
+		}
+		budget.TimePeriod.End = aws.Time(end)
+	}
+
+	return budget, nil
+}
+
+func expandBudgetsCostFilters(m map[string]interface{}) map[string][]*string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	filters := make(map[string][]*string, len(m))
+	for k, v := range m {
+		filters[k] = []*string{aws.String(v.(string))}
+	}
+	return filters
+}
+
+func flattenBudgetsCostFilters(filters map[string][]*string) map[string]interface{} {
+	m := make(map[string]interface{}, len(filters))
+	for k, v := range filters {
+		if len(v) > 0 {
+			m[k] = aws.StringValue(v[0])
+		}
+	}
+	return m
+}
+
+func expandBudgetsCostTypes(l []interface{}) *budgets.CostTypes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &budgets.CostTypes{
+		IncludeCredit:            aws.Bool(m["include_credit"].(bool)),
+		IncludeDiscount:          aws.Bool(m["include_discount"].(bool)),
+		IncludeOtherSubscription: aws.Bool(m["include_other_subscription"].(bool)),
+		IncludeRecurring:         aws.Bool(m["include_recurring"].(bool)),
+		IncludeRefund:            aws.Bool(m["include_refund"].(bool)),
+		IncludeSubscription:      aws.Bool(m["include_subscription"].(bool)),
+		IncludeSupport:           aws.Bool(m["include_support"].(bool)),
+		IncludeTax:               aws.Bool(m["include_tax"].(bool)),
+		IncludeUpfront:           aws.Bool(m["include_upfront"].(bool)),
+		UseAmortized:             aws.Bool(m["use_amortized"].(bool)),
+		UseBlended:               aws.Bool(m["use_blended"].(bool)),
+	}
+}
+
+func flattenBudgetsCostTypes(costTypes *budgets.CostTypes) []map[string]interface{} {
+	if costTypes == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"include_credit":             aws.BoolValue(costTypes.IncludeCredit),
+		"include_discount":           aws.BoolValue(costTypes.IncludeDiscount),
+		"include_other_subscription": aws.BoolValue(costTypes.IncludeOtherSubscription),
+		"include_recurring":          aws.BoolValue(costTypes.IncludeRecurring),
+		"include_refund":             aws.BoolValue(costTypes.IncludeRefund),
+		"include_subscription":       aws.BoolValue(costTypes.IncludeSubscription),
+		"include_support":            aws.BoolValue(costTypes.IncludeSupport),
+		"include_tax":                aws.BoolValue(costTypes.IncludeTax),
+		"include_upfront":            aws.BoolValue(costTypes.IncludeUpfront),
+		"use_amortized":              aws.BoolValue(costTypes.UseAmortized),
+		"use_blended":                aws.BoolValue(costTypes.UseBlended),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandBudgetsNotification(m map[string]interface{}) *budgets.Notification {
+	return &budgets.Notification{
+		ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+		NotificationType:   aws.String(m["notification_type"].(string)),
+		Threshold:          aws.Float64(m["threshold"].(float64)),
+		ThresholdType:      aws.String(m["threshold_type"].(string)),
+	}
+}
+
+func expandBudgetsNotificationsWithSubscribers(l []interface{}) ([]*budgets.NotificationWithSubscribers, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*budgets.NotificationWithSubscribers, 0, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		var subscribers []*budgets.Subscriber
+		for _, address := range m["subscriber_email_addresses"].(*schema.Set).List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeEmail),
+			})
+		}
+		for _, arn := range m["subscriber_sns_topic_arns"].(*schema.Set).List() {
+			subscribers = append(subscribers, &budgets.Subscriber{
+				Address:          aws.String(arn.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+			})
+		}
+
+		if len(subscribers) == 0 {
+			return nil, fmt.Errorf("Budget notifications must have at least one subscriber_email_addresses or subscriber_sns_topic_arns entry")
+		}
+
+		result = append(result, &budgets.NotificationWithSubscribers{
+			Notification: expandBudgetsNotification(m),
+			Subscribers:  subscribers,
+		})
+	}
+
+	return result, nil
+}
+
+func flattenBudgetsNotification(notification *budgets.Notification, subscribers []*budgets.Subscriber) map[string]interface{} {
+	var emailAddresses, snsTopicArns []interface{}
+	for _, subscriber := range subscribers {
+		switch aws.StringValue(subscriber.SubscriptionType) {
+		case budgets.SubscriptionTypeEmail:
+			emailAddresses = append(emailAddresses, aws.StringValue(subscriber.Address))
+		case budgets.SubscriptionTypeSns:
+			snsTopicArns = append(snsTopicArns, aws.StringValue(subscriber.Address))
+		}
+	}
+
+	thresholdType := aws.StringValue(notification.ThresholdType)
+	if thresholdType == "" {
+		thresholdType = budgets.ThresholdTypePercentage
+	}
+
+	return map[string]interface{}{
+		"comparison_operator":        aws.StringValue(notification.ComparisonOperator),
+		"notification_type":          aws.StringValue(notification.NotificationType),
+		"threshold":                  aws.Float64Value(notification.Threshold),
+		"threshold_type":             thresholdType,
+		"subscriber_email_addresses": schema.NewSet(schema.HashString, emailAddresses),
+		"subscriber_sns_topic_arns":  schema.NewSet(schema.HashString, snsTopicArns),
+	}
+}
@@ -0,0 +1,319 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeBudgetsBudgetID(t *testing.T) {
+	cases := []struct {
+		ID        string
+		AccountID string
+		Name      string
+		ErrCount  int
+	}{
+		{
+			ID:        "123456789012:my-budget",
+			AccountID: "123456789012",
+			Name:      "my-budget",
+		},
+		{
+			ID:       "my-budget",
+			ErrCount: 1,
+		},
+		{
+			ID:       "123456789012:",
+			ErrCount: 1,
+		},
+		{
+			ID:       ":my-budget",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		accountID, name, err := decodeBudgetsBudgetID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if accountID != tc.AccountID || name != tc.Name {
+			t.Fatalf("expected %q to parse as %s/%s, received: %s/%s",
+				tc.ID, tc.AccountID, tc.Name, accountID, name)
+		}
+	}
+}
+
+func TestAccAWSBudgetsBudget_basic(t *testing.T) {
+	var budget budgets.Budget
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_basic(rName, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestMatchResourceAttr(resourceName, "account_id", regexp.MustCompile(`^\d{12}$`)),
+					resource.TestCheckResourceAttr(resourceName, "budget_type", "COST"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.0.include_tax", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.0.use_blended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "limit_amount", "100.0"),
+					resource.TestCheckResourceAttr(resourceName, "limit_unit", "USD"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "time_period_start", "2017-01-01_00:00"),
+					resource.TestCheckResourceAttr(resourceName, "time_period_end", "2087-06-15_00:00"),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "MONTHLY"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_basic(rName, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "limit_amount", "200.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudget_full(t *testing.T) {
+	var budget budgets.Budget
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_full(rName, "Amazon Elastic Compute Cloud - Compute", 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "cost_filters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cost_filters.Service", "Amazon Elastic Compute Cloud - Compute"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.0.include_credit", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cost_types.0.use_blended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "time_period_end", "2087-01-01_00:00"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_full(rName, "Amazon Simple Storage Service", 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "cost_filters.Service", "Amazon Simple Storage Service"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudget_namePrefix(t *testing.T) {
+	var budget budgets.Budget
+
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_namePrefix,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBudgetsBudgetExists(resourceName, &budget),
+					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile("^tf-acc-test-")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSBudgetsBudgetExists(n string, budget *budgets.Budget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Budget ID is set")
+		}
+
+		accountID, name, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).budgetconn
+		resp, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Budget == nil {
+			return fmt.Errorf("Budget %q not found", rs.Primary.ID)
+		}
+
+		*budget = *resp.Budget
+		return nil
+	}
+}
+
+func testAccCheckAWSBudgetsBudgetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).budgetconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_budgets_budget" {
+			continue
+		}
+
+		accountID, name, err := decodeBudgetsBudgetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeBudget(&budgets.DescribeBudgetInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Budget %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSBudgetsBudgetConfig_basic(rName, limit string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = "%s"
+  budget_type       = "COST"
+  limit_amount      = "%s"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+}
+`, rName, limit)
+}
+
+func testAccAWSBudgetsBudgetConfig_full(rName, service string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "%[1]s"
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["SNS:Publish"]
+    resources = ["${aws_sns_topic.test.arn}"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["budgets.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_sns_topic_policy" "test" {
+  arn    = "${aws_sns_topic.test.arn}"
+  policy = "${data.aws_iam_policy_document.test.json}"
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = "%[1]s"
+  budget_type       = "COST"
+  limit_amount      = "500"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_00:00"
+  time_period_end   = "2087-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  cost_filters {
+    Service = "%[2]s"
+  }
+
+  cost_types {
+    include_credit = false
+    use_blended    = true
+  }
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    notification_type          = "ACTUAL"
+    threshold                  = %[3]d
+    subscriber_email_addresses = ["budgets@example.com"]
+  }
+
+  notification {
+    comparison_operator       = "GREATER_THAN"
+    notification_type         = "FORECASTED"
+    threshold                 = 100
+    threshold_type            = "PERCENTAGE"
+    subscriber_sns_topic_arns = ["${aws_sns_topic.test.arn}"]
+  }
+
+  depends_on = ["aws_sns_topic_policy.test"]
+}
+`, rName, service, threshold)
+}
+
+const testAccAWSBudgetsBudgetConfig_namePrefix = `
+resource "aws_budgets_budget" "test" {
+  name_prefix       = "tf-acc-test-"
+  budget_type       = "USAGE"
+  limit_amount      = "100"
+  limit_unit        = "GB"
+  time_period_start = "2017-01-01_00:00"
+  time_unit         = "MONTHLY"
+
+  cost_filters {
+    UsageType = "USE1-DataTransfer-Out-Bytes"
+  }
+}
+`
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,8 +24,14 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 		Update: resourceAwsCloudFormationStackUpdate,
 		Delete: resourceAwsCloudFormationStackDelete,
 
+		CustomizeDiff: resourceAwsCloudFormationStackCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("use_change_sets", false)
+				d.Set("abort_on_replacement", false)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -110,6 +118,40 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"use_change_sets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"abort_on_replacement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -155,7 +197,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("policy_url"); ok {
 		input.StackPolicyURL = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 	if v, ok := d.GetOk("timeout_in_minutes"); ok {
@@ -350,12 +392,86 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// The planned changes only ever describe a pending update
+	d.Set("planned_changes", nil)
+
 	return nil
 }
 
 func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
+	if d.Get("use_change_sets").(bool) {
+		if err := resourceAwsCloudFormationStackUpdateWithChangeSet(d, conn); err != nil {
+			return err
+		}
+	} else {
+		if err := resourceAwsCloudFormationStackUpdateStack(d, conn); err != nil {
+			return err
+		}
+	}
+
+	lastUpdatedTime, err := getLastCfEventTimestamp(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	var lastStatus string
+	var stackId string
+	wait := resource.StateChangeConf{
+		Pending: []string{
+			"UPDATE_COMPLETE_CLEANUP_IN_PROGRESS",
+			"UPDATE_IN_PROGRESS",
+			"UPDATE_ROLLBACK_IN_PROGRESS",
+			"UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS",
+		},
+		Target: []string{
+			"CREATE_COMPLETE", // If no stack update was performed
+			"UPDATE_COMPLETE",
+			"UPDATE_ROLLBACK_COMPLETE",
+			"UPDATE_ROLLBACK_FAILED",
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+				StackName: aws.String(d.Id()),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to describe stacks: %s", err)
+				return nil, "", err
+			}
+
+			stackId = aws.StringValue(resp.Stacks[0].StackId)
+
+			status := *resp.Stacks[0].StackStatus
+			lastStatus = status
+			log.Printf("[DEBUG] Current CloudFormation stack status: %q", status)
+
+			return resp, status, err
+		},
+	}
+
+	_, err = wait.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	if lastStatus == "UPDATE_ROLLBACK_COMPLETE" || lastStatus == "UPDATE_ROLLBACK_FAILED" {
+		reasons, err := getCloudFormationRollbackReasons(stackId, lastUpdatedTime, conn)
+		if err != nil {
+			return fmt.Errorf("Failed getting details about rollback: %q", err.Error())
+		}
+
+		return fmt.Errorf("%s: %q", lastStatus, reasons)
+	}
+
+	log.Printf("[DEBUG] CloudFormation stack %q has been updated", stackId)
+
+	return resourceAwsCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackUpdateStack(d *schema.ResourceData, conn *cloudformation.CloudFormation) error {
 	input := &cloudformation.UpdateStackInput{
 		StackName: aws.String(d.Id()),
 	}
@@ -386,7 +502,7 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 
@@ -419,64 +535,320 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] Current CloudFormation stack has no updates")
 	}
 
-	lastUpdatedTime, err := getLastCfEventTimestamp(d.Id(), conn)
+	return nil
+}
+
+func resourceAwsCloudFormationStackUpdateWithChangeSet(d *schema.ResourceData, conn *cloudformation.CloudFormation) error {
+	// Stack policies are not part of change sets
+	if d.HasChange("policy_body") || d.HasChange("policy_url") {
+		input := &cloudformation.SetStackPolicyInput{
+			StackName: aws.String(d.Id()),
+		}
+		if d.HasChange("policy_body") {
+			policy, err := structure.NormalizeJsonString(d.Get("policy_body"))
+			if err != nil {
+				return errwrap.Wrapf("policy body contains an invalid JSON: {{err}}", err)
+			}
+			input.StackPolicyBody = aws.String(policy)
+		}
+		if d.HasChange("policy_url") {
+			input.StackPolicyURL = aws.String(d.Get("policy_url").(string))
+		}
+
+		log.Printf("[DEBUG] Setting CloudFormation stack policy: %s", input)
+		if _, err := conn.SetStackPolicy(input); err != nil {
+			return fmt.Errorf("Error setting CloudFormation stack (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	input, err := expandCloudFormationChangeSetInput(d, d.Get("tags_all").(map[string]interface{}))
 	if err != nil {
 		return err
 	}
 
-	var lastStatus string
-	var stackId string
+	changes, err := createCloudFormationChangeSet(conn, input, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	if changes == nil {
+		log.Printf("[DEBUG] Current CloudFormation stack has no updates")
+		return deleteCloudFormationChangeSet(conn, input)
+	}
+
+	if d.Get("abort_on_replacement").(bool) {
+		if replacements := cloudFormationChangeSetReplacements(changes); len(replacements) > 0 {
+			if err := deleteCloudFormationChangeSet(conn, input); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+			return fmt.Errorf("CloudFormation change set %s would replace resources of stack (%s), aborting update: %s",
+				aws.StringValue(input.ChangeSetName), d.Id(), strings.Join(replacements, ", "))
+		}
+	}
+
+	log.Printf("[DEBUG] Executing CloudFormation change set %s", aws.StringValue(input.ChangeSetName))
+	_, err = conn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: input.ChangeSetName,
+		StackName:     input.StackName,
+	})
+	if err != nil {
+		return fmt.Errorf("Error executing CloudFormation change set %s: %s", aws.StringValue(input.ChangeSetName), err)
+	}
+
+	return nil
+}
+
+// resourceAwsCloudFormationStackCustomizeDiff previews the changes of a stack
+// update in the plan by creating, and then discarding, a change set.
+func resourceAwsCloudFormationStackCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("use_change_sets").(bool) {
+		return nil
+	}
+
+	// The stack will be replaced
+	for _, k := range []string{"name", "disable_rollback", "on_failure", "timeout_in_minutes"} {
+		if diff.HasChange(k) {
+			return nil
+		}
+	}
+
+	keys := []string{"template_body", "template_url", "capabilities.#", "notification_arns.#", "parameters.%", "tags.%", "iam_role_arn"}
+	for _, k := range keys {
+		if _, ok := diff.GetOk(k); !ok && len(diff.GetChangedKeysPrefix(k)) > 0 {
+			// The value is not known until apply
+			return diff.SetNewComputed("planned_changes")
+		}
+	}
+
+	// "tags_all" is only planned once this function has run
+	tags := providerTags(meta, diff.Get("tags").(map[string]interface{}))
+	o, _ := diff.GetChange("tags_all")
+	changed := !tagsMapsEqual(o.(map[string]interface{}), tags)
+	for _, k := range []string{"template_body", "template_url", "capabilities", "notification_arns", "parameters", "iam_role_arn"} {
+		if diff.HasChange(k) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).cfconn
+
+	input, err := expandCloudFormationChangeSetInput(diff, tags)
+	if err != nil {
+		return err
+	}
+
+	changes, err := createCloudFormationChangeSet(conn, input, cloudFormationChangeSetPreviewTimeout)
+	if err != nil {
+		return err
+	}
+	if err := deleteCloudFormationChangeSet(conn, input); err != nil {
+		return err
+	}
+
+	// The apply creates the change set again, and must plan the same changes
+	if !cloudFormationChangeSetIsStatic(changes) {
+		return diff.SetNewComputed("planned_changes")
+	}
+
+	return diff.SetNew("planned_changes", flattenCloudFormationChanges(changes))
+}
+
+const cloudFormationChangeSetPreviewTimeout = 5 * time.Minute
+
+// cloudFormationStackConfig is implemented by both schema.ResourceData and
+// schema.ResourceDiff, so that change sets are built alike for the plan and
+// for the update.
+type cloudFormationStackConfig interface {
+	Id() string
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+	HasChange(string) bool
+}
+
+func expandCloudFormationChangeSetInput(d cloudFormationStackConfig, tags map[string]interface{}) (*cloudformation.CreateChangeSetInput, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(resource.UniqueId()),
+		ChangeSetType: aws.String(cloudformation.ChangeSetTypeUpdate),
+		StackName:     aws.String(d.Id()),
+	}
+
+	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return nil, errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+
+	if d.HasChange("notification_arns") {
+		input.NotificationARNs = expandStringList(d.Get("notification_arns").(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = expandCloudFormationTags(tags)
+	}
+
+	if d.HasChange("iam_role_arn") {
+		input.RoleARN = aws.String(d.Get("iam_role_arn").(string))
+	}
+
+	return input, nil
+}
+
+// createCloudFormationChangeSet creates a change set and waits for it to be
+// ready. The changes it contains are returned, or nil if the change set
+// turned out to be empty.
+func createCloudFormationChangeSet(conn *cloudformation.CloudFormation, input *cloudformation.CreateChangeSetInput, timeout time.Duration) ([]*cloudformation.Change, error) {
+	changeSetName := aws.StringValue(input.ChangeSetName)
+
+	log.Printf("[DEBUG] Creating CloudFormation change set: %s", input)
+	if _, err := conn.CreateChangeSet(input); err != nil {
+		return nil, fmt.Errorf("Error creating CloudFormation change set %s: %s", changeSetName, err)
+	}
+
+	var changes []*cloudformation.Change
+	var statusReason string
 	wait := resource.StateChangeConf{
 		Pending: []string{
-			"UPDATE_COMPLETE_CLEANUP_IN_PROGRESS",
-			"UPDATE_IN_PROGRESS",
-			"UPDATE_ROLLBACK_IN_PROGRESS",
-			"UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS",
+			cloudformation.ChangeSetStatusCreatePending,
+			cloudformation.ChangeSetStatusCreateInProgress,
 		},
 		Target: []string{
-			"CREATE_COMPLETE", // If no stack update was performed
-			"UPDATE_COMPLETE",
-			"UPDATE_ROLLBACK_COMPLETE",
-			"UPDATE_ROLLBACK_FAILED",
+			cloudformation.ChangeSetStatusCreateComplete,
+			cloudformation.ChangeSetStatusFailed,
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		MinTimeout: 5 * time.Second,
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
 		Refresh: func() (interface{}, string, error) {
-			resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
-				StackName: aws.String(d.Id()),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to describe stacks: %s", err)
-				return nil, "", err
+			changes = nil
+			describeInput := &cloudformation.DescribeChangeSetInput{
+				ChangeSetName: input.ChangeSetName,
+				StackName:     input.StackName,
 			}
+			for {
+				resp, err := conn.DescribeChangeSet(describeInput)
+				if err != nil {
+					return nil, "", err
+				}
 
-			stackId = aws.StringValue(resp.Stacks[0].StackId)
-
-			status := *resp.Stacks[0].StackStatus
-			lastStatus = status
-			log.Printf("[DEBUG] Current CloudFormation stack status: %q", status)
+				status := aws.StringValue(resp.Status)
+				if status != cloudformation.ChangeSetStatusCreateComplete {
+					statusReason = aws.StringValue(resp.StatusReason)
+					return resp, status, nil
+				}
 
-			return resp, status, err
+				changes = append(changes, resp.Changes...)
+				if resp.NextToken == nil {
+					return resp, status, nil
+				}
+				describeInput.NextToken = resp.NextToken
+			}
 		},
 	}
 
-	_, err = wait.WaitForState()
+	raw, err := wait.WaitForState()
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("Error waiting for CloudFormation change set %s creation: %s", changeSetName, err)
 	}
 
-	if lastStatus == "UPDATE_ROLLBACK_COMPLETE" || lastStatus == "UPDATE_ROLLBACK_FAILED" {
-		reasons, err := getCloudFormationRollbackReasons(stackId, lastUpdatedTime, conn)
-		if err != nil {
-			return fmt.Errorf("Failed getting details about rollback: %q", err.Error())
+	if aws.StringValue(raw.(*cloudformation.DescribeChangeSetOutput).Status) == cloudformation.ChangeSetStatusFailed {
+		// FAILED: The submitted information didn't contain changes.
+		if strings.Contains(statusReason, "didn't contain changes") || strings.Contains(statusReason, "No updates are to be performed") {
+			return nil, nil
 		}
+		return nil, fmt.Errorf("CloudFormation change set %s failed: %s", changeSetName, statusReason)
+	}
 
-		return fmt.Errorf("%s: %q", lastStatus, reasons)
+	return changes, nil
+}
+
+func deleteCloudFormationChangeSet(conn *cloudformation.CloudFormation, input *cloudformation.CreateChangeSetInput) error {
+	_, err := conn.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+		ChangeSetName: input.ChangeSetName,
+		StackName:     input.StackName,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting CloudFormation change set %s: %s", aws.StringValue(input.ChangeSetName), err)
 	}
 
-	log.Printf("[DEBUG] CloudFormation stack %q has been updated", stackId)
+	return nil
+}
 
-	return resourceAwsCloudFormationStackRead(d, meta)
+// cloudFormationChangeSetReplacements returns the logical IDs of the
+// resources which the changes will, or may, replace.
+func cloudFormationChangeSetReplacements(changes []*cloudformation.Change) []string {
+	var replacements []string
+	for _, c := range changes {
+		rc := c.ResourceChange
+		if rc == nil {
+			continue
+		}
+		switch aws.StringValue(rc.Replacement) {
+		case cloudformation.ReplacementTrue, cloudformation.ReplacementConditional:
+			replacements = append(replacements, aws.StringValue(rc.LogicalResourceId))
+		}
+	}
+	sort.Strings(replacements)
+
+	return replacements
+}
+
+// cloudFormationChangeSetIsStatic reports whether the outcome of every change
+// is already known, rather than evaluated when the change set is executed.
+func cloudFormationChangeSetIsStatic(changes []*cloudformation.Change) bool {
+	for _, c := range changes {
+		rc := c.ResourceChange
+		if rc == nil {
+			continue
+		}
+		if aws.StringValue(rc.Replacement) == cloudformation.ReplacementConditional {
+			return false
+		}
+		for _, detail := range rc.Details {
+			if aws.StringValue(detail.Evaluation) == cloudformation.EvaluationTypeDynamic {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func flattenCloudFormationChanges(changes []*cloudformation.Change) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(changes))
+	for _, c := range changes {
+		rc := c.ResourceChange
+		if rc == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"action":              aws.StringValue(rc.Action),
+			"logical_resource_id": aws.StringValue(rc.LogicalResourceId),
+			"replacement":         aws.StringValue(rc.Replacement),
+			"resource_type":       aws.StringValue(rc.ResourceType),
+		})
+	}
+
+	// Keep the plan stable between the plan and apply walks
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["logical_resource_id"].(string) < result[j]["logical_resource_id"].(string)
+	})
+
+	return result
 }
 
 func resourceAwsCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
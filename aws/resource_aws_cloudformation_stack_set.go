@@ -0,0 +1,410 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudFormationStackSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetCreate,
+		Read:   resourceAwsCloudFormationStackSetRead,
+		Update: resourceAwsCloudFormationStackSetUpdate,
+		Delete: resourceAwsCloudFormationStackSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudFormationStackSetName,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"template_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"template_url"},
+				ValidateFunc:  validateCloudFormationTemplate,
+				StateFunc: func(v interface{}) string {
+					template, _ := normalizeCloudFormationTemplate(v)
+					return template
+				},
+			},
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"stack_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// cloudFormationStackSetOperationPreferencesSchema returns the schema of the
+// settings applied to the stack set operations started by a resource.
+func cloudFormationStackSetOperationPreferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"failure_tolerance_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(0),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+				},
+				"failure_tolerance_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(0, 100),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+				},
+				"max_concurrent_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+				},
+				"max_concurrent_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(1, 100),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+				},
+				"region_order": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	name := d.Get("name").(string)
+	input := &cloudformation.CreateStackSetInput{
+		StackSetName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation StackSet: %s", input)
+	if _, err := conn.CreateStackSet(input); err != nil {
+		return fmt.Errorf("Error creating CloudFormation StackSet (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	resp, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	stackSet := resp.StackSet
+	if stackSet == nil || aws.StringValue(stackSet.Status) == cloudformation.StackSetStatusDeleted {
+		log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	template, err := normalizeCloudFormationTemplate(aws.StringValue(stackSet.TemplateBody))
+	if err != nil {
+		return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+	}
+
+	d.Set("description", stackSet.Description)
+	d.Set("name", stackSet.StackSetName)
+	d.Set("stack_set_id", stackSet.StackSetId)
+	d.Set("template_body", template)
+
+	if err := d.Set("capabilities", schema.NewSet(schema.HashString, flattenStringList(stackSet.Capabilities))); err != nil {
+		return fmt.Errorf("error setting capabilities: %s", err)
+	}
+
+	originalParams := d.Get("parameters").(map[string]interface{})
+	if err := d.Set("parameters", flattenCloudFormationParameters(stackSet.Parameters, originalParams)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("tags", flattenCloudFormationTags(stackSet.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	input := &cloudformation.UpdateStackSetInput{
+		OperationId:          aws.String(resource.UniqueId()),
+		OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+		StackSetName:         aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	// Capabilities, parameters and tags must be present whether they are
+	// changed or not
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating CloudFormation StackSet: %s", input)
+	var output *cloudformation.UpdateStackSetOutput
+	err := retryOnCloudFormationStackSetOperationInProgress(d.Timeout(schema.TimeoutUpdate), func() error {
+		var err error
+		output, err = conn.UpdateStackSet(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, d.Id(), aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting for CloudFormation StackSet (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	log.Printf("[INFO] Deleting CloudFormation StackSet: %s", d.Id())
+	_, err := conn.DeleteStackSet(&cloudformation.DeleteStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFormationStackSetOperationPreferences(l []interface{}) *cloudformation.StackSetOperationPreferences {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	preferences := &cloudformation.StackSetOperationPreferences{}
+
+	if v, ok := m["failure_tolerance_count"].(int); ok && v > 0 {
+		preferences.FailureToleranceCount = aws.Int64(int64(v))
+	}
+	if v, ok := m["failure_tolerance_percentage"].(int); ok && v > 0 {
+		preferences.FailureTolerancePercentage = aws.Int64(int64(v))
+	}
+	if v, ok := m["max_concurrent_count"].(int); ok && v > 0 {
+		preferences.MaxConcurrentCount = aws.Int64(int64(v))
+	}
+	if v, ok := m["max_concurrent_percentage"].(int); ok && v > 0 {
+		preferences.MaxConcurrentPercentage = aws.Int64(int64(v))
+	}
+	if v, ok := m["region_order"].([]interface{}); ok && len(v) > 0 {
+		preferences.RegionOrder = expandStringList(v)
+	}
+
+	return preferences
+}
+
+// retryOnCloudFormationStackSetOperationInProgress retries f while another
+// operation is running on the stack set, since CloudFormation only runs one
+// operation per stack set at a time.
+func retryOnCloudFormationStackSetOperationInProgress(timeout time.Duration, f func() error) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		err := f()
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func waitForCloudFormationStackSetOperation(conn *cloudformation.CloudFormation, stackSetName, operationId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackSetOperationStatusRunning,
+			cloudformation.StackSetOperationStatusStopping,
+		},
+		Target:     []string{cloudformation.StackSetOperationStatusSucceeded},
+		Refresh:    cloudFormationStackSetOperationRefreshFunc(conn, stackSetName, operationId),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for CloudFormation StackSet (%s) operation: %s", stackSetName, operationId)
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func cloudFormationStackSetOperationRefreshFunc(conn *cloudformation.CloudFormation, stackSetName, operationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+			OperationId:  aws.String(operationId),
+			StackSetName: aws.String(stackSetName),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		operation := resp.StackSetOperation
+		if operation == nil {
+			return nil, "", fmt.Errorf("operation %s not found", operationId)
+		}
+
+		status := aws.StringValue(operation.Status)
+		switch status {
+		case cloudformation.StackSetOperationStatusFailed, cloudformation.StackSetOperationStatusStopped:
+			reasons, err := getCloudFormationStackSetOperationFailures(conn, stackSetName, operationId)
+			if err != nil {
+				return nil, status, fmt.Errorf("operation %s %s, failed getting failure reasons: %s", operationId, status, err)
+			}
+			return nil, status, fmt.Errorf("operation %s %s: %s", operationId, status, strings.Join(reasons, ", "))
+		}
+
+		return operation, status, nil
+	}
+}
+
+func getCloudFormationStackSetOperationFailures(conn *cloudformation.CloudFormation, stackSetName, operationId string) ([]string, error) {
+	var reasons []string
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		OperationId:  aws.String(operationId),
+		StackSetName: aws.String(stackSetName),
+	}
+	for {
+		resp, err := conn.ListStackSetOperationResults(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range resp.Summaries {
+			if aws.StringValue(summary.Status) == cloudformation.StackSetOperationResultStatusSucceeded {
+				continue
+			}
+			reasons = append(reasons, fmt.Sprintf("%s in %s: %s (%s)",
+				aws.StringValue(summary.Account), aws.StringValue(summary.Region),
+				aws.StringValue(summary.Status), aws.StringValue(summary.StatusReason)))
+		}
+
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	return reasons, nil
+}
@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetInstanceCreate,
+		Read:   resourceAwsCloudFormationStackSetInstanceRead,
+		Update: resourceAwsCloudFormationStackSetInstanceUpdate,
+		Delete: resourceAwsCloudFormationStackSetInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"parameter_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"retain_stack": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudFormationStackSetName,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	accountId := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountId = v.(string)
+	}
+
+	region := meta.(*AWSClient).region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	stackSetName := d.Get("stack_set_name").(string)
+
+	input := &cloudformation.CreateStackInstancesInput{
+		Accounts:             aws.StringSlice([]string{accountId}),
+		OperationId:          aws.String(resource.UniqueId()),
+		OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+		Regions:              aws.StringSlice([]string{region}),
+		StackSetName:         aws.String(stackSetName),
+	}
+
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation StackSet Instance: %s", input)
+	var output *cloudformation.CreateStackInstancesOutput
+	err := retryOnCloudFormationStackSetOperationInProgress(d.Timeout(schema.TimeoutCreate), func() error {
+		var err error
+		output, err = conn.CreateStackInstances(input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating CloudFormation StackSet (%s) Instance: %s", stackSetName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountId, region))
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for CloudFormation StackSet Instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+		StackInstanceAccount: aws.String(accountId),
+		StackInstanceRegion:  aws.String(region),
+		StackSetName:         aws.String(stackSetName),
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+	}
+
+	if resp.StackInstance == nil {
+		log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", resp.StackInstance.Account)
+	d.Set("region", resp.StackInstance.Region)
+	d.Set("stack_id", resp.StackInstance.StackId)
+	d.Set("stack_set_name", stackSetName)
+
+	originalParams := d.Get("parameter_overrides").(map[string]interface{})
+	if err := d.Set("parameter_overrides", flattenCloudFormationParameters(resp.StackInstance.ParameterOverrides, originalParams)); err != nil {
+		return fmt.Errorf("error setting parameter_overrides: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	if d.HasChange("parameter_overrides") {
+		stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		input := &cloudformation.UpdateStackInstancesInput{
+			Accounts:             aws.StringSlice([]string{accountId}),
+			OperationId:          aws.String(resource.UniqueId()),
+			OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+			ParameterOverrides:   []*cloudformation.Parameter{},
+			Regions:              aws.StringSlice([]string{region}),
+			StackSetName:         aws.String(stackSetName),
+		}
+
+		if v, ok := d.GetOk("parameter_overrides"); ok {
+			input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating CloudFormation StackSet Instance: %s", input)
+		var output *cloudformation.UpdateStackInstancesOutput
+		err = retryOnCloudFormationStackSetOperationInProgress(d.Timeout(schema.TimeoutUpdate), func() error {
+			var err error
+			output, err = conn.UpdateStackInstances(input)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for CloudFormation StackSet Instance (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, accountId, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.DeleteStackInstancesInput{
+		Accounts:             aws.StringSlice([]string{accountId}),
+		OperationId:          aws.String(resource.UniqueId()),
+		OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+		Regions:              aws.StringSlice([]string{region}),
+		RetainStacks:         aws.Bool(d.Get("retain_stack").(bool)),
+		StackSetName:         aws.String(stackSetName),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation StackSet Instance: %s", input)
+	var output *cloudformation.DeleteStackInstancesOutput
+	err = retryOnCloudFormationStackSetOperationInProgress(d.Timeout(schema.TimeoutDelete), func() error {
+		var err error
+		output, err = conn.DeleteStackInstances(input)
+		return err
+	})
+	if err != nil {
+		if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for CloudFormation StackSet Instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeCloudFormationStackSetInstanceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected STACKSETNAME,ACCOUNTID,REGION", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
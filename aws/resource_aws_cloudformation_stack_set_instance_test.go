@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeCloudFormationStackSetInstanceID(t *testing.T) {
+	cases := []struct {
+		ID           string
+		StackSetName string
+		AccountID    string
+		Region       string
+		ErrCount     int
+	}{
+		{
+			ID:           "my-stack-set,123456789012,us-east-1",
+			StackSetName: "my-stack-set",
+			AccountID:    "123456789012",
+			Region:       "us-east-1",
+		},
+		{
+			ID:       "my-stack-set,123456789012",
+			ErrCount: 1,
+		},
+		{
+			ID:       "my-stack-set,,us-east-1",
+			ErrCount: 1,
+		},
+		{
+			ID:       "my-stack-set,123456789012,us-east-1,extra",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if stackSetName != tc.StackSetName || accountID != tc.AccountID || region != tc.Region {
+			t.Fatalf("expected %q to parse as %s/%s/%s, received: %s/%s/%s",
+				tc.ID, tc.StackSetName, tc.AccountID, tc.Region, stackSetName, accountID, region)
+		}
+	}
+}
+
+func TestAccAWSCloudFormationStackSetInstance_basic(t *testing.T) {
+	var stackInstance cloudformation.StackInstance
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "retain_stack", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", "aws_cloudformation_stack_set.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operation_preferences", "retain_stack"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_parameterOverrides(t *testing.T) {
+	var stackInstance cloudformation.StackInstance
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig_parameterOverrides(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.VpcCidr", "10.1.0.0/16"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig_parameterOverrides(rName, "10.2.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.VpcCidr", "10.2.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceExists(n string, stackInstance *cloudformation.StackInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFormation StackSet Instance ID is set")
+		}
+
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		resp, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountID),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.StackInstance == nil {
+			return fmt.Errorf("CloudFormation StackSet Instance %q not found", rs.Primary.ID)
+		}
+
+		*stackInstance = *resp.StackInstance
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set_instance" {
+			continue
+		}
+
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountID),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") {
+				continue
+			}
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("CloudFormation StackSet Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cloudformation_stack_set" "test" {
+  name          = "%s"
+  template_body = <<TEMPLATE
+%s
+TEMPLATE
+
+  parameters {
+    VpcCidr = "10.0.0.0/16"
+  }
+}
+`, rName, testAccAWSCloudFormationStackSetTemplateBody)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfig(rName string) string {
+	return testAccAWSCloudFormationStackSetInstanceConfig_base(rName) + `
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+}
+`
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfig_parameterOverrides(rName, vpcCidr string) string {
+	return testAccAWSCloudFormationStackSetInstanceConfig_base(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+
+  parameter_overrides {
+    VpcCidr = "%s"
+  }
+
+  operation_preferences {
+    failure_tolerance_count = 0
+    max_concurrent_count    = 1
+  }
+}
+`, vpcCidr)
+}
@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFormationStackSet_basic(t *testing.T) {
+	var stackSet cloudformation.StackSet
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_set_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfig(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operation_preferences"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_description(t *testing.T) {
+	var stackSet cloudformation.StackSet
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfig_description(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfig_description(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+// Stack sets are managed with the AWSCloudFormationStackSetAdministrationRole
+// role of the administrator account, which must be created beforehand.
+func testAccPreCheckAWSCloudFormationStackSet(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	_, err := conn.GetRole(&iam.GetRoleInput{
+		RoleName: aws.String("AWSCloudFormationStackSetAdministrationRole"),
+	})
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		t.Skip("skipping acceptance test: IAM role AWSCloudFormationStackSetAdministrationRole does not exist")
+	}
+	if err != nil {
+		t.Fatalf("error reading IAM role AWSCloudFormationStackSetAdministrationRole: %s", err)
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetExists(n string, stackSet *cloudformation.StackSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFormation StackSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		resp, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.StackSet == nil {
+			return fmt.Errorf("CloudFormation StackSet %q not found", rs.Primary.ID)
+		}
+
+		*stackSet = *resp.StackSet
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set" {
+			continue
+		}
+
+		resp, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if resp.StackSet != nil && aws.StringValue(resp.StackSet.Status) != cloudformation.StackSetStatusDeleted {
+			return fmt.Errorf("CloudFormation StackSet %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccAWSCloudFormationStackSetTemplateBody = `
+Parameters:
+  VpcCidr:
+    Type: String
+Resources:
+  MyVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: !Ref VpcCidr
+Outputs:
+  VpcId:
+    Value: !Ref MyVpc
+`
+
+func testAccAWSCloudFormationStackSetConfig(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name          = "%s"
+  template_body = <<TEMPLATE
+%s
+TEMPLATE
+
+  parameters {
+    VpcCidr = "10.0.0.0/16"
+  }
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName, testAccAWSCloudFormationStackSetTemplateBody, tagValue)
+}
+
+func testAccAWSCloudFormationStackSetConfig_description(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name          = "%s"
+  description   = "%s"
+  template_body = <<TEMPLATE
+%s
+TEMPLATE
+
+  parameters {
+    VpcCidr = "10.0.0.0/16"
+  }
+}
+`, rName, description, testAccAWSCloudFormationStackSetTemplateBody)
+}
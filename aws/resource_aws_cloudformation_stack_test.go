@@ -2,10 +2,14 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -237,6 +241,242 @@ func TestAccAWSCloudFormation_withUrl_withParams_noUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFormation_useChangeSets(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := fmt.Sprintf("tf-acc-test-change-sets-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack.change_sets"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationConfig_useChangeSets(stackName, "10.0.0.0/16", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "use_change_sets", "true"),
+					resource.TestCheckResourceAttr(resourceName, "abort_on_replacement", "true"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationConfig_useChangeSets(stackName, "10.0.0.0/16", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcTagName", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+			},
+			{
+				Config:      testAccAWSCloudFormationConfig_useChangeSets(stackName, "12.0.0.0/16", "updated"),
+				ExpectError: regexp.MustCompile(`would replace resources`),
+			},
+		},
+	})
+}
+
+func TestCloudFormationChangeSetReplacements(t *testing.T) {
+	changes := []*cloudformation.Change{
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("Subnet"),
+				Replacement:       aws.String(cloudformation.ReplacementConditional),
+			},
+		},
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("Vpc"),
+				Replacement:       aws.String(cloudformation.ReplacementTrue),
+			},
+		},
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("Bucket"),
+				Replacement:       aws.String(cloudformation.ReplacementFalse),
+			},
+		},
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionAdd),
+				LogicalResourceId: aws.String("Queue"),
+			},
+		},
+		{},
+	}
+
+	expected := []string{"Subnet", "Vpc"}
+	if replacements := cloudFormationChangeSetReplacements(changes); !reflect.DeepEqual(replacements, expected) {
+		t.Fatalf("expected replacements %v, received: %v", expected, replacements)
+	}
+}
+
+func TestFlattenCloudFormationChanges(t *testing.T) {
+	changes := []*cloudformation.Change{
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:             aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId:  aws.String("Vpc"),
+				PhysicalResourceId: aws.String("vpc-12345678"),
+				Replacement:        aws.String(cloudformation.ReplacementTrue),
+				ResourceType:       aws.String("AWS::EC2::VPC"),
+			},
+		},
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionAdd),
+				LogicalResourceId: aws.String("Queue"),
+				ResourceType:      aws.String("AWS::SQS::Queue"),
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"action":              "Add",
+			"logical_resource_id": "Queue",
+			"replacement":         "",
+			"resource_type":       "AWS::SQS::Queue",
+		},
+		{
+			"action":              "Modify",
+			"logical_resource_id": "Vpc",
+			"replacement":         "True",
+			"resource_type":       "AWS::EC2::VPC",
+		},
+	}
+	if result := flattenCloudFormationChanges(changes); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected %#v, received: %#v", expected, result)
+	}
+}
+
+func TestCloudFormationChangeSetIsStatic(t *testing.T) {
+	cases := []struct {
+		Change   *cloudformation.ResourceChange
+		Expected bool
+	}{
+		{
+			Change: &cloudformation.ResourceChange{
+				Action:      aws.String(cloudformation.ChangeActionModify),
+				Replacement: aws.String(cloudformation.ReplacementTrue),
+				Details: []*cloudformation.ResourceChangeDetail{
+					{Evaluation: aws.String(cloudformation.EvaluationTypeStatic)},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Change: &cloudformation.ResourceChange{
+				Action: aws.String(cloudformation.ChangeActionAdd),
+			},
+			Expected: true,
+		},
+		{
+			Change: &cloudformation.ResourceChange{
+				Action:      aws.String(cloudformation.ChangeActionModify),
+				Replacement: aws.String(cloudformation.ReplacementConditional),
+			},
+			Expected: false,
+		},
+		{
+			Change: &cloudformation.ResourceChange{
+				Action:      aws.String(cloudformation.ChangeActionModify),
+				Replacement: aws.String(cloudformation.ReplacementFalse),
+				Details: []*cloudformation.ResourceChangeDetail{
+					{Evaluation: aws.String(cloudformation.EvaluationTypeStatic)},
+					{Evaluation: aws.String(cloudformation.EvaluationTypeDynamic)},
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		changes := []*cloudformation.Change{{ResourceChange: tc.Change}}
+		if static := cloudFormationChangeSetIsStatic(changes); static != tc.Expected {
+			t.Fatalf("%d: expected %t, received: %t", i, tc.Expected, static)
+		}
+	}
+}
+
+func TestResourceAwsCloudFormationStackCustomizeDiff(t *testing.T) {
+	r := resourceAwsCloudFormationStack()
+	resourceDefaultTags(r)
+
+	state := &terraform.InstanceState{
+		ID: "arn:aws:cloudformation:us-west-2:123456789012:stack/tf-test/1",
+		Attributes: map[string]string{
+			"name":                 "tf-test",
+			"template_body":        `{"Resources":{}}`,
+			"use_change_sets":      "true",
+			"abort_on_replacement": "false",
+			"disable_rollback":     "false",
+			"parameters.%":         "0",
+			"tags.%":               "0",
+			"tags_all.%":           "0",
+			"planned_changes.#":    "0",
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		TemplateBody string
+		Computed     bool
+	}{
+		// Nothing changes, so no change set is created
+		{
+			Name:         "no changes",
+			TemplateBody: `{"Resources":{}}`,
+		},
+		// The template is not known yet, so neither are the changes
+		{
+			Name:         "unknown until apply",
+			TemplateBody: "${var.template_body}",
+			Computed:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"name":            "tf-test",
+			"template_body":   tc.TemplateBody,
+			"use_change_sets": true,
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Name, err)
+		}
+		err = raw.Interpolate(map[string]ast.Variable{
+			"var.template_body": {
+				Value: config.UnknownVariableValue,
+				Type:  ast.TypeUnknown,
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Name, err)
+		}
+
+		// The AWSClient has no connections, so any AWS call panics
+		diff, err := r.Diff(state, terraform.NewResourceConfig(raw), &AWSClient{})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Name, err)
+		}
+
+		var attr *terraform.ResourceAttrDiff
+		if diff != nil {
+			attr = diff.Attributes["planned_changes.#"]
+		}
+		if computed := attr != nil && attr.NewComputed; computed != tc.Computed {
+			t.Fatalf("%s: expected planned_changes to be computed: %t, received diff: %#v", tc.Name, tc.Computed, attr)
+		}
+		if !tc.Computed && attr != nil {
+			t.Fatalf("%s: expected no planned_changes diff, received: %#v", tc.Name, attr)
+		}
+	}
+}
+
 func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -655,3 +895,44 @@ resource "aws_cloudformation_stack" "with-url-and-params-and-yaml" {
 }
 `, rName, rName, bucketKey, rName, vpcCidr)
 }
+
+func testAccAWSCloudFormationConfig_useChangeSets(stackName, vpcCidr, vpcTagName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "change_sets" {
+  name                 = "%s"
+  use_change_sets      = true
+  abort_on_replacement = true
+
+  parameters {
+    VpcCIDR    = "%s"
+    VpcTagName = "%s"
+  }
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    },
+    "VpcTagName" : {
+      "Description" : "Name tag of the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"},
+        "Tags" : [
+          {"Key": "Name", "Value": {"Ref": "VpcTagName"}}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, stackName, vpcCidr, vpcTagName)
+}
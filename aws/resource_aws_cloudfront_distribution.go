@@ -24,6 +24,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 			State: resourceAwsCloudFrontDistributionImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(70 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -563,7 +567,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               tagsFromMapCloudFront(d.Get("tags").(map[string]interface{})),
+			Tags:               tagsFromMapCloudFront(d.Get("tags_all").(map[string]interface{})),
 		},
 	}
 
@@ -664,7 +668,7 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 	}
 
 	// Distribution needs to be in deployed state again before it can be deleted.
-	err = resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta)
+	err = resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -698,12 +702,12 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
-func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interface{}) error {
+func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interface{}, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"InProgress"},
 		Target:     []string{"Deployed"},
 		Refresh:    resourceAwsCloudFrontWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    70 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 15 * time.Second,
 		Delay:      10 * time.Minute,
 	}
@@ -186,8 +186,9 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 
 	restricted := meta.(*AWSClient).IsChinaCloud() || meta.(*AWSClient).IsGovCloud()
 
-	if !restricted && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if !restricted && d.HasChange("tags_all") {
+		oraw, _ := d.GetChange("tags_all")
+		nraw := d.Get("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffCloudWatchTags(o, n)
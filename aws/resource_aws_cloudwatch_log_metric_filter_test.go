@@ -59,6 +59,12 @@ func TestAccAWSCloudWatchLogMetricFilter_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_log_metric_filter.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_cloudwatch_log_metric_filter.foobar", ":", "log_group_name", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
@@ -16,6 +17,9 @@ func resourceAwsCloudWatchLogStream() *schema.Resource {
 		Create: resourceAwsCloudWatchLogStreamCreate,
 		Read:   resourceAwsCloudWatchLogStreamRead,
 		Delete: resourceAwsCloudWatchLogStreamDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudWatchLogStreamImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -39,6 +43,19 @@ func resourceAwsCloudWatchLogStream() *schema.Resource {
 	}
 }
 
+func resourceAwsCloudWatchLogStreamImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOG-GROUP-NAME:NAME", d.Id())
+	}
+
+	d.Set("log_group_name", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCloudWatchLogStreamCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 
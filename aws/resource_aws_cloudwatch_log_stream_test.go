@@ -26,6 +26,12 @@ func TestAccAWSCloudWatchLogStream_basic(t *testing.T) {
 					testAccCheckCloudWatchLogStreamExists("aws_cloudwatch_log_stream.foobar", &ls),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_log_stream.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_cloudwatch_log_stream.foobar", ":", "log_group_name", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -21,6 +21,9 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 		Read:   resourceAwsCloudwatchLogSubscriptionFilterRead,
 		Update: resourceAwsCloudwatchLogSubscriptionFilterUpdate,
 		Delete: resourceAwsCloudwatchLogSubscriptionFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudwatchLogSubscriptionFilterImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,6 +59,19 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 	}
 }
 
+func resourceAwsCloudwatchLogSubscriptionFilterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOG-GROUP-NAME:NAME", d.Id())
+	}
+
+	d.Set("log_group_name", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(cloudwatchLogsSubscriptionFilterId(idParts[0]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCloudwatchLogSubscriptionFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 	params := getAwsCloudWatchLogsSubscriptionFilterInput(d)
@@ -156,6 +172,9 @@ func resourceAwsCloudwatchLogSubscriptionFilterRead(d *schema.ResourceData, meta
 	for _, subscriptionFilter := range resp.SubscriptionFilters {
 		if *subscriptionFilter.LogGroupName == log_group_name {
 			d.SetId(cloudwatchLogsSubscriptionFilterId(log_group_name))
+			d.Set("destination_arn", subscriptionFilter.DestinationArn)
+			d.Set("filter_pattern", subscriptionFilter.FilterPattern)
+			d.Set("role_arn", subscriptionFilter.RoleArn)
 			return nil // OK, matching subscription filter found
 		}
 	}
@@ -35,6 +35,12 @@ func TestAccAWSCloudwatchLogSubscriptionFilter_basic(t *testing.T) {
 						"aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", "distribution", "Random"),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", ":", "log_group_name", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -30,6 +30,11 @@ func TestAccAWSCodeBuildProject_basic(t *testing.T) {
 						"aws_codebuild_project.foo", "build_timeout", "5"),
 				),
 			},
+			{
+				ResourceName:      "aws_codebuild_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
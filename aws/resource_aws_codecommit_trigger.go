@@ -14,6 +14,9 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 		Create: resourceAwsCodeCommitTriggerCreate,
 		Read:   resourceAwsCodeCommitTriggerRead,
 		Delete: resourceAwsCodeCommitTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCodeCommitTriggerImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"repository_name": &schema.Schema{
@@ -70,6 +73,12 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 	}
 }
 
+func resourceAwsCodeCommitTriggerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("repository_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCodeCommitTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codecommitconn
 
@@ -108,6 +117,12 @@ func resourceAwsCodeCommitTriggerRead(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] CodeCommit Trigger: %s", resp)
 
+	d.Set("repository_name", d.Id())
+	d.Set("configuration_id", resp.ConfigurationId)
+	if err := d.Set("trigger", flattenAwsCodeCommitTriggers(resp.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %s", err)
+	}
+
 	return nil
 }
 
@@ -161,3 +176,17 @@ func expandAwsCodeCommitTriggers(configured []interface{}) []*codecommit.Reposit
 	}
 	return triggers
 }
+
+func flattenAwsCodeCommitTriggers(triggers []*codecommit.RepositoryTrigger) []interface{} {
+	result := make([]interface{}, 0, len(triggers))
+	for _, t := range triggers {
+		result = append(result, map[string]interface{}{
+			"name":            aws.StringValue(t.Name),
+			"destination_arn": aws.StringValue(t.DestinationArn),
+			"custom_data":     aws.StringValue(t.CustomData),
+			"branches":        flattenStringList(t.Branches),
+			"events":          flattenStringList(t.Events),
+		})
+	}
+	return result
+}
@@ -18,6 +18,9 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 		Read:   resourceAwsCodeDeployAppRead,
 		Update: resourceAwsCodeDeployUpdate,
 		Delete: resourceAwsCodeDeployAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCodeDeployAppImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -36,6 +39,22 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 	}
 }
 
+func resourceAwsCodeDeployAppImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).codedeployconn
+
+	application := d.Id()
+	resp, err := conn.GetApplication(&codedeploy.GetApplicationInput{
+		ApplicationName: aws.String(application),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(resp.Application.ApplicationId), application))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCodeDeployAppCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codedeployconn
 
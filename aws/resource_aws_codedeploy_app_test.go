@@ -29,6 +29,12 @@ func TestAccAWSCodeDeployApp_basic(t *testing.T) {
 					testAccCheckAWSCodeDeployAppExists("aws_codedeploy_app.foo"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_codedeploy_app.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_codedeploy_app.foo", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
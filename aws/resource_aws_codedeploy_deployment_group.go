@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -24,6 +25,9 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 		Read:   resourceAwsCodeDeployDeploymentGroupRead,
 		Update: resourceAwsCodeDeployDeploymentGroupUpdate,
 		Delete: resourceAwsCodeDeployDeploymentGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCodeDeployDeploymentGroupImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"app_name": &schema.Schema{
@@ -338,6 +342,29 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 	}
 }
 
+func resourceAwsCodeDeployDeploymentGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected APP-NAME:DEPLOYMENT-GROUP-NAME", d.Id())
+	}
+
+	d.Set("app_name", idParts[0])
+	d.Set("deployment_group_name", idParts[1])
+
+	conn := meta.(*AWSClient).codedeployconn
+	resp, err := conn.GetDeploymentGroup(&codedeploy.GetDeploymentGroupInput{
+		ApplicationName:     aws.String(idParts[0]),
+		DeploymentGroupName: aws.String(idParts[1]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(aws.StringValue(resp.DeploymentGroupInfo.DeploymentGroupId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCodeDeployDeploymentGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codedeployconn
 
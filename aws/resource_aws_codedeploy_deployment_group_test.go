@@ -89,6 +89,12 @@ func TestAccAWSCodeDeployDeploymentGroup_basic(t *testing.T) {
 						"aws_codedeploy_deployment_group.foo", "trigger_configuration.#", "0"),
 				),
 			},
+			resource.TestStep{
+				ResourceName: "aws_codedeploy_deployment_group.foo",
+				ImportState: true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_codedeploy_deployment_group.foo", ":", "app_name", "deployment_group_name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -22,6 +22,9 @@ func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
 		Read:   resourceAwsCognitoIdentityPoolRolesAttachmentRead,
 		Update: resourceAwsCognitoIdentityPoolRolesAttachmentUpdate,
 		Delete: resourceAwsCognitoIdentityPoolRolesAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoIdentityPoolRolesAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"identity_pool_id": {
@@ -116,6 +119,12 @@ func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsCognitoIdentityPoolRolesAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("identity_pool_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCognitoIdentityPoolRolesAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cognitoconn
 
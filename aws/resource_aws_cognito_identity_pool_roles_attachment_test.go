@@ -39,6 +39,11 @@ func TestAccAWSCognitoIdentityPoolRolesAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
 				),
 			},
+			{
+				ResourceName:      "aws_cognito_identity_pool_roles_attachment.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityProviderCreate,
+		Read:   resourceAwsCognitoIdentityProviderRead,
+		Update: resourceAwsCognitoIdentityProviderUpdate,
+		Delete: resourceAwsCognitoIdentityProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateIdentityProvider.html
+		Schema: map[string]*schema.Schema{
+			"attribute_mapping": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"idp_identifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateMaxLength(40),
+				},
+			},
+			"provider_details": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMaxLength(32),
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// OIDC is accepted by the API but not yet part of the SDK enum
+				ValidateFunc: validation.StringInSlice([]string{
+					cognitoidentityprovider.IdentityProviderTypeTypeSaml,
+					"OIDC",
+					cognitoidentityprovider.IdentityProviderTypeTypeFacebook,
+					cognitoidentityprovider.IdentityProviderTypeTypeGoogle,
+					cognitoidentityprovider.IdentityProviderTypeTypeLoginWithAmazon,
+				}, false),
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoIdentityProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId := d.Get("user_pool_id").(string)
+	providerName := d.Get("provider_name").(string)
+
+	params := &cognitoidentityprovider.CreateIdentityProviderInput{
+		ProviderDetails: stringMapToPointers(d.Get("provider_details").(map[string]interface{})),
+		ProviderName:    aws.String(providerName),
+		ProviderType:    aws.String(d.Get("provider_type").(string)),
+		UserPoolId:      aws.String(userPoolId),
+	}
+
+	if v, ok := d.GetOk("attribute_mapping"); ok {
+		params.AttributeMapping = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("idp_identifiers"); ok {
+		params.IdpIdentifiers = expandStringList(v.([]interface{}))
+	}
+
+	log.Print("[DEBUG] Creating Cognito Identity Provider")
+
+	_, err := conn.CreateIdentityProvider(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Identity Provider: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolId, providerName))
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, providerName, err := decodeCognitoIdentityProviderID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Print("[DEBUG] Reading Cognito Identity Provider")
+
+	resp, err := conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+		ProviderName: aws.String(providerName),
+		UserPoolId:   aws.String(userPoolId),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Identity Provider %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito Identity Provider: %s", err)
+	}
+
+	ip := resp.IdentityProvider
+
+	d.Set("provider_name", ip.ProviderName)
+	d.Set("provider_type", ip.ProviderType)
+	d.Set("user_pool_id", ip.UserPoolId)
+
+	attributeMapping := flattenCognitoIdentityProviderMap(d.Get("attribute_mapping").(map[string]interface{}), ip.AttributeMapping)
+	if err := d.Set("attribute_mapping", attributeMapping); err != nil {
+		return fmt.Errorf("error setting attribute_mapping: %s", err)
+	}
+
+	if err := d.Set("idp_identifiers", flattenStringList(ip.IdpIdentifiers)); err != nil {
+		return fmt.Errorf("error setting idp_identifiers: %s", err)
+	}
+
+	providerDetails := flattenCognitoIdentityProviderMap(d.Get("provider_details").(map[string]interface{}), ip.ProviderDetails)
+	if err := d.Set("provider_details", providerDetails); err != nil {
+		return fmt.Errorf("error setting provider_details: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, providerName, err := decodeCognitoIdentityProviderID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := &cognitoidentityprovider.UpdateIdentityProviderInput{
+		ProviderName: aws.String(providerName),
+		UserPoolId:   aws.String(userPoolId),
+	}
+
+	if d.HasChange("attribute_mapping") {
+		params.AttributeMapping = stringMapToPointers(d.Get("attribute_mapping").(map[string]interface{}))
+	}
+
+	if d.HasChange("idp_identifiers") {
+		params.IdpIdentifiers = expandStringList(d.Get("idp_identifiers").([]interface{}))
+	}
+
+	if d.HasChange("provider_details") {
+		params.ProviderDetails = stringMapToPointers(d.Get("provider_details").(map[string]interface{}))
+	}
+
+	log.Print("[DEBUG] Updating Cognito Identity Provider")
+
+	_, err = conn.UpdateIdentityProvider(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Identity Provider: %s", err)
+	}
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, providerName, err := decodeCognitoIdentityProviderID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Print("[DEBUG] Deleting Cognito Identity Provider")
+
+	_, err = conn.DeleteIdentityProvider(&cognitoidentityprovider.DeleteIdentityProviderInput{
+		ProviderName: aws.String(providerName),
+		UserPoolId:   aws.String(userPoolId),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Identity Provider: %s", err)
+	}
+
+	return nil
+}
+
+func decodeCognitoIdentityProviderID(id string) (string, string, error) {
+	idSplit := strings.SplitN(id, "/", 2)
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return "", "", fmt.Errorf("Expected ID in format of user_pool_id/provider_name, received: %s", id)
+	}
+	return idSplit[0], idSplit[1], nil
+}
+
+// flattenCognitoIdentityProviderMap drops keys Cognito adds on its own
+// (e.g. the generated SAML signing certificate) so they do not show up as a
+// diff against the configuration. Everything is kept when nothing is
+// configured yet, which is the case on import.
+func flattenCognitoIdentityProviderMap(configured map[string]interface{}, remote map[string]*string) map[string]string {
+	m := make(map[string]string)
+	for k, v := range remote {
+		if v == nil {
+			continue
+		}
+		if len(configured) > 0 {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		m[k] = *v
+	}
+	return m
+}
@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeCognitoIdentityProviderID(t *testing.T) {
+	cases := []struct {
+		ID           string
+		UserPoolID   string
+		ProviderName string
+		ErrCount     int
+	}{
+		{
+			ID:           "us-east-1_vG78M4goG/Google",
+			UserPoolID:   "us-east-1_vG78M4goG",
+			ProviderName: "Google",
+		},
+		{
+			ID:           "us-east-1_vG78M4goG/my/provider",
+			UserPoolID:   "us-east-1_vG78M4goG",
+			ProviderName: "my/provider",
+		},
+		{
+			ID:       "us-east-1_vG78M4goG",
+			ErrCount: 1,
+		},
+		{
+			ID:       "us-east-1_vG78M4goG/",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		userPoolID, providerName, err := decodeCognitoIdentityProviderID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if userPoolID != tc.UserPoolID || providerName != tc.ProviderName {
+			t.Fatalf("expected %q to parse as %s/%s, received: %s/%s",
+				tc.ID, tc.UserPoolID, tc.ProviderName, userPoolID, providerName)
+		}
+	}
+}
+
+func TestFlattenCognitoIdentityProviderMap(t *testing.T) {
+	remote := map[string]*string{
+		"MetadataFile":                aws.String("<xml/>"),
+		"ActiveEncryptionCertificate": aws.String("MIIC..."),
+	}
+
+	cases := []struct {
+		Configured map[string]interface{}
+		Expected   map[string]string
+	}{
+		{
+			Configured: map[string]interface{}{},
+			Expected: map[string]string{
+				"MetadataFile":                "<xml/>",
+				"ActiveEncryptionCertificate": "MIIC...",
+			},
+		},
+		{
+			Configured: map[string]interface{}{
+				"MetadataFile": "<xml/>",
+			},
+			Expected: map[string]string{
+				"MetadataFile": "<xml/>",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := flattenCognitoIdentityProviderMap(tc.Configured, remote)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %#v, received: %#v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAWSCognitoIdentityProvider_basic(t *testing.T) {
+	var identityProvider cognitoidentityprovider.IdentityProviderType
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_cognito_identity_provider.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(poolName, "email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists(resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "Google"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "Google"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.authorize_scopes", "email"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.email", "email"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(poolName, "email profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists(resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.authorize_scopes", "email profile"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provider_details"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoIdentityProvider_saml(t *testing.T) {
+	var identityProvider cognitoidentityprovider.IdentityProviderType
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_cognito_identity_provider.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_saml(poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists(resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "SAML"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.MetadataFile"),
+					resource.TestCheckResourceAttr(resourceName, "idp_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "idp_identifiers.0", "example"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoIdentityProviderExists(name string, identityProvider *cognitoidentityprovider.IdentityProviderType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito Identity Provider ID set")
+		}
+
+		userPoolId, providerName, err := decodeCognitoIdentityProviderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		resp, err := conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(providerName),
+			UserPoolId:   aws.String(userPoolId),
+		})
+		if err != nil {
+			return err
+		}
+
+		*identityProvider = *resp.IdentityProvider
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoIdentityProviderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_identity_provider" {
+			continue
+		}
+
+		userPoolId, providerName, err := decodeCognitoIdentityProviderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(providerName),
+			UserPoolId:   aws.String(userPoolId),
+		})
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Cognito Identity Provider %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoIdentityProviderConfig_basic(poolName, authorizeScopes string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name                     = "%s"
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "main" {
+  user_pool_id  = "${aws_cognito_user_pool.main.id}"
+  provider_name = "Google"
+  provider_type = "Google"
+
+  provider_details {
+    authorize_scopes = "%s"
+    client_id        = "test-url.apps.googleusercontent.com"
+    client_secret    = "client_secret"
+  }
+
+  attribute_mapping {
+    email    = "email"
+    username = "sub"
+  }
+}
+`, poolName, authorizeScopes)
+}
+
+func testAccAWSCognitoIdentityProviderConfig_saml(poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+
+resource "aws_cognito_identity_provider" "main" {
+  user_pool_id    = "${aws_cognito_user_pool.main.id}"
+  provider_name   = "example"
+  provider_type   = "SAML"
+  idp_identifiers = ["example"]
+
+  provider_details {
+    MetadataFile = "${file("./test-fixtures/saml-metadata.xml")}"
+  }
+}
+`, poolName)
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCognitoResourceServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoResourceServerCreate,
+		Read:   resourceAwsCognitoResourceServerRead,
+		Update: resourceAwsCognitoResourceServerUpdate,
+		Delete: resourceAwsCognitoResourceServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateResourceServer.html
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMaxLength(256),
+			},
+			"scope": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMaxLength(256),
+						},
+						"scope_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCognitoResourceServerScopeName,
+						},
+					},
+				},
+			},
+			"scope_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoResourceServerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	identifier := d.Get("identifier").(string)
+	userPoolId := d.Get("user_pool_id").(string)
+
+	params := &cognitoidentityprovider.CreateResourceServerInput{
+		Identifier: aws.String(identifier),
+		Name:       aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	if v, ok := d.GetOk("scope"); ok {
+		params.Scopes = expandCognitoResourceServerScopes(v.(*schema.Set).List())
+	}
+
+	log.Print("[DEBUG] Creating Cognito Resource Server")
+
+	_, err := conn.CreateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito Resource Server: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolId, identifier))
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, identifier, err := decodeCognitoResourceServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Print("[DEBUG] Reading Cognito Resource Server")
+
+	resp, err := conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+		Identifier: aws.String(identifier),
+		UserPoolId: aws.String(userPoolId),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito Resource Server %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito Resource Server: %s", err)
+	}
+
+	rs := resp.ResourceServer
+
+	d.Set("identifier", rs.Identifier)
+	d.Set("name", rs.Name)
+	d.Set("user_pool_id", rs.UserPoolId)
+
+	if err := d.Set("scope", flattenCognitoResourceServerScopes(rs.Scopes)); err != nil {
+		return fmt.Errorf("error setting scope: %s", err)
+	}
+
+	scopeIdentifiers := make([]string, 0, len(rs.Scopes))
+	for _, s := range rs.Scopes {
+		scopeIdentifiers = append(scopeIdentifiers, fmt.Sprintf("%s/%s", aws.StringValue(rs.Identifier), aws.StringValue(s.ScopeName)))
+	}
+	if err := d.Set("scope_identifiers", scopeIdentifiers); err != nil {
+		return fmt.Errorf("error setting scope_identifiers: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoResourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, identifier, err := decodeCognitoResourceServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// UpdateResourceServer replaces the whole configuration,
+	// so everything has to be sent along, not just the changes
+	params := &cognitoidentityprovider.UpdateResourceServerInput{
+		Identifier: aws.String(identifier),
+		Name:       aws.String(d.Get("name").(string)),
+		Scopes:     expandCognitoResourceServerScopes(d.Get("scope").(*schema.Set).List()),
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	log.Print("[DEBUG] Updating Cognito Resource Server")
+
+	_, err = conn.UpdateResourceServer(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito Resource Server: %s", err)
+	}
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, identifier, err := decodeCognitoResourceServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Print("[DEBUG] Deleting Cognito Resource Server")
+
+	_, err = conn.DeleteResourceServer(&cognitoidentityprovider.DeleteResourceServerInput{
+		Identifier: aws.String(identifier),
+		UserPoolId: aws.String(userPoolId),
+	})
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito Resource Server: %s", err)
+	}
+
+	return nil
+}
+
+// The identifier is commonly a URL, so only the first slash separates it
+// from the user pool ID.
+func decodeCognitoResourceServerID(id string) (string, string, error) {
+	idSplit := strings.SplitN(id, "/", 2)
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return "", "", fmt.Errorf("Expected ID in format of user_pool_id/identifier, received: %s", id)
+	}
+	return idSplit[0], idSplit[1], nil
+}
+
+func expandCognitoResourceServerScopes(inputs []interface{}) []*cognitoidentityprovider.ResourceServerScopeType {
+	configs := make([]*cognitoidentityprovider.ResourceServerScopeType, 0, len(inputs))
+
+	for _, input := range inputs {
+		data := input.(map[string]interface{})
+		configs = append(configs, &cognitoidentityprovider.ResourceServerScopeType{
+			ScopeDescription: aws.String(data["scope_description"].(string)),
+			ScopeName:        aws.String(data["scope_name"].(string)),
+		})
+	}
+
+	return configs
+}
+
+func flattenCognitoResourceServerScopes(inputs []*cognitoidentityprovider.ResourceServerScopeType) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(inputs))
+
+	for _, input := range inputs {
+		values = append(values, map[string]interface{}{
+			"scope_description": aws.StringValue(input.ScopeDescription),
+			"scope_name":        aws.StringValue(input.ScopeName),
+		})
+	}
+
+	return values
+}
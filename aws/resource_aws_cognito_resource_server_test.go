@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeCognitoResourceServerID(t *testing.T) {
+	cases := []struct {
+		ID         string
+		UserPoolID string
+		Identifier string
+		ErrCount   int
+	}{
+		{
+			ID:         "us-east-1_vG78M4goG/example",
+			UserPoolID: "us-east-1_vG78M4goG",
+			Identifier: "example",
+		},
+		{
+			ID:         "us-east-1_vG78M4goG/https://example.com/api",
+			UserPoolID: "us-east-1_vG78M4goG",
+			Identifier: "https://example.com/api",
+		},
+		{
+			ID:       "us-east-1_vG78M4goG",
+			ErrCount: 1,
+		},
+		{
+			ID:       "/https://example.com",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		userPoolID, identifier, err := decodeCognitoResourceServerID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if userPoolID != tc.UserPoolID || identifier != tc.Identifier {
+			t.Fatalf("expected %q to parse as %s/%s, received: %s/%s",
+				tc.ID, tc.UserPoolID, tc.Identifier, userPoolID, identifier)
+		}
+	}
+}
+
+func TestAccAWSCognitoResourceServer_basic(t *testing.T) {
+	var resourceServer cognitoidentityprovider.ResourceServerType
+	identifier := fmt.Sprintf("tf-acc-test-resource-server-id-%s", acctest.RandString(10))
+	name1 := fmt.Sprintf("tf-acc-test-resource-server-name-%s", acctest.RandString(10))
+	name2 := fmt.Sprintf("tf-acc-test-resource-server-name-%s", acctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-test-resource-server-pool-%s", acctest.RandString(10))
+	resourceName := "aws_cognito_resource_server.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoResourceServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoResourceServerConfig_basic(identifier, name1, poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists(resourceName, &resourceServer),
+					resource.TestCheckResourceAttr(resourceName, "identifier", identifier),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scope_identifiers.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoResourceServerConfig_basic(identifier, name2, poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists(resourceName, &resourceServer),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoResourceServer_scope(t *testing.T) {
+	var resourceServer cognitoidentityprovider.ResourceServerType
+	identifier := fmt.Sprintf("https://tf-acc-test-%s.example.com", acctest.RandString(10))
+	name := fmt.Sprintf("tf-acc-test-resource-server-name-%s", acctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-test-resource-server-pool-%s", acctest.RandString(10))
+	resourceName := "aws_cognito_resource_server.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoResourceServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoResourceServerConfig_scope(identifier, name, poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists(resourceName, &resourceServer),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scope_identifiers.#", "2"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool_client.main", "allowed_oauth_scopes.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoResourceServerConfig_scopeUpdate(identifier, name, poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists(resourceName, &resourceServer),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope_identifiers.0", fmt.Sprintf("%s/scope_1_updated", identifier)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoResourceServerExists(name string, resourceServer *cognitoidentityprovider.ResourceServerType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito Resource Server ID set")
+		}
+
+		userPoolId, identifier, err := decodeCognitoResourceServerID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		resp, err := conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+			Identifier: aws.String(identifier),
+			UserPoolId: aws.String(userPoolId),
+		})
+		if err != nil {
+			return err
+		}
+
+		*resourceServer = *resp.ResourceServer
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoResourceServerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_resource_server" {
+			continue
+		}
+
+		userPoolId, identifier, err := decodeCognitoResourceServerID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+			Identifier: aws.String(identifier),
+			UserPoolId: aws.String(userPoolId),
+		})
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Cognito Resource Server %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoResourceServerConfig_basic(identifier, name, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_resource_server" "main" {
+  identifier   = "%s"
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+`, identifier, name, poolName)
+}
+
+func testAccAWSCognitoResourceServerConfig_scope(identifier, name, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_resource_server" "main" {
+  identifier = "%s"
+  name       = "%s"
+
+  scope = {
+    scope_name        = "scope_1_name"
+    scope_description = "scope_1_description"
+  }
+
+  scope = {
+    scope_name        = "scope_2_name"
+    scope_description = "scope_2_description"
+  }
+
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+
+resource "aws_cognito_user_pool_client" "main" {
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+
+  generate_secret                      = true
+  allowed_oauth_flows                  = ["client_credentials"]
+  allowed_oauth_flows_user_pool_client = true
+  allowed_oauth_scopes                 = ["${aws_cognito_resource_server.main.scope_identifiers}"]
+}
+`, identifier, name, poolName, name)
+}
+
+func testAccAWSCognitoResourceServerConfig_scopeUpdate(identifier, name, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_resource_server" "main" {
+  identifier = "%s"
+  name       = "%s"
+
+  scope = {
+    scope_name        = "scope_1_updated"
+    scope_description = "scope_1_description"
+  }
+
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+`, identifier, name, poolName)
+}
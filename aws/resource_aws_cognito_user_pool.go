@@ -574,7 +574,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
@@ -818,7 +818,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
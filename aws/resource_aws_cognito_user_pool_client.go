@@ -109,7 +109,7 @@ func resourceAwsCognitoUserPoolClient() *schema.Resource {
 					// https://docs.aws.amazon.com/cognito/latest/developerguide/authorization-endpoint.html
 					// System reserved scopes are openid, email, phone, profile, and aws.cognito.signin.user.admin.
 					// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateUserPoolClient.html#CognitoUserPools-CreateUserPoolClient-request-AllowedOAuthScopes
-					// Constraints seem like to be designed for custom scopes which are not supported yet?
+					// Custom scopes are defined by aws_cognito_resource_server, see its scope_identifiers.
 				},
 			},
 
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Client ID Cognito reports for the customization shared by all app clients
+const cognitoUserPoolUICustomizationAllClients = "ALL"
+
+func resourceAwsCognitoUserPoolUICustomization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolUICustomizationPut,
+		Read:   resourceAwsCognitoUserPoolUICustomizationRead,
+		Update: resourceAwsCognitoUserPoolUICustomizationPut,
+		Delete: resourceAwsCognitoUserPoolUICustomizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsCognitoUserPoolUICustomizationCustomizeDiff,
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_SetUICustomization.html
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  cognitoUserPoolUICustomizationAllClients,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"css": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"css_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"image_file_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+// The image itself is not returned by the API, so changes to the file
+// are detected by comparing a hash of its contents at plan time.
+func resourceAwsCognitoUserPoolUICustomizationCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("image_file")
+	if !ok {
+		if len(diff.GetChangedKeysPrefix("image_file")) > 0 {
+			return diff.SetNewComputed("image_file_hash")
+		}
+		if diff.Get("image_file_hash").(string) != "" {
+			return diff.SetNew("image_file_hash", "")
+		}
+		return nil
+	}
+
+	hash, err := cognitoUserPoolUICustomizationImageHash(v.(string))
+	if err != nil {
+		return err
+	}
+
+	if diff.Get("image_file_hash").(string) != hash {
+		return diff.SetNew("image_file_hash", hash)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUICustomizationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId := d.Get("user_pool_id").(string)
+	clientId := d.Get("client_id").(string)
+
+	css, hasCss := d.GetOk("css")
+	imageFile, hasImageFile := d.GetOk("image_file")
+	if !hasCss && !hasImageFile {
+		return errors.New("at least one of css or image_file must be set")
+	}
+
+	params := &cognitoidentityprovider.SetUICustomizationInput{
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	if clientId != cognitoUserPoolUICustomizationAllClients {
+		params.ClientId = aws.String(clientId)
+	}
+
+	if hasCss {
+		params.CSS = aws.String(css.(string))
+	}
+
+	if hasImageFile {
+		image, err := loadFileContent(imageFile.(string))
+		if err != nil {
+			return fmt.Errorf("Unable to load %q: %s", imageFile.(string), err)
+		}
+		params.ImageFile = image
+	}
+
+	log.Print("[DEBUG] Setting Cognito User Pool UI Customization")
+
+	_, err := conn.SetUICustomization(params)
+	if err != nil {
+		return fmt.Errorf("Error setting Cognito User Pool UI Customization: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolId, clientId))
+
+	return resourceAwsCognitoUserPoolUICustomizationRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, clientId, err := decodeCognitoUserPoolUICustomizationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := &cognitoidentityprovider.GetUICustomizationInput{
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	if clientId != cognitoUserPoolUICustomizationAllClients {
+		params.ClientId = aws.String(clientId)
+	}
+
+	log.Print("[DEBUG] Reading Cognito User Pool UI Customization")
+
+	resp, err := conn.GetUICustomization(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito User Pool UI Customization %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito User Pool UI Customization: %s", err)
+	}
+
+	ui := resp.UICustomization
+	if ui == nil || (aws.StringValue(ui.CSS) == "" && aws.StringValue(ui.ImageUrl) == "") {
+		log.Printf("[WARN] Cognito User Pool UI Customization %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_id", clientId)
+	d.Set("css", ui.CSS)
+	d.Set("css_version", ui.CSSVersion)
+	d.Set("image_url", ui.ImageUrl)
+	d.Set("user_pool_id", userPoolId)
+
+	if ui.CreationDate != nil {
+		d.Set("creation_date", ui.CreationDate.Format(time.RFC3339))
+	}
+
+	if ui.LastModifiedDate != nil {
+		d.Set("last_modified_date", ui.LastModifiedDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUICustomizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolId, clientId, err := decodeCognitoUserPoolUICustomizationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// There is no delete call, setting neither CSS nor an image resets
+	// the hosted UI to the defaults
+	params := &cognitoidentityprovider.SetUICustomizationInput{
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	if clientId != cognitoUserPoolUICustomizationAllClients {
+		params.ClientId = aws.String(clientId)
+	}
+
+	log.Print("[DEBUG] Deleting Cognito User Pool UI Customization")
+
+	_, err = conn.SetUICustomization(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito User Pool UI Customization: %s", err)
+	}
+
+	return nil
+}
+
+func decodeCognitoUserPoolUICustomizationID(id string) (string, string, error) {
+	idSplit := strings.Split(id, "/")
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return "", "", fmt.Errorf("Expected ID in format of user_pool_id/client_id, received: %s", id)
+	}
+	return idSplit[0], idSplit[1], nil
+}
+
+func cognitoUserPoolUICustomizationImageHash(filename string) (string, error) {
+	image, err := loadFileContent(filename)
+	if err != nil {
+		return "", fmt.Errorf("Unable to load %q: %s", filename, err)
+	}
+	hash := sha256.Sum256(image)
+	return base64.StdEncoding.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeCognitoUserPoolUICustomizationID(t *testing.T) {
+	cases := []struct {
+		ID         string
+		UserPoolID string
+		ClientID   string
+		ErrCount   int
+	}{
+		{
+			ID:         "us-east-1_vG78M4goG/ALL",
+			UserPoolID: "us-east-1_vG78M4goG",
+			ClientID:   "ALL",
+		},
+		{
+			ID:         "us-east-1_vG78M4goG/6omj4gqgpnb8no7ltmt7gg5qp2",
+			UserPoolID: "us-east-1_vG78M4goG",
+			ClientID:   "6omj4gqgpnb8no7ltmt7gg5qp2",
+		},
+		{
+			ID:       "us-east-1_vG78M4goG",
+			ErrCount: 1,
+		},
+		{
+			ID:       "us-east-1_vG78M4goG/",
+			ErrCount: 1,
+		},
+		{
+			ID:       "us-east-1_vG78M4goG/ALL/extra",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		userPoolID, clientID, err := decodeCognitoUserPoolUICustomizationID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if userPoolID != tc.UserPoolID || clientID != tc.ClientID {
+			t.Fatalf("expected %q to parse as %s/%s, received: %s/%s",
+				tc.ID, tc.UserPoolID, tc.ClientID, userPoolID, clientID)
+		}
+	}
+}
+
+func TestCognitoUserPoolUICustomizationImageHash(t *testing.T) {
+	hash1, err := cognitoUserPoolUICustomizationImageHash("test-fixtures/cognito-ui-logo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash2, err := cognitoUserPoolUICustomizationImageHash("test-fixtures/cognito-ui-logo-modified.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash1 == hash2 {
+		t.Fatalf("expected different images to have different hashes, both were %s", hash1)
+	}
+
+	if _, err := cognitoUserPoolUICustomizationImageHash("test-fixtures/does-not-exist.png"); err == nil {
+		t.Fatal("expected a missing file to trigger an error")
+	}
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_basic(t *testing.T) {
+	var ui cognitoidentityprovider.UICustomizationType
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_cognito_user_pool_ui_customization.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_css(rName, ".label-customizable {font-weight: 400;}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName, &ui),
+					resource.TestCheckResourceAttr(resourceName, "client_id", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 400;}"),
+					resource.TestCheckResourceAttrSet(resourceName, "css_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_css(rName, ".label-customizable {font-weight: 100;}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName, &ui),
+					resource.TestCheckResourceAttr(resourceName, "css", ".label-customizable {font-weight: 100;}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_imageFile(t *testing.T) {
+	var ui cognitoidentityprovider.UICustomizationType
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "aws_cognito_user_pool_ui_customization.main"
+
+	hash1, err := cognitoUserPoolUICustomizationImageHash("test-fixtures/cognito-ui-logo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash2, err := cognitoUserPoolUICustomizationImageHash("test-fixtures/cognito-ui-logo-modified.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_imageFile(rName, "test-fixtures/cognito-ui-logo.png"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName, &ui),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "aws_cognito_user_pool_client.main", "id"),
+					resource.TestCheckResourceAttr(resourceName, "image_file_hash", hash1),
+					resource.TestCheckResourceAttrSet(resourceName, "image_url"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfig_imageFile(rName, "test-fixtures/cognito-ui-logo-modified.png"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName, &ui),
+					resource.TestCheckResourceAttr(resourceName, "image_file_hash", hash2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_file", "image_file_hash"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationExists(name string, ui *cognitoidentityprovider.UICustomizationType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito User Pool UI Customization ID set")
+		}
+
+		userPoolId, clientId, err := decodeCognitoUserPoolUICustomizationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		params := &cognitoidentityprovider.GetUICustomizationInput{
+			UserPoolId: aws.String(userPoolId),
+		}
+		if clientId != cognitoUserPoolUICustomizationAllClients {
+			params.ClientId = aws.String(clientId)
+		}
+
+		resp, err := conn.GetUICustomization(params)
+		if err != nil {
+			return err
+		}
+
+		*ui = *resp.UICustomization
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool_ui_customization" {
+			continue
+		}
+
+		userPoolId, clientId, err := decodeCognitoUserPoolUICustomizationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		params := &cognitoidentityprovider.GetUICustomizationInput{
+			UserPoolId: aws.String(userPoolId),
+		}
+		if clientId != cognitoUserPoolUICustomizationAllClients {
+			params.ClientId = aws.String(clientId)
+		}
+
+		resp, err := conn.GetUICustomization(params)
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		ui := resp.UICustomization
+		if ui != nil && (aws.StringValue(ui.CSS) != "" || aws.StringValue(ui.ImageUrl) != "") {
+			return fmt.Errorf("Cognito User Pool UI Customization %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfig_css(rName, css string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+}
+
+resource "aws_cognito_user_pool_domain" "main" {
+  domain       = "%[1]s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool_ui_customization" "main" {
+  css          = "%[2]s"
+  user_pool_id = "${aws_cognito_user_pool_domain.main.user_pool_id}"
+}
+`, rName, css)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfig_imageFile(rName, imageFile string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+}
+
+resource "aws_cognito_user_pool_domain" "main" {
+  domain       = "%[1]s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool_client" "main" {
+  name         = "%[1]s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool_ui_customization" "main" {
+  client_id    = "${aws_cognito_user_pool_client.main.id}"
+  image_file   = "%[2]s"
+  user_pool_id = "${aws_cognito_user_pool_domain.main.user_pool_id}"
+}
+`, rName, imageFile)
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDBClusterSnapshotCopy_basic(t *testing.T) {
+	var v rds.DBClusterSnapshot
+	rInt := acctest.RandInt()
+	resourceName := "aws_db_cluster_snapshot_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbClusterSnapshotCopyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbClusterSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_db_cluster_snapshot_identifier", fmt.Sprintf("tf-acc-test-%d-copy", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_snapshot_arn", "aws_db_cluster_snapshot.test", "db_cluster_snapshot_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_tags", "source_db_cluster_snapshot_identifier"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDBClusterSnapshotCopy_kmsKeyId(t *testing.T) {
+	var v rds.DBClusterSnapshot
+	rInt := acctest.RandInt()
+	resourceName := "aws_db_cluster_snapshot_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbClusterSnapshotCopyConfigKmsKeyId(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbClusterSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsDbClusterSnapshotCopyConfig(rInt int) string {
+	return testAccAwsDbClusterSnapshotConfig(rInt) + fmt.Sprintf(`
+resource "aws_db_cluster_snapshot_copy" "test" {
+  source_db_cluster_snapshot_identifier = "${aws_db_cluster_snapshot.test.db_cluster_snapshot_arn}"
+  target_db_cluster_snapshot_identifier = "tf-acc-test-%d-copy"
+}
+`, rInt)
+}
+
+func testAccAwsDbClusterSnapshotCopyConfigKmsKeyId(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "tf-acc-test-%[1]d"
+  deletion_window_in_days = 7
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = "tf-acc-test-%[1]d"
+  database_name       = "mydb"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  storage_encrypted   = true
+  skip_final_snapshot = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = "${aws_rds_cluster.test.id}"
+  db_cluster_snapshot_identifier = "tf-acc-test-%[1]d"
+}
+
+resource "aws_db_cluster_snapshot_copy" "test" {
+  source_db_cluster_snapshot_identifier = "${aws_db_cluster_snapshot.test.db_cluster_snapshot_arn}"
+  target_db_cluster_snapshot_identifier = "tf-acc-test-%[1]d-copy"
+  kms_key_id                            = "${aws_kms_key.test.arn}"
+}
+`, rInt)
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsDbInstance() *schema.Resource {
@@ -290,6 +291,83 @@ func resourceAwsDbInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"restore_to_point_in_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"s3_import",
+					"snapshot_identifier",
+					"replicate_source_db",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_db_instance_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"restore_time": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validateRFC3339TimeString,
+							ConflictsWith: []string{"restore_to_point_in_time.0.use_latest_restorable_time"},
+						},
+						"use_latest_restorable_time": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_to_point_in_time.0.restore_time"},
+						},
+					},
+				},
+			},
+
+			"s3_import": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"restore_to_point_in_time",
+					"snapshot_identifier",
+					"replicate_source_db",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ingestion_role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"source_engine": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"mysql"}, false),
+						},
+						"source_engine_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"auto_minor_version_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -357,7 +435,7 @@ func resourceAwsDbInstance() *schema.Resource {
 
 func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -500,50 +578,238 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("Error creating DB Instance: %s", err)
 		}
 
-		var sgUpdate bool
-		var passwordUpdate bool
+		if err := resourceAwsDbInstanceUpdateRestored(d, meta); err != nil {
+			return err
+		}
+	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
+		if input := v.([]interface{}); len(input) > 0 && input[0] != nil {
+			restore := input[0].(map[string]interface{})
+
+			opts := rds.RestoreDBInstanceToPointInTimeInput{
+				SourceDBInstanceIdentifier: aws.String(restore["source_db_instance_identifier"].(string)),
+				TargetDBInstanceIdentifier: aws.String(d.Get("identifier").(string)),
+				DBInstanceClass:            aws.String(d.Get("instance_class").(string)),
+				AutoMinorVersionUpgrade:    aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+				PubliclyAccessible:         aws.Bool(d.Get("publicly_accessible").(bool)),
+				Tags:                       tags,
+				CopyTagsToSnapshot:         aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
+			}
+
+			if v, ok := restore["restore_time"].(string); ok && v != "" {
+				restoreTime, err := time.Parse(time.RFC3339, v)
+				if err != nil {
+					return err
+				}
+				opts.RestoreTime = aws.Time(restoreTime)
+			}
+
+			if v, ok := restore["use_latest_restorable_time"].(bool); ok && v {
+				opts.UseLatestRestorableTime = aws.Bool(v)
+			}
+
+			if opts.RestoreTime == nil && opts.UseLatestRestorableTime == nil {
+				return fmt.Errorf(`provider.aws: aws_db_instance: %s: one of "restore_to_point_in_time.0.restore_time" or "restore_to_point_in_time.0.use_latest_restorable_time" must be set`, d.Get("identifier").(string))
+			}
+
+			if attr, ok := d.GetOk("name"); ok {
+				// "This parameter is not used for the MySQL or MariaDB engines."
+				// https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_RestoreDBInstanceToPointInTime.html
+				switch strings.ToLower(d.Get("engine").(string)) {
+				case "mysql", "mariadb":
+					// skip
+				default:
+					opts.DBName = aws.String(attr.(string))
+				}
+			}
+
+			if attr, ok := d.GetOk("availability_zone"); ok {
+				opts.AvailabilityZone = aws.String(attr.(string))
+			}
+
+			if attr, ok := d.GetOk("db_subnet_group_name"); ok {
+				opts.DBSubnetGroupName = aws.String(attr.(string))
+			}
+
+			if attr, ok := d.GetOk("engine"); ok {
+				opts.Engine = aws.String(attr.(string))
+			}
+
+			if attr, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+				opts.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
+			}
+
+			if attr, ok := d.GetOk("iops"); ok {
+				opts.Iops = aws.Int64(int64(attr.(int)))
+			}
+
+			if attr, ok := d.GetOk("license_model"); ok {
+				opts.LicenseModel = aws.String(attr.(string))
+			}
+
+			if attr, ok := d.GetOk("multi_az"); ok {
+				opts.MultiAZ = aws.Bool(attr.(bool))
+			}
+
+			if attr, ok := d.GetOk("option_group_name"); ok {
+				opts.OptionGroupName = aws.String(attr.(string))
+			}
+
+			if attr, ok := d.GetOk("port"); ok {
+				opts.Port = aws.Int64(int64(attr.(int)))
+			}
+
+			if attr, ok := d.GetOk("tde_credential_arn"); ok {
+				opts.TdeCredentialArn = aws.String(attr.(string))
+			}
+
+			if attr, ok := d.GetOk("storage_type"); ok {
+				opts.StorageType = aws.String(attr.(string))
+			}
+
+			log.Printf("[DEBUG] DB Instance restore to point in time configuration: %s", opts)
+			_, err := conn.RestoreDBInstanceToPointInTime(&opts)
+			if err != nil {
+				return fmt.Errorf("Error creating DB Instance: %s", err)
+			}
+
+			if err := resourceAwsDbInstanceUpdateRestored(d, meta); err != nil {
+				return err
+			}
+		}
+	} else if v, ok := d.GetOk("s3_import"); ok {
+		if _, ok := d.GetOk("allocated_storage"); !ok {
+			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string))
+		}
+		if _, ok := d.GetOk("engine"); !ok {
+			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string))
+		}
+		if _, ok := d.GetOk("password"); !ok {
+			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string))
+		}
+		if _, ok := d.GetOk("username"); !ok {
+			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string))
+		}
+
+		s3Bucket := v.([]interface{})[0].(map[string]interface{})
+		opts := rds.RestoreDBInstanceFromS3Input{
+			AllocatedStorage:        aws.Int64(int64(d.Get("allocated_storage").(int))),
+			AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+			CopyTagsToSnapshot:      aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
+			DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
+			DBInstanceIdentifier:    aws.String(d.Get("identifier").(string)),
+			Engine:                  aws.String(d.Get("engine").(string)),
+			EngineVersion:           aws.String(d.Get("engine_version").(string)),
+			S3BucketName:            aws.String(s3Bucket["bucket_name"].(string)),
+			S3IngestionRoleArn:      aws.String(s3Bucket["ingestion_role"].(string)),
+			MasterUsername:          aws.String(d.Get("username").(string)),
+			MasterUserPassword:      aws.String(d.Get("password").(string)),
+			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
+			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
+			SourceEngine:            aws.String(s3Bucket["source_engine"].(string)),
+			SourceEngineVersion:     aws.String(s3Bucket["source_engine_version"].(string)),
+			Tags:                    tags,
+		}
+
+		if v, ok := s3Bucket["bucket_prefix"].(string); ok && v != "" {
+			opts.S3Prefix = aws.String(v)
+		}
+
+		if attr, ok := d.GetOk("name"); ok {
+			opts.DBName = aws.String(attr.(string))
+		}
+
+		attr := d.Get("backup_retention_period")
+		opts.BackupRetentionPeriod = aws.Int64(int64(attr.(int)))
+
+		if attr, ok := d.GetOk("multi_az"); ok {
+			opts.MultiAZ = aws.Bool(attr.(bool))
+		}
 
-		if _, ok := d.GetOk("password"); ok {
-			passwordUpdate = true
+		if attr, ok := d.GetOk("maintenance_window"); ok {
+			opts.PreferredMaintenanceWindow = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("backup_window"); ok {
+			opts.PreferredBackupWindow = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("license_model"); ok {
+			opts.LicenseModel = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("parameter_group_name"); ok {
+			opts.DBParameterGroupName = aws.String(attr.(string))
 		}
 
 		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
-			sgUpdate = true
+			opts.VpcSecurityGroupIds = expandStringList(attr.List())
 		}
+
 		if attr := d.Get("security_group_names").(*schema.Set); attr.Len() > 0 {
-			sgUpdate = true
+			opts.DBSecurityGroups = expandStringList(attr.List())
 		}
-		if sgUpdate || passwordUpdate {
-			log.Printf("[INFO] DB is restoring from snapshot with default security, but custom security should be set, will now update after snapshot is restored!")
 
-			// wait for instance to get up and then modify security
-			d.SetId(d.Get("identifier").(string))
+		if attr, ok := d.GetOk("storage_type"); ok {
+			opts.StorageType = aws.String(attr.(string))
+		}
 
-			log.Printf("[INFO] DB Instance ID: %s", d.Id())
+		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
+			opts.DBSubnetGroupName = aws.String(attr.(string))
+		}
 
-			log.Println(
-				"[INFO] Waiting for DB Instance to be available")
+		if attr, ok := d.GetOk("iops"); ok {
+			opts.Iops = aws.Int64(int64(attr.(int)))
+		}
 
-			stateConf := &resource.StateChangeConf{
-				Pending:    resourceAwsDbInstanceCreatePendingStates,
-				Target:     []string{"available", "storage-optimization"},
-				Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-				Timeout:    d.Timeout(schema.TimeoutCreate),
-				MinTimeout: 10 * time.Second,
-				Delay:      30 * time.Second, // Wait 30 secs before starting
-			}
+		if attr, ok := d.GetOk("port"); ok {
+			opts.Port = aws.Int64(int64(attr.(int)))
+		}
 
-			// Wait, catching any errors
-			_, err := stateConf.WaitForState()
-			if err != nil {
-				return err
-			}
+		if attr, ok := d.GetOk("availability_zone"); ok {
+			opts.AvailabilityZone = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("monitoring_role_arn"); ok {
+			opts.MonitoringRoleArn = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("monitoring_interval"); ok {
+			opts.MonitoringInterval = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOk("option_group_name"); ok {
+			opts.OptionGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("kms_key_id"); ok {
+			opts.KmsKeyId = aws.String(attr.(string))
+		}
 
-			err = resourceAwsDbInstanceUpdate(d, meta)
+		if attr, ok := d.GetOk("iam_database_authentication_enabled"); ok {
+			opts.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
+		}
+
+		log.Printf("[DEBUG] DB Instance S3 Restore configuration: %#v", opts)
+		var err error
+		// IAM roles and the bucket policy may take some time to propagate
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err = conn.RestoreDBInstanceFromS3(&opts)
 			if err != nil {
-				return err
+				if isAWSErr(err, "InvalidParameterValue", "ENHANCED_MONITORING") {
+					return resource.RetryableError(err)
+				}
+				if isAWSErr(err, "InvalidParameterValue", "S3_SNAPSHOT_INGESTION") {
+					return resource.RetryableError(err)
+				}
+				if isAWSErr(err, "InvalidParameterValue", "S3 bucket cannot be found") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
 			}
-
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating DB Instance: %s", err)
 		}
 	} else {
 		if _, ok := d.GetOk("allocated_storage"); !ok {
@@ -703,6 +969,57 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	return resourceAwsDbInstanceRead(d, meta)
 }
 
+// resourceAwsDbInstanceUpdateRestored applies the security groups and
+// password of the configuration to an instance restored from a snapshot or
+// a point in time, as the restore itself always uses the defaults.
+func resourceAwsDbInstanceUpdateRestored(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	var sgUpdate bool
+	var passwordUpdate bool
+
+	if _, ok := d.GetOk("password"); ok {
+		passwordUpdate = true
+	}
+
+	if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
+		sgUpdate = true
+	}
+	if attr := d.Get("security_group_names").(*schema.Set); attr.Len() > 0 {
+		sgUpdate = true
+	}
+	if !sgUpdate && !passwordUpdate {
+		return nil
+	}
+
+	log.Printf("[INFO] DB is restoring with default security, but custom security should be set, will now update after the DB is restored!")
+
+	// wait for instance to get up and then modify security
+	d.SetId(d.Get("identifier").(string))
+
+	log.Printf("[INFO] DB Instance ID: %s", d.Id())
+
+	log.Println(
+		"[INFO] Waiting for DB Instance to be available")
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceAwsDbInstanceCreatePendingStates,
+		Target:     []string{"available", "storage-optimization"},
+		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second, // Wait 30 secs before starting
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsDbInstanceUpdate(d, meta)
+}
+
 func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	v, err := resourceAwsDbInstanceRetrieve(d.Id(), meta.(*AWSClient).rdsconn)
 
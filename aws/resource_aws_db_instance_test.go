@@ -313,6 +313,59 @@ func TestAccAWSDBInstance_snapshot(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_restoreToPointInTime(t *testing.T) {
+	var source, restored rds.DBInstance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfigRestoreToPointInTime(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.source", &source),
+					testAccCheckAWSDBInstanceExists("aws_db_instance.restored", &restored),
+					resource.TestCheckResourceAttr("aws_db_instance.restored", "identifier", fmt.Sprintf("tf-test-restored-%d", rInt)),
+					resource.TestCheckResourceAttr("aws_db_instance.restored", "engine", "mysql"),
+					resource.TestCheckResourceAttr("aws_db_instance.restored", "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttr("aws_db_instance.restored", "vpc_security_group_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBInstance_s3Import(t *testing.T) {
+	// The bucket must contain a Percona XtraBackup of a MySQL 5.6 database
+	bucket := os.Getenv("RDS_S3_IMPORT_BUCKET")
+	if bucket == "" {
+		t.Skip("Environment variable RDS_S3_IMPORT_BUCKET is not set")
+	}
+	prefix := os.Getenv("RDS_S3_IMPORT_BUCKET_PREFIX")
+
+	var v rds.DBInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfigS3Import(rName, bucket, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.s3", &v),
+					resource.TestCheckResourceAttr("aws_db_instance.s3", "identifier", rName),
+					resource.TestCheckResourceAttr("aws_db_instance.s3", "engine", "mysql"),
+					resource.TestCheckResourceAttr("aws_db_instance.s3", "s3_import.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstance_enhancedMonitoring(t *testing.T) {
 	var dbInstance rds.DBInstance
 	rName := acctest.RandString(5)
@@ -986,6 +1039,121 @@ resource "aws_db_instance" "snapshot" {
 `, rInt, rInt)
 }
 
+func testAccAWSDBInstanceConfigRestoreToPointInTime(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "source" {
+	identifier = "tf-test-source-%[1]d"
+
+	allocated_storage = 5
+	engine = "mysql"
+	engine_version = "5.6.35"
+	instance_class = "db.t2.micro"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+	backup_retention_period = 1
+
+	parameter_group_name = "default.mysql5.6"
+
+	skip_final_snapshot = true
+}
+
+resource "aws_security_group" "restored" {
+	name = "tf-test-restored-%[1]d"
+}
+
+resource "aws_db_instance" "restored" {
+	identifier = "tf-test-restored-%[1]d"
+	instance_class = "${aws_db_instance.source.instance_class}"
+	vpc_security_group_ids = ["${aws_security_group.restored.id}"]
+
+	restore_to_point_in_time {
+		source_db_instance_identifier = "${aws_db_instance.source.identifier}"
+		use_latest_restorable_time = true
+	}
+
+	skip_final_snapshot = true
+}
+`, rInt)
+}
+
+func testAccAWSDBInstanceConfigS3Import(rName, bucket, prefix string) string {
+	return fmt.Sprintf(`
+data "aws_s3_bucket" "xtrabackup" {
+	bucket = %[2]q
+}
+
+resource "aws_iam_role" "rds_s3_access_role" {
+	name = %[1]q
+
+	assume_role_policy = <<EOP
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Effect": "Allow",
+			"Principal": {
+				"Service": "rds.amazonaws.com"
+			},
+			"Action": "sts:AssumeRole"
+		}
+	]
+}
+EOP
+}
+
+resource "aws_iam_role_policy" "rds_s3_access" {
+	name = %[1]q
+	role = "${aws_iam_role.rds_s3_access_role.id}"
+
+	policy = <<EOP
+{
+	"Version": "2012-10-17",
+	"Statement": [
+		{
+			"Effect": "Allow",
+			"Action": [
+				"s3:GetBucketLocation",
+				"s3:ListBucket",
+				"s3:GetObject"
+			],
+			"Resource": [
+				"${data.aws_s3_bucket.xtrabackup.arn}",
+				"${data.aws_s3_bucket.xtrabackup.arn}/*"
+			]
+		}
+	]
+}
+EOP
+}
+
+resource "aws_db_instance" "s3" {
+	identifier = %[1]q
+
+	allocated_storage = 5
+	engine = "mysql"
+	engine_version = "5.6"
+	instance_class = "db.t2.small"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+
+	backup_retention_period = 0
+	skip_final_snapshot = true
+
+	s3_import {
+		bucket_name = "${data.aws_s3_bucket.xtrabackup.id}"
+		bucket_prefix = %[3]q
+		ingestion_role = "${aws_iam_role.rds_s3_access_role.arn}"
+		source_engine = "mysql"
+		source_engine_version = "5.6"
+	}
+
+	depends_on = ["aws_iam_role_policy.rds_s3_access"]
+}
+`, rName, bucket, prefix)
+}
+
 func testAccSnapshotInstanceConfig_enhancedMonitoring(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "enhanced_policy_role" {
@@ -17,6 +17,9 @@ func resourceAwsDbSnapshot() *schema.Resource {
 		Create: resourceAwsDbSnapshotCreate,
 		Read:   resourceAwsDbSnapshotRead,
 		Delete: resourceAwsDbSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(20 * time.Minute),
@@ -163,6 +166,8 @@ func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("option_group_name", snapshot.OptionGroupName)
 	d.Set("port", snapshot.Port)
 	d.Set("source_db_snapshot_identifier", snapshot.SourceDBSnapshotIdentifier)
+	d.Set("db_snapshot_identifier", snapshot.DBSnapshotIdentifier)
+	d.Set("db_instance_identifier", snapshot.DBInstanceIdentifier)
 	d.Set("source_region", snapshot.SourceRegion)
 	d.Set("snapshot_type", snapshot.SnapshotType)
 	d.Set("status", snapshot.Status)
@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDbSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDbSnapshotCopyCreate,
+		Read:   resourceAwsDbSnapshotCopyRead,
+		Delete: resourceAwsDbSnapshotCopyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_db_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_db_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"option_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"allocated_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_instance_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDbSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	params := &rds.CopyDBSnapshotInput{
+		SourceDBSnapshotIdentifier: aws.String(d.Get("source_db_snapshot_identifier").(string)),
+		TargetDBSnapshotIdentifier: aws.String(d.Get("target_db_snapshot_identifier").(string)),
+		CopyTags:                   aws.Bool(d.Get("copy_tags").(bool)),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		params.KmsKeyId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("option_group_name"); ok {
+		params.OptionGroupName = aws.String(v.(string))
+	}
+
+	// The SDK presigns the request against the source region when
+	// SourceRegion is set, which cross-region copies of encrypted
+	// snapshots require.
+	if v, ok := d.GetOk("source_region"); ok {
+		params.SourceRegion = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying DB Snapshot: %s", params)
+	_, err := conn.CopyDBSnapshot(params)
+	if err != nil {
+		return fmt.Errorf("Error copying DB Snapshot: %s", err)
+	}
+	d.SetId(d.Get("target_db_snapshot_identifier").(string))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "creating", "copying"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsDbSnapshotStateRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DB Snapshot (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDbSnapshotCopyRead(d, meta)
+}
+
+func resourceAwsDbSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	resp, err := conn.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
+			log.Printf("[WARN] DB Snapshot (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DB Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.DBSnapshots) != 1 || aws.StringValue(resp.DBSnapshots[0].DBSnapshotIdentifier) != d.Id() {
+		log.Printf("[WARN] DB Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	snapshot := resp.DBSnapshots[0]
+
+	d.Set("target_db_snapshot_identifier", snapshot.DBSnapshotIdentifier)
+	d.Set("allocated_storage", snapshot.AllocatedStorage)
+	d.Set("availability_zone", snapshot.AvailabilityZone)
+	d.Set("db_instance_identifier", snapshot.DBInstanceIdentifier)
+	d.Set("db_snapshot_arn", snapshot.DBSnapshotArn)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("engine", snapshot.Engine)
+	d.Set("engine_version", snapshot.EngineVersion)
+	d.Set("iops", snapshot.Iops)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("license_model", snapshot.LicenseModel)
+	d.Set("option_group_name", snapshot.OptionGroupName)
+	d.Set("port", snapshot.Port)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("status", snapshot.Status)
+	d.Set("storage_type", snapshot.StorageType)
+	d.Set("vpc_id", snapshot.VpcId)
+	if snapshot.SourceRegion != nil {
+		d.Set("source_region", snapshot.SourceRegion)
+	}
+
+	return nil
+}
+
+func resourceAwsDbSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	log.Printf("[DEBUG] Deleting DB Snapshot: %s", d.Id())
+	_, err := conn.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DB Snapshot (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDBSnapshotCopy_basic(t *testing.T) {
+	var v rds.DBSnapshot
+	rInt := acctest.RandInt()
+	resourceName := "aws_db_snapshot_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbSnapshotCopyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbSnapshotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_db_snapshot_identifier", fmt.Sprintf("testsnapshot%d-copy", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_type", "manual"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_tags", "source_db_snapshot_identifier"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDBSnapshotCopy_crossRegionEncrypted(t *testing.T) {
+	var v rds.DBSnapshot
+	rInt := acctest.RandInt()
+	resourceName := "aws_db_snapshot_copy.test"
+
+	// record the initialized providers so that we can use them to
+	// check for the snapshots in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckDbSnapshotCopyDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDbSnapshotCopyConfigCrossRegionEncrypted(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbSnapshotCopyExistsWithProvider(resourceName, &v, testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "source_region", "us-east-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.destination", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDbSnapshotCopyDestroy(s *terraform.State) error {
+	return testAccCheckDbSnapshotCopyDestroyWithProvider(s, testAccProvider)
+}
+
+func testAccCheckDbSnapshotCopyDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).rdsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_snapshot_copy" {
+			continue
+		}
+
+		resp, err := conn.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+			DBSnapshotIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.DBSnapshots) > 0 {
+			return fmt.Errorf("DB Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDbSnapshotCopyExistsWithProvider(n string, v *rds.DBSnapshot, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		provider := providerF()
+		conn := provider.Meta().(*AWSClient).rdsconn
+
+		resp, err := conn.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+			DBSnapshotIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.DBSnapshots) == 0 {
+			return fmt.Errorf("DB Snapshot %s not found", rs.Primary.ID)
+		}
+
+		*v = *resp.DBSnapshots[0]
+		return nil
+	}
+}
+
+func testAccAwsDbSnapshotCopyConfig(rInt int) string {
+	return testAccAwsDbSnapshotConfig(rInt) + fmt.Sprintf(`
+
+resource "aws_db_snapshot_copy" "test" {
+  source_db_snapshot_identifier = "${aws_db_snapshot.test.db_snapshot_arn}"
+  target_db_snapshot_identifier = "testsnapshot%d-copy"
+  copy_tags                     = true
+}
+`, rInt)
+}
+
+func testAccAwsDbSnapshotCopyConfigCrossRegionEncrypted(rInt int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias  = "useast1"
+  region = "us-east-1"
+}
+
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_kms_key" "source" {
+  provider                = "aws.useast1"
+  description             = "tf-acc-test-%[1]d-source"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "destination" {
+  description             = "tf-acc-test-%[1]d-destination"
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "test" {
+  provider                = "aws.useast1"
+  allocated_storage       = 10
+  engine                  = "mysql"
+  engine_version          = "5.6.35"
+  instance_class          = "db.t2.small"
+  name                    = "baz"
+  password                = "barbarbarbar"
+  username                = "foo"
+  backup_retention_period = 0
+  storage_encrypted       = true
+  kms_key_id              = "${aws_kms_key.source.arn}"
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_snapshot" "test" {
+  provider               = "aws.useast1"
+  db_instance_identifier = "${aws_db_instance.test.id}"
+  db_snapshot_identifier = "testsnapshot%[1]d"
+}
+
+resource "aws_db_snapshot_copy" "test" {
+  source_db_snapshot_identifier = "${aws_db_snapshot.test.db_snapshot_arn}"
+  target_db_snapshot_identifier = "testsnapshot%[1]d-copy"
+  source_region                 = "us-east-1"
+  kms_key_id                    = "${aws_kms_key.destination.arn}"
+}
+`, rInt)
+}
@@ -25,6 +25,9 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 		Read:   resourceAwsNetworkAclRead,
 		Delete: resourceAwsDefaultNetworkAclDelete,
 		Update: resourceAwsDefaultNetworkAclUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDefaultNetworkAclImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -150,6 +153,12 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 	}
 }
 
+func resourceAwsDefaultNetworkAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("default_network_acl_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("default_network_acl_id").(string))
 
@@ -15,6 +15,9 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 		Read:   resourceAwsDefaultRouteTableRead,
 		Update: resourceAwsRouteTableUpdate,
 		Delete: resourceAwsDefaultRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDefaultRouteTableImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
@@ -90,6 +93,24 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 	}
 }
 
+func resourceAwsDefaultRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		RouteTableIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RouteTables) < 1 || resp.RouteTables[0] == nil {
+		return nil, fmt.Errorf("Default Route table %s not found", d.Id())
+	}
+
+	d.Set("vpc_id", resp.RouteTables[0].VpcId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("default_route_table_id").(string))
 
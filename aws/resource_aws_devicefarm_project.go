@@ -15,6 +15,9 @@ func resourceAwsDevicefarmProject() *schema.Resource {
 		Read:   resourceAwsDevicefarmProjectRead,
 		Update: resourceAwsDevicefarmProjectUpdate,
 		Delete: resourceAwsDevicefarmProjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
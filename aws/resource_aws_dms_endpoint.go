@@ -128,7 +128,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
 		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
 		EngineName:         aws.String(d.Get("engine_name").(string)),
-		Tags:               dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:               tagsFromMapDMS(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	// if dynamodb then add required params
@@ -215,7 +215,7 @@ func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	d.Set("tags", tagsToMapDMS(tagsResp.TagList))
 
 	return nil
 }
@@ -285,8 +285,8 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
-		err := dmsSetTags(d.Get("endpoint_arn").(string), d, meta)
+	if d.HasChange("tags_all") {
+		err := setTagsDMS(conn, d, d.Get("endpoint_arn").(string))
 		if err != nil {
 			return err
 		}
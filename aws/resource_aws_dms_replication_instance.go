@@ -136,7 +136,7 @@ func resourceAwsDmsReplicationInstanceCreate(d *schema.ResourceData, meta interf
 		PubliclyAccessible:            aws.Bool(d.Get("publicly_accessible").(bool)),
 		ReplicationInstanceClass:      aws.String(d.Get("replication_instance_class").(string)),
 		ReplicationInstanceIdentifier: aws.String(d.Get("replication_instance_id").(string)),
-		Tags: dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags: tagsFromMapDMS(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	// WARNING: GetOk returns the zero value for the type if the key is omitted in config. This means for optional
@@ -233,12 +233,14 @@ func resourceAwsDmsReplicationInstanceRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	d.Set("tags", tagsToMapDMS(tagsResp.TagList))
 
 	return nil
 }
 
 func resourceAwsDmsReplicationInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
 	request := &dms.ModifyReplicationInstanceInput{
 		ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
 		ReplicationInstanceArn: aws.String(d.Get("replication_instance_arn").(string)),
@@ -292,16 +294,14 @@ func resourceAwsDmsReplicationInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
-		err := dmsSetTags(d.Get("replication_instance_arn").(string), d, meta)
+	if d.HasChange("tags_all") {
+		err := setTagsDMS(conn, d, d.Get("replication_instance_arn").(string))
 		if err != nil {
 			return err
 		}
 	}
 
 	if hasChanges {
-		conn := meta.(*AWSClient).dmsconn
-
 		_, err := conn.ModifyReplicationInstance(request)
 		if err != nil {
 			return err
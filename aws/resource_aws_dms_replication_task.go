@@ -97,7 +97,7 @@ func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{
 		ReplicationTaskIdentifier: aws.String(d.Get("replication_task_id").(string)),
 		SourceEndpointArn:         aws.String(d.Get("source_endpoint_arn").(string)),
 		TableMappings:             aws.String(d.Get("table_mappings").(string)),
-		Tags:                      dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                      tagsFromMapDMS(d.Get("tags_all").(map[string]interface{})),
 		TargetEndpointArn:         aws.String(d.Get("target_endpoint_arn").(string)),
 	}
 
@@ -172,7 +172,7 @@ func resourceAwsDmsReplicationTaskRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	d.Set("tags", tagsToMapDMS(tagsResp.TagList))
 
 	return nil
 }
@@ -209,8 +209,8 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
-		err := dmsSetTags(d.Get("replication_task_arn").(string), d, meta)
+	if d.HasChange("tags_all") {
+		err := setTagsDMS(conn, d, d.Get("replication_task_arn").(string))
 		if err != nil {
 			return err
 		}
@@ -15,6 +15,9 @@ func resourceAwsDxConnectionAssociation() *schema.Resource {
 		Create: resourceAwsDxConnectionAssociationCreate,
 		Read:   resourceAwsDxConnectionAssociationRead,
 		Delete: resourceAwsDxConnectionAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDxConnectionAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"connection_id": {
@@ -31,6 +34,24 @@ func resourceAwsDxConnectionAssociation() *schema.Resource {
 	}
 }
 
+func resourceAwsDxConnectionAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).dxconn
+
+	resp, err := conn.DescribeConnections(&directconnect.DescribeConnectionsInput{
+		ConnectionId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Connections) != 1 {
+		return nil, fmt.Errorf("Found %d DX connections for %s, expected 1", len(resp.Connections), d.Id())
+	}
+
+	d.Set("lag_id", resp.Connections[0].LagId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDxConnectionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dxconn
 
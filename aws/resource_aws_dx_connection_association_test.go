@@ -23,6 +23,11 @@ func TestAccAWSDxConnectionAssociation_basic(t *testing.T) {
 					testAccCheckAwsDxConnectionAssociationExists("aws_dx_connection_association.test"),
 				),
 			},
+			{
+				ResourceName:      "aws_dx_connection_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
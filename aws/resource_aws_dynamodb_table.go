@@ -237,6 +237,24 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 					},
 				},
 			},
+			"restore_source_backup_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -245,6 +263,10 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dynamodbconn
 
+	if v, ok := d.GetOk("restore_source_backup_arn"); ok {
+		return resourceAwsDynamoDbTableCreateFromBackup(d, meta, v.(string))
+	}
+
 	keySchemaMap := map[string]interface{}{
 		"hash_key": d.Get("hash_key").(string),
 	}
@@ -394,32 +416,8 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 			AttributeDefinitions: expandDynamoDbAttributes(attributes),
 		}
 
-		// Only 1 online index can be created or deleted simultaneously per table
-		for _, op := range ops {
-			input.GlobalSecondaryIndexUpdates = []*dynamodb.GlobalSecondaryIndexUpdate{op}
-			log.Printf("[DEBUG] Updating DynamoDB Table: %s", input)
-			_, err := conn.UpdateTable(input)
-			if err != nil {
-				return err
-			}
-			if op.Create != nil {
-				idxName := *op.Create.IndexName
-				if err := waitForDynamoDbGSIToBeActive(d.Id(), idxName, conn); err != nil {
-					return fmt.Errorf("Error waiting for DynamoDB GSI %q to be created: %s", idxName, err)
-				}
-			}
-			if op.Update != nil {
-				idxName := *op.Update.IndexName
-				if err := waitForDynamoDbGSIToBeActive(d.Id(), idxName, conn); err != nil {
-					return fmt.Errorf("Error waiting for DynamoDB GSI %q to be updated: %s", idxName, err)
-				}
-			}
-			if op.Delete != nil {
-				idxName := *op.Delete.IndexName
-				if err := waitForDynamoDbGSIToBeDeleted(d.Id(), idxName, conn); err != nil {
-					return fmt.Errorf("Error waiting for DynamoDB GSI %q to be deleted: %s", idxName, err)
-				}
-			}
+		if err := updateDynamoDbGSIs(input, ops, conn); err != nil {
+			return err
 		}
 
 		// We may only be changing the attribute type
@@ -442,7 +440,7 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsDynamoDb(conn, d); err != nil {
 			return err
 		}
@@ -485,6 +483,16 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	pitrOut, err := conn.DescribeContinuousBackups(&dynamodb.DescribeContinuousBackupsInput{
+		TableName: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, "UnknownOperationException", "") {
+		return fmt.Errorf("Error reading DynamoDB Table (%s) continuous backups: %s", d.Id(), err)
+	}
+	if err := d.Set("point_in_time_recovery", flattenDynamoDbPitr(pitrOut)); err != nil {
+		return fmt.Errorf("error setting point_in_time_recovery: %s", err)
+	}
+
 	tags, err := readDynamoDbTableTags(d.Get("arn").(string), conn)
 	if err != nil {
 		return err
@@ -534,6 +542,280 @@ func resourceAwsDynamoDbTableDelete(d *schema.ResourceData, meta interface{}) er
 	return err
 }
 
+// resourceAwsDynamoDbTableCreateFromBackup creates the table by restoring
+// an on-demand backup. The restored table carries the key schema, indexes and
+// provisioned throughput of the backup, so it is reconciled with the
+// configuration before the usual update of TTL and tags.
+func resourceAwsDynamoDbTableCreateFromBackup(d *schema.ResourceData, meta interface{}, backupArn string) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.RestoreTableFromBackupInput{
+		BackupArn:       aws.String(backupArn),
+		TargetTableName: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Restoring DynamoDB table from backup: %s", input)
+
+	var output *dynamodb.RestoreTableFromBackupOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.RestoreTableFromBackup(input)
+		if err != nil {
+			if isAWSErr(err, "ThrottlingException", "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "simultaneously") {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error restoring DynamoDB table from backup (%s): %s", backupArn, err)
+	}
+
+	d.SetId(*output.TableDescription.TableName)
+	d.Set("arn", output.TableDescription.TableArn)
+
+	if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+		return err
+	}
+
+	if err := updateDynamoDbRestoredTable(d, conn); err != nil {
+		return err
+	}
+
+	return resourceAwsDynamoDbTableUpdate(d, meta)
+}
+
+// updateDynamoDbRestoredTable brings the provisioned throughput, streams and
+// global secondary indexes of a freshly restored table in line with the
+// configuration. The key schema and local secondary indexes cannot be
+// changed, so a mismatch with the configuration is returned as an error.
+func updateDynamoDbRestoredTable(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
+	result, err := conn.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return err
+	}
+	table := result.Table
+
+	err = validateDynamoDbRestoredTableKeys(table, d.Get("hash_key").(string), d.Get("range_key").(string),
+		d.Get("attribute").(*schema.Set).List(), d.Get("local_secondary_index").(*schema.Set).List())
+	if err != nil {
+		return fmt.Errorf("DynamoDB table %s restored from backup %s does not match the configuration, "+
+			"it will be replaced once the configuration matches the backup: %s",
+			d.Id(), d.Get("restore_source_backup_arn").(string), err)
+	}
+
+	readCapacity := int64(d.Get("read_capacity").(int))
+	writeCapacity := int64(d.Get("write_capacity").(int))
+	if aws.Int64Value(table.ProvisionedThroughput.ReadCapacityUnits) != readCapacity ||
+		aws.Int64Value(table.ProvisionedThroughput.WriteCapacityUnits) != writeCapacity {
+		_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+			ProvisionedThroughput: expandDynamoDbProvisionedThroughput(map[string]interface{}{
+				"read_capacity":  d.Get("read_capacity"),
+				"write_capacity": d.Get("write_capacity"),
+			}),
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Table update: %s", err)
+		}
+	}
+
+	// The view type of an enabled stream cannot be changed, so a stream
+	// that does not match the configuration is disabled first
+	streamEnabled := d.Get("stream_enabled").(bool)
+	streamViewType := d.Get("stream_view_type").(string)
+	var tableStreamEnabled bool
+	var tableStreamViewType string
+	if table.StreamSpecification != nil {
+		tableStreamEnabled = aws.BoolValue(table.StreamSpecification.StreamEnabled)
+		tableStreamViewType = aws.StringValue(table.StreamSpecification.StreamViewType)
+	}
+	if tableStreamEnabled && (!streamEnabled || tableStreamViewType != streamViewType) {
+		spec := &dynamodb.StreamSpecification{
+			StreamEnabled: aws.Bool(false),
+		}
+		if err := updateDynamoDbStreamSpecification(d, spec, conn); err != nil {
+			return err
+		}
+		tableStreamEnabled = false
+	}
+	if streamEnabled && !tableStreamEnabled {
+		spec := &dynamodb.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: aws.String(streamViewType),
+		}
+		if err := updateDynamoDbStreamSpecification(d, spec, conn); err != nil {
+			return err
+		}
+	}
+
+	ops, err := diffDynamoDbGSI(flattenDynamoDbGlobalSecondaryIndexes(table.GlobalSecondaryIndexes), d.Get("global_secondary_index").(*schema.Set).List())
+	if err != nil {
+		return fmt.Errorf("Computing difference for global_secondary_index failed: %s", err)
+	}
+	if len(ops) > 0 {
+		log.Printf("[DEBUG] Updating global secondary indexes of restored table:\n%s", ops)
+
+		input := &dynamodb.UpdateTableInput{
+			TableName:            aws.String(d.Id()),
+			AttributeDefinitions: expandDynamoDbAttributes(d.Get("attribute").(*schema.Set).List()),
+		}
+
+		if err := updateDynamoDbGSIs(input, ops, conn); err != nil {
+			return err
+		}
+
+		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Table op: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func updateDynamoDbStreamSpecification(d *schema.ResourceData, spec *dynamodb.StreamSpecification, conn *dynamodb.DynamoDB) error {
+	_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+		TableName:           aws.String(d.Id()),
+		StreamSpecification: spec,
+	})
+	if err != nil {
+		return err
+	}
+	if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Table update: %s", err)
+	}
+	return nil
+}
+
+// validateDynamoDbRestoredTableKeys checks that the key schema, local
+// secondary indexes and key attribute types of a restored table are the ones
+// of the configuration.
+func validateDynamoDbRestoredTableKeys(table *dynamodb.TableDescription, hashKey, rangeKey string, attributes, lsis []interface{}) error {
+	var tableHashKey, tableRangeKey string
+	for _, key := range table.KeySchema {
+		switch aws.StringValue(key.KeyType) {
+		case dynamodb.KeyTypeHash:
+			tableHashKey = aws.StringValue(key.AttributeName)
+		case dynamodb.KeyTypeRange:
+			tableRangeKey = aws.StringValue(key.AttributeName)
+		}
+	}
+	if tableHashKey != hashKey {
+		return fmt.Errorf("hash_key is %q in the backup, %q in the configuration", tableHashKey, hashKey)
+	}
+	if tableRangeKey != rangeKey {
+		return fmt.Errorf("range_key is %q in the backup, %q in the configuration", tableRangeKey, rangeKey)
+	}
+	keyAttributes := []string{tableHashKey}
+	if tableRangeKey != "" {
+		keyAttributes = append(keyAttributes, tableRangeKey)
+	}
+
+	tableLsis := make(map[string]*dynamodb.LocalSecondaryIndexDescription, len(table.LocalSecondaryIndexes))
+	for _, lsi := range table.LocalSecondaryIndexes {
+		tableLsis[aws.StringValue(lsi.IndexName)] = lsi
+	}
+	for _, raw := range lsis {
+		m := raw.(map[string]interface{})
+		name := m["name"].(string)
+		lsi, ok := tableLsis[name]
+		if !ok {
+			return fmt.Errorf("local_secondary_index %q is not in the backup", name)
+		}
+		delete(tableLsis, name)
+
+		var lsiRangeKey string
+		for _, key := range lsi.KeySchema {
+			if aws.StringValue(key.KeyType) == dynamodb.KeyTypeRange {
+				lsiRangeKey = aws.StringValue(key.AttributeName)
+			}
+		}
+		if lsiRangeKey != m["range_key"].(string) {
+			return fmt.Errorf("local_secondary_index %q has range_key %q in the backup, %q in the configuration", name, lsiRangeKey, m["range_key"].(string))
+		}
+		keyAttributes = append(keyAttributes, lsiRangeKey)
+
+		var projectionType string
+		var nonKeyAttributes []*string
+		if lsi.Projection != nil {
+			projectionType = aws.StringValue(lsi.Projection.ProjectionType)
+			nonKeyAttributes = lsi.Projection.NonKeyAttributes
+		}
+		if projectionType != m["projection_type"].(string) {
+			return fmt.Errorf("local_secondary_index %q has projection_type %q in the backup, %q in the configuration", name, projectionType, m["projection_type"].(string))
+		}
+		configNonKeyAttributes, _ := m["non_key_attributes"].([]interface{})
+		if !schema.NewSet(schema.HashString, flattenStringList(nonKeyAttributes)).Equal(schema.NewSet(schema.HashString, configNonKeyAttributes)) {
+			return fmt.Errorf("local_secondary_index %q has different non_key_attributes in the backup", name)
+		}
+	}
+	for name := range tableLsis {
+		return fmt.Errorf("local_secondary_index %q of the backup is not in the configuration", name)
+	}
+
+	tableAttributes := make(map[string]string, len(table.AttributeDefinitions))
+	for _, attr := range table.AttributeDefinitions {
+		tableAttributes[aws.StringValue(attr.AttributeName)] = aws.StringValue(attr.AttributeType)
+	}
+	configAttributes := make(map[string]string, len(attributes))
+	for _, raw := range attributes {
+		m := raw.(map[string]interface{})
+		configAttributes[m["name"].(string)] = m["type"].(string)
+	}
+	for _, name := range keyAttributes {
+		if tableAttributes[name] != configAttributes[name] {
+			return fmt.Errorf("attribute %q has type %q in the backup, %q in the configuration", name, tableAttributes[name], configAttributes[name])
+		}
+	}
+
+	return nil
+}
+
+// updateDynamoDbGSIs applies the global secondary index operations one at a
+// time, as only 1 online index can be created or deleted simultaneously per table.
+func updateDynamoDbGSIs(input *dynamodb.UpdateTableInput, ops []*dynamodb.GlobalSecondaryIndexUpdate, conn *dynamodb.DynamoDB) error {
+	tableName := aws.StringValue(input.TableName)
+
+	for _, op := range ops {
+		input.GlobalSecondaryIndexUpdates = []*dynamodb.GlobalSecondaryIndexUpdate{op}
+		log.Printf("[DEBUG] Updating DynamoDB Table: %s", input)
+		_, err := conn.UpdateTable(input)
+		if err != nil {
+			return err
+		}
+		if op.Create != nil {
+			idxName := *op.Create.IndexName
+			if err := waitForDynamoDbGSIToBeActive(tableName, idxName, conn); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB GSI %q to be created: %s", idxName, err)
+			}
+		}
+		if op.Update != nil {
+			idxName := *op.Update.IndexName
+			if err := waitForDynamoDbGSIToBeActive(tableName, idxName, conn); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB GSI %q to be updated: %s", idxName, err)
+			}
+		}
+		if op.Delete != nil {
+			idxName := *op.Delete.IndexName
+			if err := waitForDynamoDbGSIToBeDeleted(tableName, idxName, conn); err != nil {
+				return fmt.Errorf("Error waiting for DynamoDB GSI %q to be deleted: %s", idxName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func deleteAwsDynamoDbTable(tableName string, conn *dynamodb.DynamoDB) error {
 	input := &dynamodb.DeleteTableInput{
 		TableName: aws.String(tableName),
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbTableBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableBackupCreate,
+		Read:   resourceAwsDynamoDbTableBackupRead,
+		Delete: resourceAwsDynamoDbTableBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDynamoDbBackupName,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbTableBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.CreateBackupInput{
+		BackupName: aws.String(d.Get("name").(string)),
+		TableName:  aws.String(d.Get("table_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB table backup: %s", input)
+
+	var output *dynamodb.CreateBackupOutput
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateBackup(input)
+		if err != nil {
+			// Only one backup of a table can be in progress at a time
+			if isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "simultaneously") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB table backup: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupDetails.BackupArn))
+
+	if err := waitForDynamoDbBackupToBeAvailable(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB table backup (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbTableBackupRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB table backup (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DynamoDB table backup (%s): %s", d.Id(), err)
+	}
+
+	backup := output.BackupDescription.BackupDetails
+	if aws.StringValue(backup.BackupStatus) == dynamodb.BackupStatusDeleted {
+		log.Printf("[WARN] DynamoDB table backup (%s) has been deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", backup.BackupArn)
+	d.Set("name", backup.BackupName)
+	d.Set("size_bytes", backup.BackupSizeBytes)
+	d.Set("status", backup.BackupStatus)
+	d.Set("creation_date_time", "")
+	if backup.BackupCreationDateTime != nil {
+		d.Set("creation_date_time", aws.TimeValue(backup.BackupCreationDateTime).Format(time.RFC3339))
+	}
+
+	if table := output.BackupDescription.SourceTableDetails; table != nil {
+		d.Set("table_name", table.TableName)
+		d.Set("table_arn", table.TableArn)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	log.Printf("[DEBUG] Deleting DynamoDB table backup: %s", d.Id())
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteBackup(&dynamodb.DeleteBackupInput{
+			BackupArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DynamoDB table backup (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForDynamoDbBackupToBeAvailable(backupArn string, timeout time.Duration, conn *dynamodb.DynamoDB) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.BackupStatusCreating},
+		Target:  []string{dynamodb.BackupStatusAvailable},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			result, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+				BackupArn: aws.String(backupArn),
+			})
+			if err != nil {
+				return 42, "", err
+			}
+
+			return result, aws.StringValue(result.BackupDescription.BackupDetails.BackupStatus), nil
+		},
+	}
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDynamoDbTableBackup_basic(t *testing.T) {
+	var backup dynamodb.BackupDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_dynamodb_table_backup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbTableBackupConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDynamoDbTableBackupExists(resourceName, &backup),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", dynamodb.BackupStatusAvailable),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", "aws_dynamodb_table.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbTableBackupExists(n string, backup *dynamodb.BackupDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB table backup ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*backup = *output.BackupDescription
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDynamoDbTableBackupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_backup" {
+			continue
+		}
+
+		output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(output.BackupDescription.BackupDetails.BackupStatus) == dynamodb.BackupStatusDeleted {
+			continue
+		}
+
+		return fmt.Errorf("DynamoDB table backup %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSDynamoDbTableBackupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = "%[1]s"
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_backup" "test" {
+  name       = "%[1]s"
+  table_name = "${aws_dynamodb_table.test.name}"
+}
+`, rName)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -327,6 +328,188 @@ func TestDiffDynamoDbGSI(t *testing.T) {
 	}
 }
 
+func TestFlattenDynamoDbGlobalSecondaryIndexes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAwsDynamoDbTable().Schema, map[string]interface{}{
+		"global_secondary_index": []interface{}{
+			map[string]interface{}{
+				"name":            "att1-index",
+				"hash_key":        "att1",
+				"write_capacity":  10,
+				"read_capacity":   10,
+				"projection_type": "ALL",
+			},
+			map[string]interface{}{
+				"name":               "att2-index",
+				"hash_key":           "att2",
+				"range_key":          "att3",
+				"write_capacity":     5,
+				"read_capacity":      5,
+				"projection_type":    "INCLUDE",
+				"non_key_attributes": []interface{}{"RandomAttribute"},
+			},
+		},
+	})
+
+	gsis := []*dynamodb.GlobalSecondaryIndexDescription{
+		{
+			IndexName: aws.String("att1-index"),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String("att1"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{
+				WriteCapacityUnits: aws.Int64(10),
+				ReadCapacityUnits:  aws.Int64(10),
+			},
+			Projection: &dynamodb.Projection{
+				ProjectionType: aws.String("ALL"),
+			},
+		},
+		{
+			IndexName: aws.String("att2-index"),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String("att2"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String("att3"), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{
+				WriteCapacityUnits: aws.Int64(5),
+				ReadCapacityUnits:  aws.Int64(5),
+			},
+			Projection: &dynamodb.Projection{
+				ProjectionType:   aws.String("INCLUDE"),
+				NonKeyAttributes: aws.StringSlice([]string{"RandomAttribute"}),
+			},
+		},
+	}
+
+	ops, err := diffDynamoDbGSI(flattenDynamoDbGlobalSecondaryIndexes(gsis), d.Get("global_secondary_index").(*schema.Set).List())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 0 {
+		t.Fatalf("Expected no updates for matching indexes, got:\n%s", ops)
+	}
+
+	gsis[1].ProvisionedThroughput.ReadCapacityUnits = aws.Int64(1)
+
+	ops, err = diffDynamoDbGSI(flattenDynamoDbGlobalSecondaryIndexes(gsis), d.Get("global_secondary_index").(*schema.Set).List())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 1 || ops[0].Update == nil || aws.StringValue(ops[0].Update.IndexName) != "att2-index" {
+		t.Fatalf("Expected a capacity update of att2-index, got:\n%s", ops)
+	}
+}
+
+func TestValidateDynamoDbRestoredTableKeys(t *testing.T) {
+	table := &dynamodb.TableDescription{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+			{AttributeName: aws.String("created"), AttributeType: aws.String("N")},
+			{AttributeName: aws.String("status"), AttributeType: aws.String("S")},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String("id"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			{AttributeName: aws.String("created"), KeyType: aws.String(dynamodb.KeyTypeRange)},
+		},
+		LocalSecondaryIndexes: []*dynamodb.LocalSecondaryIndexDescription{
+			{
+				IndexName: aws.String("status-index"),
+				KeySchema: []*dynamodb.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+					{AttributeName: aws.String("status"), KeyType: aws.String(dynamodb.KeyTypeRange)},
+				},
+				Projection: &dynamodb.Projection{
+					ProjectionType:   aws.String(dynamodb.ProjectionTypeInclude),
+					NonKeyAttributes: aws.StringSlice([]string{"owner", "size"}),
+				},
+			},
+		},
+	}
+	attributes := func(statusType string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{"name": "id", "type": "S"},
+			map[string]interface{}{"name": "created", "type": "N"},
+			map[string]interface{}{"name": "status", "type": statusType},
+		}
+	}
+	lsi := func(rangeKey string, nonKeyAttributes ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name":               "status-index",
+				"range_key":          rangeKey,
+				"projection_type":    dynamodb.ProjectionTypeInclude,
+				"non_key_attributes": nonKeyAttributes,
+			},
+		}
+	}
+
+	cases := []struct {
+		hashKey    string
+		rangeKey   string
+		attributes []interface{}
+		lsis       []interface{}
+		err        string
+	}{
+		{"id", "created", attributes("S"), lsi("status", "size", "owner"), ""},
+		{"pk", "created", attributes("S"), lsi("status", "owner", "size"), `hash_key is "id" in the backup, "pk" in the configuration`},
+		{"id", "", attributes("S"), lsi("status", "owner", "size"), `range_key is "created" in the backup, "" in the configuration`},
+		{"id", "created", attributes("S"), lsi("created", "owner", "size"), `local_secondary_index "status-index" has range_key "status" in the backup, "created" in the configuration`},
+		{"id", "created", attributes("S"), lsi("status", "owner"), `local_secondary_index "status-index" has different non_key_attributes in the backup`},
+		{"id", "created", attributes("S"), nil, `local_secondary_index "status-index" of the backup is not in the configuration`},
+		{"id", "created", attributes("N"), lsi("status", "owner", "size"), `attribute "status" has type "S" in the backup, "N" in the configuration`},
+	}
+
+	for i, tc := range cases {
+		err := validateDynamoDbRestoredTableKeys(table, tc.hashKey, tc.rangeKey, tc.attributes, tc.lsis)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("%d: expected error %q, got: %v", i, tc.err, err)
+		}
+	}
+}
+
+func TestAccAWSDynamoDbTable_restoreSourceBackupArn(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+
+	rName := acctest.RandomWithPrefix("TerraformTestTable-")
+	resourceName := "aws_dynamodb_table.restored"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbConfigRestoreSourceBackupArn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialAWSDynamoDbTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-restored", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_source_backup_arn", "aws_dynamodb_table_backup.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "read_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "write_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stream_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "stream_view_type", "KEYS_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "ttl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore_source_backup_arn"},
+			},
+		},
+	})
+}
+
 func TestAccAWSDynamoDbTable_basic(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 
@@ -1038,6 +1221,65 @@ resource "aws_dynamodb_table" "basic-dynamodb-table" {
 `, rName)
 }
 
+func testAccAWSDynamoDbConfigRestoreSourceBackupArn(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "source" {
+  name = "%[1]s"
+  read_capacity = 1
+  write_capacity = 1
+  hash_key = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_backup" "test" {
+  name       = "%[1]s"
+  table_name = "${aws_dynamodb_table.source.name}"
+}
+
+resource "aws_dynamodb_table" "restored" {
+  name = "%[1]s-restored"
+  read_capacity = 2
+  write_capacity = 2
+  hash_key = "TestTableHashKey"
+  restore_source_backup_arn = "${aws_dynamodb_table_backup.test.arn}"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestGSIHashKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name = "TestGSI"
+    hash_key = "TestGSIHashKey"
+    write_capacity = 1
+    read_capacity = 1
+    projection_type = "KEYS_ONLY"
+  }
+
+  stream_enabled = true
+  stream_view_type = "KEYS_ONLY"
+
+  ttl {
+    attribute_name = "TestTTL"
+    enabled = true
+  }
+
+  tags {
+    Name = "%[1]s-restored"
+  }
+}
+`, rName)
+}
+
 func testAccAWSDynamoDbConfigInitialState(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "basic-dynamodb-table" {
@@ -17,6 +17,9 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 		Create: resourceAwsEbsSnapshotCreate,
 		Read:   resourceAwsEbsSnapshotRead,
 		Delete: resourceAwsEbsSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
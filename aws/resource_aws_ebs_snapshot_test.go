@@ -58,6 +58,11 @@ func TestAccAWSEBSSnapshot_basic(t *testing.T) {
 					testAccCheckTags(&v.Tags, "Name", rName),
 				),
 			},
+			{
+				ResourceName:      "aws_ebs_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -139,7 +139,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
 		}
@@ -150,7 +150,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
 		}
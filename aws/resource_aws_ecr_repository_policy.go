@@ -17,6 +17,9 @@ func resourceAwsEcrRepositoryPolicy() *schema.Resource {
 		Read:   resourceAwsEcrRepositoryPolicyRead,
 		Update: resourceAwsEcrRepositoryPolicyUpdate,
 		Delete: resourceAwsEcrRepositoryPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"repository": &schema.Schema{
@@ -25,8 +28,9 @@ func resourceAwsEcrRepositoryPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 			"policy": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"registry_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -99,6 +103,8 @@ func resourceAwsEcrRepositoryPolicyRead(d *schema.ResourceData, meta interface{}
 
 	d.SetId(*repositoryPolicy.RepositoryName)
 	d.Set("registry_id", repositoryPolicy.RegistryId)
+	d.Set("repository", repositoryPolicy.RepositoryName)
+	d.Set("policy", repositoryPolicy.PolicyText)
 
 	return nil
 }
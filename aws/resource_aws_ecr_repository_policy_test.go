@@ -26,6 +26,11 @@ func TestAccAWSEcrRepositoryPolicy_basic(t *testing.T) {
 					testAccCheckAWSEcrRepositoryPolicyExists("aws_ecr_repository_policy.default"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_ecr_repository_policy.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
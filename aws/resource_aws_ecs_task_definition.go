@@ -18,6 +18,9 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 		Create: resourceAwsEcsTaskDefinitionCreate,
 		Read:   resourceAwsEcsTaskDefinitionRead,
 		Delete: resourceAwsEcsTaskDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcsTaskDefinitionImport,
+		},
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsEcsTaskDefinitionMigrateState,
@@ -163,6 +166,12 @@ func validateAwsEcsTaskDefinitionContainerDefinitions(v interface{}, k string) (
 	return
 }
 
+func resourceAwsEcsTaskDefinitionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsEcsTaskDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
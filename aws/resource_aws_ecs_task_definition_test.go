@@ -34,6 +34,12 @@ func TestAccAWSEcsTaskDefinition_basic(t *testing.T) {
 					testAccCheckAWSEcsTaskDefinitionExists("aws_ecs_task_definition.jenkins", &def),
 				),
 			},
+			{
+				ResourceName:      "aws_ecs_task_definition.jenkins",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_ecs_task_definition.jenkins", "arn"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -23,6 +23,11 @@ func TestAccAWSEgressOnlyInternetGateway_basic(t *testing.T) {
 					testAccCheckAWSEgressOnlyInternetGatewayExists("aws_egress_only_internet_gateway.foo", &igw),
 				),
 			},
+			{
+				ResourceName:      "aws_egress_only_internet_gateway.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -37,6 +37,11 @@ func TestAccAWSEIPAssociation_basic(t *testing.T) {
 						"aws_eip_association.to_eni", &a),
 				),
 			},
+			{
+				ResourceName:      "aws_eip_association.by_allocation_id",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
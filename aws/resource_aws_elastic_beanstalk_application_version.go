@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,9 @@ func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 		Read:   resourceAwsElasticBeanstalkApplicationVersionRead,
 		Update: resourceAwsElasticBeanstalkApplicationVersionUpdate,
 		Delete: resourceAwsElasticBeanstalkApplicationVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticBeanstalkApplicationVersionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"application": &schema.Schema{
@@ -52,6 +56,18 @@ func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 	}
 }
 
+func resourceAwsElasticBeanstalkApplicationVersionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION/NAME", d.Id())
+	}
+
+	d.Set("application", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticBeanstalkApplicationVersionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticbeanstalkconn
 
@@ -107,9 +123,17 @@ func resourceAwsElasticBeanstalkApplicationVersionRead(d *schema.ResourceData, m
 			len(resp.ApplicationVersions), d.Id())
 	}
 
-	if err := d.Set("description", resp.ApplicationVersions[0].Description); err != nil {
+	applicationVersion := resp.ApplicationVersions[0]
+
+	d.Set("application", applicationVersion.ApplicationName)
+	d.Set("name", applicationVersion.VersionLabel)
+	if err := d.Set("description", applicationVersion.Description); err != nil {
 		return err
 	}
+	if applicationVersion.SourceBundle != nil {
+		d.Set("bucket", applicationVersion.SourceBundle.S3Bucket)
+		d.Set("key", applicationVersion.SourceBundle.S3Key)
+	}
 
 	return nil
 }
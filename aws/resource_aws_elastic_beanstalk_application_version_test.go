@@ -27,6 +27,13 @@ func TestAccAWSBeanstalkAppVersion_basic(t *testing.T) {
 					testAccCheckApplicationVersionExists("aws_elastic_beanstalk_application_version.default", &appVersion),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "aws_elastic_beanstalk_application_version.default",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSImportStateIdFunc("aws_elastic_beanstalk_application_version.default", "application", "name"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
 		},
 	})
 }
@@ -18,6 +18,9 @@ func resourceAwsElasticBeanstalkConfigurationTemplate() *schema.Resource {
 		Read:   resourceAwsElasticBeanstalkConfigurationTemplateRead,
 		Update: resourceAwsElasticBeanstalkConfigurationTemplateUpdate,
 		Delete: resourceAwsElasticBeanstalkConfigurationTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticBeanstalkConfigurationTemplateImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -55,6 +58,32 @@ func resourceAwsElasticBeanstalkConfigurationTemplate() *schema.Resource {
 	}
 }
 
+func resourceAwsElasticBeanstalkConfigurationTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION/NAME", d.Id())
+	}
+
+	conn := meta.(*AWSClient).elasticbeanstalkconn
+	resp, err := conn.DescribeConfigurationSettings(&elasticbeanstalk.DescribeConfigurationSettingsInput{
+		ApplicationName: aws.String(idParts[0]),
+		TemplateName:    aws.String(idParts[1]),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.ConfigurationSettings) != 1 {
+		return nil, fmt.Errorf("Error importing Elastic Beanstalk configuration template: found %d templates, expected 1", len(resp.ConfigurationSettings))
+	}
+
+	d.Set("application", idParts[0])
+	d.Set("name", idParts[1])
+	d.Set("solution_stack_name", resp.ConfigurationSettings[0].SolutionStackName)
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticBeanstalkConfigurationTemplateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticbeanstalkconn
 
@@ -26,6 +26,12 @@ func TestAccAWSBeanstalkConfigurationTemplate_basic(t *testing.T) {
 					testAccCheckBeanstalkConfigurationTemplateExists("aws_elastic_beanstalk_configuration_template.tf_template", &config),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_elastic_beanstalk_configuration_template.tf_template",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_elastic_beanstalk_configuration_template.tf_template", "application", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -52,6 +52,12 @@ func resourceAwsElasticBeanstalkEnvironment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsElasticBeanstalkEnvironmentMigrateState,
 
@@ -219,7 +225,7 @@ func resourceAwsElasticBeanstalkEnvironmentCreate(d *schema.ResourceData, meta i
 		EnvironmentName: aws.String(name),
 		ApplicationName: aws.String(app),
 		OptionSettings:  extractOptionSettings(settings),
-		Tags:            tagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            tagsFromMapBeanstalk(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if desc != "" {
@@ -272,7 +278,7 @@ func resourceAwsElasticBeanstalkEnvironmentCreate(d *schema.ResourceData, meta i
 	// Assign the application name as the resource ID
 	d.SetId(*resp.EnvironmentId)
 
-	waitForReadyTimeOut, err := time.ParseDuration(d.Get("wait_for_ready_timeout").(string))
+	waitForReadyTimeOut, err := resourceAwsElasticBeanstalkEnvironmentWaitTimeout(d, schema.TimeoutCreate)
 	if err != nil {
 		return err
 	}
@@ -420,7 +426,7 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 			return err
 		}
 
-		waitForReadyTimeOut, err := time.ParseDuration(d.Get("wait_for_ready_timeout").(string))
+		waitForReadyTimeOut, err := resourceAwsElasticBeanstalkEnvironmentWaitTimeout(d, schema.TimeoutUpdate)
 		if err != nil {
 			return err
 		}
@@ -456,28 +462,17 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
-		oldTags := tagsFromMapBeanstalk(o.(map[string]interface{}))
-		newTags := tagsFromMapBeanstalk(n.(map[string]interface{}))
-
-		tagsToAdd, tagNamesToRemove := diffTagsBeanstalk(oldTags, newTags)
-
-		updateTags := elasticbeanstalk.UpdateTagsForResourceInput{
-			ResourceArn:  aws.String(d.Get("arn").(string)),
-			TagsToAdd:    tagsToAdd,
-			TagsToRemove: tagNamesToRemove,
-		}
+	if d.HasChange("tags_all") {
+		o, _ := d.GetChange("tags_all")
+		n := d.Get("tags_all")
 
 		// Get the current time to filter getBeanstalkEnvironmentErrors messages
 		t := time.Now()
-		log.Printf("[DEBUG] Elastic Beanstalk Environment update tags: %s", updateTags)
-		_, err := conn.UpdateTagsForResource(&updateTags)
-		if err != nil {
+		if err := updateTagsBeanstalk(conn, d.Get("arn").(string), o, n); err != nil {
 			return err
 		}
 
-		waitForReadyTimeOut, err := time.ParseDuration(d.Get("wait_for_ready_timeout").(string))
+		waitForReadyTimeOut, err := resourceAwsElasticBeanstalkEnvironmentWaitTimeout(d, schema.TimeoutUpdate)
 		if err != nil {
 			return err
 		}
@@ -755,7 +750,7 @@ func resourceAwsElasticBeanstalkEnvironmentDelete(d *schema.ResourceData, meta i
 		return err
 	}
 
-	waitForReadyTimeOut, err := time.ParseDuration(d.Get("wait_for_ready_timeout").(string))
+	waitForReadyTimeOut, err := resourceAwsElasticBeanstalkEnvironmentWaitTimeout(d, schema.TimeoutDelete)
 	if err != nil {
 		return err
 	}
@@ -793,6 +788,21 @@ func resourceAwsElasticBeanstalkEnvironmentDelete(d *schema.ResourceData, meta i
 	return nil
 }
 
+// resourceAwsElasticBeanstalkEnvironmentWaitTimeout returns how long to wait for the environment to settle.
+func resourceAwsElasticBeanstalkEnvironmentWaitTimeout(d *schema.ResourceData, key string) (time.Duration, error) {
+	waitForReadyTimeOut, err := time.ParseDuration(d.Get("wait_for_ready_timeout").(string))
+	if err != nil {
+		return 0, err
+	}
+	// wait_for_ready_timeout predates the timeouts block, so it still wins
+	// when moved off its default.
+	if waitForReadyTimeOut != 20*time.Minute {
+		return waitForReadyTimeOut, nil
+	}
+
+	return d.Timeout(key), nil
+}
+
 // environmentStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // the creation of the Beanstalk Environment
 func environmentStateRefreshFunc(conn *elasticbeanstalk.ElasticBeanstalk, environmentId string, t time.Time) resource.StateRefreshFunc {
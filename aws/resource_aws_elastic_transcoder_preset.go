@@ -16,6 +16,9 @@ func resourceAwsElasticTranscoderPreset() *schema.Resource {
 		Create: resourceAwsElasticTranscoderPresetCreate,
 		Read:   resourceAwsElasticTranscoderPresetRead,
 		Delete: resourceAwsElasticTranscoderPresetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -486,6 +489,7 @@ func resourceAwsElasticTranscoderPresetRead(d *schema.ResourceData, meta interfa
 
 	preset := resp.Preset
 	d.Set("arn", *preset.Arn)
+	d.Set("description", preset.Description)
 
 	if preset.Audio != nil {
 		err := d.Set("audio", flattenETAudioParameters(preset.Audio))
@@ -76,6 +76,11 @@ func TestAccAWSElasticTranscoderPreset_basic(t *testing.T) {
 					checkExists(true),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -28,6 +28,12 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			State: resourceAwsElasticSearchDomainImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"access_policies": {
 				Type:             schema.TypeString,
@@ -418,7 +424,7 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := tagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapElasticsearchService(d.Get("tags_all").(map[string]interface{}))
 
 	if err := setTagsElasticsearchService(conn, d, *out.DomainStatus.ARN); err != nil {
 		return err
@@ -428,7 +434,7 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	d.SetPartial("tags")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
-	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
+	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -439,8 +445,8 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	return resourceAwsElasticSearchDomainRead(d, meta)
 }
 
-func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService, domainName, arn string) error {
-	return resource.Retry(60*time.Minute, func() *resource.RetryError {
+func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService, domainName, arn string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
 		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(domainName),
 		})
@@ -651,7 +657,7 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(d.Get("domain_name").(string)),
 		})
@@ -691,16 +697,16 @@ func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be deleted", domainName)
-	err = resourceAwsElasticSearchDomainDeleteWaiter(domainName, conn)
+	err = resourceAwsElasticSearchDomainDeleteWaiter(domainName, conn, d.Timeout(schema.TimeoutDelete))
 
 	return err
 }
 
-func resourceAwsElasticSearchDomainDeleteWaiter(domainName string, conn *elasticsearch.ElasticsearchService) error {
+func resourceAwsElasticSearchDomainDeleteWaiter(domainName string, conn *elasticsearch.ElasticsearchService, timeout time.Duration) error {
 	input := &elasticsearch.DescribeElasticsearchDomainInput{
 		DomainName: aws.String(domainName),
 	}
-	err := resource.Retry(90*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(timeout, func() *resource.RetryError {
 		out, err := conn.DescribeElasticsearchDomain(input)
 
 		if err != nil {
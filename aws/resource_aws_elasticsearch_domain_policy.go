@@ -18,6 +18,9 @@ func resourceAwsElasticSearchDomainPolicy() *schema.Resource {
 		Read:   resourceAwsElasticSearchDomainPolicyRead,
 		Update: resourceAwsElasticSearchDomainPolicyUpsert,
 		Delete: resourceAwsElasticSearchDomainPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticSearchDomainPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -56,6 +59,13 @@ func resourceAwsElasticSearchDomainPolicyRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsElasticSearchDomainPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("domain_name", d.Id())
+	d.SetId("esd-policy-" + d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticSearchDomainPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 	domainName := d.Get("domain_name").(string)
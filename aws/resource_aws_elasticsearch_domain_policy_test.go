@@ -65,6 +65,11 @@ func TestAccAWSElasticSearchDomainPolicy_basic(t *testing.T) {
 					},
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_elasticsearch_domain_policy.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -36,6 +36,13 @@ func TestAccAWSELBAttachment_basic(t *testing.T) {
 				),
 			},
 
+			resource.TestStep{
+				ResourceName:      "aws_elb_attachment.foo1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_elb_attachment.foo1", "elb", "instance"),
+				ImportStateCheck:  testAccCheckAWSImportStateAttributesSet("elb", "instance"),
+			},
+
 			resource.TestStep{
 				Config: testAccAWSELBAttachmentConfig2,
 				Check: resource.ComposeTestCheckFunc(
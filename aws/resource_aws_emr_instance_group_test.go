@@ -25,6 +25,12 @@ func TestAccAWSEMRInstanceGroup_basic(t *testing.T) {
 				Config: testAccAWSEmrInstanceGroupConfig(rInt),
 				Check:  testAccCheckAWSEmrInstanceGroupExists("aws_emr_instance_group.task", &ig),
 			},
+			{
+				ResourceName:      "aws_emr_instance_group.task",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_emr_instance_group.task", "cluster_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func resourceAwsGameliftBuild() *schema.Resource {
 		Read:   resourceAwsGameliftBuildRead,
 		Update: resourceAwsGameliftBuildUpdate,
 		Delete: resourceAwsGameliftBuildDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -107,6 +107,12 @@ func TestAccAWSGameliftBuild_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_gamelift_build.test", "storage_location.0.role_arn", roleArn),
 				),
 			},
+			{
+				ResourceName:            "aws_gamelift_build.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"storage_location"},
+			},
 		},
 	})
 }
@@ -305,6 +305,12 @@ func TestAccAWSGameliftFleet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_gamelift_fleet.test", "runtime_configuration.0.server_process.0.launch_path", launchPath),
 				),
 			},
+			{
+				ResourceName:            "aws_gamelift_fleet.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ec2_inbound_permission", "runtime_configuration"},
+			},
 		},
 	})
 }
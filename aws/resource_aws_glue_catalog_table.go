@@ -0,0 +1,602 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueCatalogTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCatalogTableCreate,
+		Read:   resourceAwsGlueCatalogTableRead,
+		Update: resourceAwsGlueCatalogTableUpdate,
+		Delete: resourceAwsGlueCatalogTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"partition_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: glueColumnSchema(),
+				},
+			},
+			"retention": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"storage_descriptor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: glueColumnSchema(),
+							},
+						},
+						"compressed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"number_of_buckets": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"output_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"ser_de_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+									"serialization_library": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"skewed_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"skewed_column_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"skewed_column_value_location_maps": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+									"skewed_column_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"sort_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"sort_order": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1),
+									},
+								},
+							},
+						},
+						"stored_as_sub_directories": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"table_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view_expanded_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view_original_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func glueColumnSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"comment": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 255),
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func readAwsGlueCatalogTableID(id string) (catalogID string, dbName string, name string, err error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 3 {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected CATALOG-ID:DATABASE-NAME:TABLE-NAME", id)
+	}
+	return idParts[0], idParts[1], idParts[2], nil
+}
+
+func resourceAwsGlueCatalogTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+	dbName := d.Get("database_name").(string)
+	name := d.Get("name").(string)
+
+	input := &glue.CreateTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableInput:   expandGlueTableInput(d),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Catalog Table: %s", input)
+	_, err := conn.CreateTable(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Glue Catalog Table (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", catalogID, dbName, name))
+
+	return resourceAwsGlueCatalogTableRead(d, meta)
+}
+
+func resourceAwsGlueCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, name, err := readAwsGlueCatalogTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &glue.GetTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		Name:         aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Reading Glue Catalog Table: %s", input)
+	output, err := conn.GetTable(input)
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Catalog Table (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Catalog Table (%s): %s", d.Id(), err)
+	}
+
+	table := output.Table
+	if table == nil {
+		log.Printf("[WARN] Glue Catalog Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("catalog_id", catalogID)
+	d.Set("database_name", dbName)
+	d.Set("name", table.Name)
+	d.Set("description", table.Description)
+	d.Set("owner", table.Owner)
+	d.Set("retention", int(aws.Int64Value(table.Retention)))
+	d.Set("table_type", table.TableType)
+	d.Set("view_expanded_text", table.ViewExpandedText)
+	d.Set("view_original_text", table.ViewOriginalText)
+
+	if err := d.Set("parameters", aws.StringValueMap(table.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("partition_keys", flattenGlueColumns(table.PartitionKeys)); err != nil {
+		return fmt.Errorf("error setting partition_keys: %s", err)
+	}
+
+	if err := d.Set("storage_descriptor", flattenGlueStorageDescriptor(table.StorageDescriptor)); err != nil {
+		return fmt.Errorf("error setting storage_descriptor: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, _, err := readAwsGlueCatalogTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdateTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableInput:   expandGlueTableInput(d),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Catalog Table: %s", input)
+	_, err = conn.UpdateTable(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Glue Catalog Table (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueCatalogTableRead(d, meta)
+}
+
+func resourceAwsGlueCatalogTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, name, err := readAwsGlueCatalogTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Table: %s", d.Id())
+	_, err = conn.DeleteTable(&glue.DeleteTableInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		Name:         aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Catalog Table (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueTableInput(d *schema.ResourceData) *glue.TableInput {
+	tableInput := &glue.TableInput{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		tableInput.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("owner"); ok {
+		tableInput.Owner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		tableInput.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("partition_keys"); ok {
+		tableInput.PartitionKeys = expandGlueColumns(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention"); ok {
+		tableInput.Retention = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("storage_descriptor"); ok {
+		tableInput.StorageDescriptor = expandGlueStorageDescriptor(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_type"); ok {
+		tableInput.TableType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("view_expanded_text"); ok {
+		tableInput.ViewExpandedText = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("view_original_text"); ok {
+		tableInput.ViewOriginalText = aws.String(v.(string))
+	}
+
+	return tableInput
+}
+
+func expandGlueStorageDescriptor(l []interface{}) *glue.StorageDescriptor {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	s := l[0].(map[string]interface{})
+	storageDescriptor := &glue.StorageDescriptor{
+		Compressed:             aws.Bool(s["compressed"].(bool)),
+		StoredAsSubDirectories: aws.Bool(s["stored_as_sub_directories"].(bool)),
+	}
+
+	if v, ok := s["bucket_columns"]; ok {
+		storageDescriptor.BucketColumns = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := s["columns"]; ok {
+		storageDescriptor.Columns = expandGlueColumns(v.([]interface{}))
+	}
+
+	if v, ok := s["input_format"]; ok && v.(string) != "" {
+		storageDescriptor.InputFormat = aws.String(v.(string))
+	}
+
+	if v, ok := s["location"]; ok && v.(string) != "" {
+		storageDescriptor.Location = aws.String(v.(string))
+	}
+
+	if v, ok := s["number_of_buckets"]; ok {
+		storageDescriptor.NumberOfBuckets = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := s["output_format"]; ok && v.(string) != "" {
+		storageDescriptor.OutputFormat = aws.String(v.(string))
+	}
+
+	if v, ok := s["parameters"]; ok {
+		storageDescriptor.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := s["ser_de_info"]; ok {
+		storageDescriptor.SerdeInfo = expandGlueSerDeInfo(v.([]interface{}))
+	}
+
+	if v, ok := s["skewed_info"]; ok {
+		storageDescriptor.SkewedInfo = expandGlueSkewedInfo(v.([]interface{}))
+	}
+
+	if v, ok := s["sort_columns"]; ok {
+		storageDescriptor.SortColumns = expandGlueSortColumns(v.([]interface{}))
+	}
+
+	return storageDescriptor
+}
+
+func expandGlueColumns(columns []interface{}) []*glue.Column {
+	columnSlice := []*glue.Column{}
+	for _, element := range columns {
+		elementMap := element.(map[string]interface{})
+
+		column := &glue.Column{
+			Name: aws.String(elementMap["name"].(string)),
+		}
+
+		if v, ok := elementMap["comment"]; ok && v.(string) != "" {
+			column.Comment = aws.String(v.(string))
+		}
+
+		if v, ok := elementMap["type"]; ok && v.(string) != "" {
+			column.Type = aws.String(v.(string))
+		}
+
+		columnSlice = append(columnSlice, column)
+	}
+
+	return columnSlice
+}
+
+func expandGlueSerDeInfo(l []interface{}) *glue.SerDeInfo {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	s := l[0].(map[string]interface{})
+	serDeInfo := &glue.SerDeInfo{}
+
+	if v, ok := s["name"]; ok && v.(string) != "" {
+		serDeInfo.Name = aws.String(v.(string))
+	}
+
+	if v, ok := s["parameters"]; ok {
+		serDeInfo.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := s["serialization_library"]; ok && v.(string) != "" {
+		serDeInfo.SerializationLibrary = aws.String(v.(string))
+	}
+
+	return serDeInfo
+}
+
+func expandGlueSortColumns(columns []interface{}) []*glue.Order {
+	orderSlice := make([]*glue.Order, len(columns))
+
+	for i, element := range columns {
+		elementMap := element.(map[string]interface{})
+
+		orderSlice[i] = &glue.Order{
+			Column:    aws.String(elementMap["column"].(string)),
+			SortOrder: aws.Int64(int64(elementMap["sort_order"].(int))),
+		}
+	}
+
+	return orderSlice
+}
+
+func expandGlueSkewedInfo(l []interface{}) *glue.SkewedInfo {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	s := l[0].(map[string]interface{})
+	skewedInfo := &glue.SkewedInfo{}
+
+	if v, ok := s["skewed_column_names"]; ok {
+		skewedInfo.SkewedColumnNames = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := s["skewed_column_value_location_maps"]; ok {
+		skewedInfo.SkewedColumnValueLocationMaps = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := s["skewed_column_values"]; ok {
+		skewedInfo.SkewedColumnValues = expandStringList(v.([]interface{}))
+	}
+
+	return skewedInfo
+}
+
+func flattenGlueStorageDescriptor(s *glue.StorageDescriptor) []map[string]interface{} {
+	if s == nil {
+		return []map[string]interface{}{}
+	}
+
+	storageDescriptor := map[string]interface{}{
+		"bucket_columns":            flattenStringList(s.BucketColumns),
+		"columns":                   flattenGlueColumns(s.Columns),
+		"compressed":                aws.BoolValue(s.Compressed),
+		"input_format":              aws.StringValue(s.InputFormat),
+		"location":                  aws.StringValue(s.Location),
+		"number_of_buckets":         int(aws.Int64Value(s.NumberOfBuckets)),
+		"output_format":             aws.StringValue(s.OutputFormat),
+		"parameters":                aws.StringValueMap(s.Parameters),
+		"ser_de_info":               flattenGlueSerDeInfo(s.SerdeInfo),
+		"skewed_info":               flattenGlueSkewedInfo(s.SkewedInfo),
+		"sort_columns":              flattenGlueOrders(s.SortColumns),
+		"stored_as_sub_directories": aws.BoolValue(s.StoredAsSubDirectories),
+	}
+
+	return []map[string]interface{}{storageDescriptor}
+}
+
+func flattenGlueColumns(cs []*glue.Column) []map[string]interface{} {
+	columnsSlice := make([]map[string]interface{}, len(cs))
+
+	for i, v := range cs {
+		columnsSlice[i] = map[string]interface{}{
+			"comment": aws.StringValue(v.Comment),
+			"name":    aws.StringValue(v.Name),
+			"type":    aws.StringValue(v.Type),
+		}
+	}
+
+	return columnsSlice
+}
+
+func flattenGlueSerDeInfo(s *glue.SerDeInfo) []map[string]interface{} {
+	if s == nil || (s.Name == nil && len(s.Parameters) == 0 && s.SerializationLibrary == nil) {
+		return []map[string]interface{}{}
+	}
+
+	serDeInfo := map[string]interface{}{
+		"name":                  aws.StringValue(s.Name),
+		"parameters":            aws.StringValueMap(s.Parameters),
+		"serialization_library": aws.StringValue(s.SerializationLibrary),
+	}
+
+	return []map[string]interface{}{serDeInfo}
+}
+
+func flattenGlueOrders(os []*glue.Order) []map[string]interface{} {
+	orders := make([]map[string]interface{}, len(os))
+
+	for i, v := range os {
+		orders[i] = map[string]interface{}{
+			"column":     aws.StringValue(v.Column),
+			"sort_order": int(aws.Int64Value(v.SortOrder)),
+		}
+	}
+
+	return orders
+}
+
+func flattenGlueSkewedInfo(s *glue.SkewedInfo) []map[string]interface{} {
+	if s == nil || (len(s.SkewedColumnNames) == 0 && len(s.SkewedColumnValueLocationMaps) == 0 && len(s.SkewedColumnValues) == 0) {
+		return []map[string]interface{}{}
+	}
+
+	skewedInfo := map[string]interface{}{
+		"skewed_column_names":               flattenStringList(s.SkewedColumnNames),
+		"skewed_column_value_location_maps": aws.StringValueMap(s.SkewedColumnValueLocationMaps),
+		"skewed_column_values":              flattenStringList(s.SkewedColumnValues),
+	}
+
+	return []map[string]interface{}{skewedInfo}
+}
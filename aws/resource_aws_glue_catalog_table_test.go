@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestReadAwsGlueCatalogTableID(t *testing.T) {
+	cases := []struct {
+		ID        string
+		CatalogID string
+		DbName    string
+		Name      string
+		ErrCount  int
+	}{
+		{
+			ID:        "123456789012:my_database:my_table",
+			CatalogID: "123456789012",
+			DbName:    "my_database",
+			Name:      "my_table",
+		},
+		{
+			ID:       "123456789012:my_database",
+			ErrCount: 1,
+		},
+		{
+			ID:       "my_table",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		catalogID, dbName, name, err := readAwsGlueCatalogTableID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if catalogID != tc.CatalogID || dbName != tc.DbName || name != tc.Name {
+			t.Fatalf("expected %q to parse as %s/%s/%s, received: %s/%s/%s",
+				tc.ID, tc.CatalogID, tc.DbName, tc.Name, catalogID, dbName, name)
+		}
+	}
+}
+
+func TestAccAWSGlueCatalogTable_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTable_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("my_test_catalog_table_%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "database_name", fmt.Sprintf("my_test_catalog_database_%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCatalogTable_full(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTable_full(rInt, "A test table from terraform", "my_column_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "A test table from terraform"),
+					resource.TestCheckResourceAttr(resourceName, "owner", "my_owner"),
+					resource.TestCheckResourceAttr(resourceName, "retention", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param1", "param1_val"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.0.name", "my_column_12"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.type", "int"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", "my_location"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.compressed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.number_of_buckets", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.0.serialization_library", "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.skewed_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.skewed_info.0.skewed_column_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.sort_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.sort_columns.0.sort_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_type", "VIRTUAL_VIEW"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogTable_full(rInt, "An updated test table from terraform", "my_column_a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "An updated test table from terraform"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "my_column_a"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlueTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_catalog_table" {
+			continue
+		}
+
+		catalogID, dbName, name, err := readAwsGlueCatalogTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Glue Catalog Table %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlueCatalogTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		catalogID, dbName, tableName, err := readAwsGlueCatalogTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		out, err := conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(tableName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if out.Table == nil {
+			return fmt.Errorf("No Glue Table Found")
+		}
+
+		if aws.StringValue(out.Table.Name) != tableName {
+			return fmt.Errorf("Glue Table Mismatch - existing: %q, state: %q",
+				aws.StringValue(out.Table.Name), tableName)
+		}
+
+		return nil
+	}
+}
+
+func testAccGlueCatalogTable_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "my_test_catalog_database_%[1]d"
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = "my_test_catalog_table_%[1]d"
+  database_name = "${aws_glue_catalog_database.test.name}"
+}
+`, rInt)
+}
+
+func testAccGlueCatalogTable_full(rInt int, desc, columnName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "my_test_catalog_database_%[1]d"
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name               = "my_test_catalog_table_%[1]d"
+  database_name      = "${aws_glue_catalog_database.test.name}"
+  description        = "%[2]s"
+  owner              = "my_owner"
+  retention          = 1
+  table_type         = "VIRTUAL_VIEW"
+  view_expanded_text = "view_expanded_text_1"
+  view_original_text = "view_original_text_1"
+
+  storage_descriptor {
+    columns = [
+      {
+        name    = "%[3]s"
+        type    = "int"
+        comment = "my_column1_comment"
+      },
+      {
+        name    = "my_column_2"
+        type    = "string"
+        comment = "my_column2_comment"
+      },
+    ]
+
+    location          = "my_location"
+    input_format      = "SequenceFileInputFormat"
+    output_format     = "SequenceFileInputFormat"
+    compressed        = false
+    number_of_buckets = 1
+
+    ser_de_info {
+      name = "ser_de_name"
+
+      parameters {
+        param1 = "param_val_1"
+      }
+
+      serialization_library = "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"
+    }
+
+    bucket_columns = ["bucket_column_1"]
+
+    sort_columns = [
+      {
+        column     = "my_column_1"
+        sort_order = 1
+      },
+    ]
+
+    parameters {
+      param1 = "param1_val"
+    }
+
+    skewed_info {
+      skewed_column_names = [
+        "my_column_1",
+      ]
+
+      skewed_column_value_location_maps {
+        my_column_1 = "my_column_1_val_loc_map"
+      }
+
+      skewed_column_values = [
+        "skewed_val_1",
+      ]
+    }
+
+    stored_as_sub_directories = false
+  }
+
+  partition_keys = [
+    {
+      name    = "my_column_12"
+      type    = "date"
+      comment = "my_column_12_comment"
+    },
+  ]
+
+  parameters {
+    param1 = "param1_val"
+  }
+}
+`, rInt, desc, columnName)
+}
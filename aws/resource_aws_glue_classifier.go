@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueClassifier() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueClassifierCreate,
+		Read:   resourceAwsGlueClassifierRead,
+		Update: resourceAwsGlueClassifierUpdate,
+		Delete: resourceAwsGlueClassifierDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsGlueClassifierCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"grok_classifier": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"json_classifier", "xml_classifier"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classification": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"custom_patterns": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 16000),
+						},
+						"grok_pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"json_classifier": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"grok_classifier", "xml_classifier"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"xml_classifier": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"grok_classifier", "json_classifier"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classification": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"row_tag": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsGlueClassifierCustomizeDiff forces a new classifier when its
+// type changes, as UpdateClassifier can only modify a classifier in place.
+func resourceAwsGlueClassifierCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{"grok_classifier", "json_classifier", "xml_classifier"} {
+		if !diff.HasChange(key) {
+			continue
+		}
+		o, n := diff.GetChange(key)
+		if len(o.([]interface{})) != len(n.([]interface{})) {
+			if err := diff.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsGlueClassifierCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input := &glue.CreateClassifierInput{}
+
+	if v, ok := d.GetOk("grok_classifier"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		input.GrokClassifier = &glue.CreateGrokClassifierRequest{
+			Classification: aws.String(m["classification"].(string)),
+			GrokPattern:    aws.String(m["grok_pattern"].(string)),
+			Name:           aws.String(name),
+		}
+		if v, ok := m["custom_patterns"]; ok && v.(string) != "" {
+			input.GrokClassifier.CustomPatterns = aws.String(v.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("json_classifier"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		input.JsonClassifier = &glue.CreateJsonClassifierRequest{
+			JsonPath: aws.String(m["json_path"].(string)),
+			Name:     aws.String(name),
+		}
+	}
+
+	if v, ok := d.GetOk("xml_classifier"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		input.XMLClassifier = &glue.CreateXMLClassifierRequest{
+			Classification: aws.String(m["classification"].(string)),
+			Name:           aws.String(name),
+			RowTag:         aws.String(m["row_tag"].(string)),
+		}
+	}
+
+	if input.GrokClassifier == nil && input.JsonClassifier == nil && input.XMLClassifier == nil {
+		return fmt.Errorf("One of grok_classifier, json_classifier or xml_classifier must be configured")
+	}
+
+	log.Printf("[DEBUG] Creating Glue Classifier: %s", input)
+	_, err := conn.CreateClassifier(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Glue Classifier (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueClassifierRead(d, meta)
+}
+
+func resourceAwsGlueClassifierRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.GetClassifierInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Glue Classifier: %s", input)
+	output, err := conn.GetClassifier(input)
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Classifier (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Classifier (%s): %s", d.Id(), err)
+	}
+
+	classifier := output.Classifier
+	if classifier == nil {
+		log.Printf("[WARN] Glue Classifier (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("grok_classifier", flattenGlueGrokClassifier(classifier.GrokClassifier)); err != nil {
+		return fmt.Errorf("error setting grok_classifier: %s", err)
+	}
+
+	if err := d.Set("json_classifier", flattenGlueJsonClassifier(classifier.JsonClassifier)); err != nil {
+		return fmt.Errorf("error setting json_classifier: %s", err)
+	}
+
+	if err := d.Set("xml_classifier", flattenGlueXmlClassifier(classifier.XMLClassifier)); err != nil {
+		return fmt.Errorf("error setting xml_classifier: %s", err)
+	}
+
+	d.Set("name", d.Id())
+
+	return nil
+}
+
+func resourceAwsGlueClassifierUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.UpdateClassifierInput{}
+
+	if v, ok := d.GetOk("grok_classifier"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		input.GrokClassifier = &glue.UpdateGrokClassifierRequest{
+			Classification: aws.String(m["classification"].(string)),
+			CustomPatterns: aws.String(m["custom_patterns"].(string)),
+			GrokPattern:    aws.String(m["grok_pattern"].(string)),
+			Name:           aws.String(d.Id()),
+		}
+	}
+
+	if v, ok := d.GetOk("json_classifier"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		input.JsonClassifier = &glue.UpdateJsonClassifierRequest{
+			JsonPath: aws.String(m["json_path"].(string)),
+			Name:     aws.String(d.Id()),
+		}
+	}
+
+	if v, ok := d.GetOk("xml_classifier"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		input.XMLClassifier = &glue.UpdateXMLClassifierRequest{
+			Classification: aws.String(m["classification"].(string)),
+			Name:           aws.String(d.Id()),
+			RowTag:         aws.String(m["row_tag"].(string)),
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Glue Classifier: %s", input)
+	_, err := conn.UpdateClassifier(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Glue Classifier (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueClassifierRead(d, meta)
+}
+
+func resourceAwsGlueClassifierDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Classifier: %s", d.Id())
+	_, err := conn.DeleteClassifier(&glue.DeleteClassifierInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Classifier (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenGlueGrokClassifier(grokClassifier *glue.GrokClassifier) []map[string]interface{} {
+	if grokClassifier == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"classification":  aws.StringValue(grokClassifier.Classification),
+		"custom_patterns": aws.StringValue(grokClassifier.CustomPatterns),
+		"grok_pattern":    aws.StringValue(grokClassifier.GrokPattern),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenGlueJsonClassifier(jsonClassifier *glue.JsonClassifier) []map[string]interface{} {
+	if jsonClassifier == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"json_path": aws.StringValue(jsonClassifier.JsonPath),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenGlueXmlClassifier(xmlClassifier *glue.XMLClassifier) []map[string]interface{} {
+	if xmlClassifier == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"classification": aws.StringValue(xmlClassifier.Classification),
+		"row_tag":        aws.StringValue(xmlClassifier.RowTag),
+	}
+
+	return []map[string]interface{}{m}
+}
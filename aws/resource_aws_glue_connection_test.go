@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueConnection_basic(t *testing.T) {
+	var connection glue.Connection
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_connection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueConnectionConfig_basic(rName, "jdbc:mysql://terraformacctesting.com/testdatabase"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.JDBC_CONNECTION_URL", "jdbc:mysql://terraformacctesting.com/testdatabase"),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.PASSWORD", "testpassword"),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.USERNAME", "testusername"),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "JDBC"),
+					resource.TestCheckResourceAttr(resourceName, "match_criteria.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "physical_connection_requirements.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSGlueConnectionConfig_basic(rName, "jdbc:mysql://terraformacctesting.com/testdatabase2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.JDBC_CONNECTION_URL", "jdbc:mysql://terraformacctesting.com/testdatabase2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueConnection_PhysicalConnectionRequirements(t *testing.T) {
+	var connection glue.Connection
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_connection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueConnectionConfig_PhysicalConnectionRequirements(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueConnectionExists(resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "physical_connection_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "physical_connection_requirements.0.security_group_id_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "physical_connection_requirements.0.subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "physical_connection_requirements.0.availability_zone", "aws_subnet.test", "availability_zone"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueConnectionExists(resourceName string, connection *glue.Connection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Connection ID is set")
+		}
+
+		catalogID, connectionName := readAwsGlueCatalogID(rs.Primary.ID)
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		output, err := conn.GetConnection(&glue.GetConnectionInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(connectionName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Connection == nil {
+			return fmt.Errorf("Glue Connection %q not found", rs.Primary.ID)
+		}
+
+		if aws.StringValue(output.Connection.Name) == connectionName {
+			*connection = *output.Connection
+			return nil
+		}
+
+		return fmt.Errorf("Glue Connection %q not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSGlueConnectionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_connection" {
+			continue
+		}
+
+		catalogID, connectionName := readAwsGlueCatalogID(rs.Primary.ID)
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		output, err := conn.GetConnection(&glue.GetConnectionInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(connectionName),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if output.Connection != nil && aws.StringValue(output.Connection.Name) == connectionName {
+			return fmt.Errorf("Glue Connection %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlueConnectionConfig_basic(rName, jdbcConnectionUrl string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_connection" "test" {
+  connection_properties = {
+    JDBC_CONNECTION_URL = "%s"
+    PASSWORD            = "testpassword"
+    USERNAME            = "testusername"
+  }
+
+  name = "%s"
+}
+`, jdbcConnectionUrl, rName)
+}
+
+func testAccAWSGlueConnectionConfig_PhysicalConnectionRequirements(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-glue-connection"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = "us-west-2a"
+  cidr_block        = "10.0.1.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "terraform-testacc-glue-connection"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = "%[1]s"
+  vpc_id = "${aws_vpc.test.id}"
+
+  ingress {
+    from_port = 1
+    protocol  = "tcp"
+    self      = true
+    to_port   = 65535
+  }
+}
+
+resource "aws_glue_connection" "test" {
+  connection_properties = {
+    JDBC_CONNECTION_URL = "jdbc:mysql://terraformacctesting.com/testdatabase"
+    PASSWORD            = "testpassword"
+    USERNAME            = "testusername"
+  }
+
+  name = "%[1]s"
+
+  physical_connection_requirements {
+    availability_zone      = "${aws_subnet.test.availability_zone}"
+    security_group_id_list = ["${aws_security_group.test.id}"]
+    subnet_id              = "${aws_subnet.test.id}"
+  }
+}
+`, rName)
+}
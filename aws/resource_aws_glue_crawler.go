@@ -0,0 +1,397 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueCrawler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCrawlerCreate,
+		Read:   resourceAwsGlueCrawlerRead,
+		Update: resourceAwsGlueCrawlerUpdate,
+		Delete: resourceAwsGlueCrawlerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"classifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schema_change_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				// Glue applies a default policy when none is configured.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "1" && new == "0"
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.DeleteBehaviorDeprecateInDatabase,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.DeleteBehaviorDeleteFromDatabase,
+								glue.DeleteBehaviorDeprecateInDatabase,
+								glue.DeleteBehaviorLog,
+							}, false),
+						},
+						"update_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.UpdateBehaviorUpdateInDatabase,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.UpdateBehaviorLog,
+								glue.UpdateBehaviorUpdateInDatabase,
+							}, false),
+						},
+					},
+				},
+			},
+			"table_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"s3_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"jdbc_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				ValidateFunc: validateJsonString,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	targets, err := expandGlueCrawlerTargets(d)
+	if err != nil {
+		return err
+	}
+
+	input := &glue.CreateCrawlerInput{
+		DatabaseName:       aws.String(d.Get("database_name").(string)),
+		Name:               aws.String(name),
+		Role:               aws.String(d.Get("role").(string)),
+		SchemaChangePolicy: expandGlueSchemaChangePolicy(d.Get("schema_change_policy").([]interface{})),
+		Targets:            targets,
+	}
+
+	if v, ok := d.GetOk("classifiers"); ok {
+		input.Classifiers = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		input.Configuration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("table_prefix"); ok {
+		input.TablePrefix = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Crawler: %s", input)
+	// IAM roles are eventually consistent, so Glue may not be able to
+	// assume a freshly created role straight away.
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateCrawler(input)
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeInvalidInputException, "Service is unable to assume role") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Glue Crawler (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.GetCrawlerInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Glue Crawler: %s", input)
+	output, err := conn.GetCrawler(input)
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	crawler := output.Crawler
+	if crawler == nil {
+		log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", crawler.Name)
+	d.Set("database_name", crawler.DatabaseName)
+	d.Set("role", crawler.Role)
+	d.Set("configuration", crawler.Configuration)
+	d.Set("description", crawler.Description)
+	d.Set("table_prefix", crawler.TablePrefix)
+
+	d.Set("schedule", "")
+	if crawler.Schedule != nil {
+		d.Set("schedule", crawler.Schedule.ScheduleExpression)
+	}
+
+	if err := d.Set("classifiers", flattenStringList(crawler.Classifiers)); err != nil {
+		return fmt.Errorf("error setting classifiers: %s", err)
+	}
+
+	if err := d.Set("schema_change_policy", flattenGlueSchemaChangePolicy(crawler.SchemaChangePolicy)); err != nil {
+		return fmt.Errorf("error setting schema_change_policy: %s", err)
+	}
+
+	if crawler.Targets != nil {
+		if err := d.Set("s3_target", flattenGlueS3Targets(crawler.Targets.S3Targets)); err != nil {
+			return fmt.Errorf("error setting s3_target: %s", err)
+		}
+
+		if err := d.Set("jdbc_target", flattenGlueJdbcTargets(crawler.Targets.JdbcTargets)); err != nil {
+			return fmt.Errorf("error setting jdbc_target: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	targets, err := expandGlueCrawlerTargets(d)
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdateCrawlerInput{
+		Classifiers:        expandStringList(d.Get("classifiers").([]interface{})),
+		DatabaseName:       aws.String(d.Get("database_name").(string)),
+		Description:        aws.String(d.Get("description").(string)),
+		Name:               aws.String(d.Id()),
+		Role:               aws.String(d.Get("role").(string)),
+		Schedule:           aws.String(d.Get("schedule").(string)),
+		SchemaChangePolicy: expandGlueSchemaChangePolicy(d.Get("schema_change_policy").([]interface{})),
+		TablePrefix:        aws.String(d.Get("table_prefix").(string)),
+		Targets:            targets,
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		input.Configuration = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Glue Crawler: %s", input)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.UpdateCrawler(input)
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeInvalidInputException, "Service is unable to assume role") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Crawler: %s", d.Id())
+	_, err := conn.DeleteCrawler(&glue.DeleteCrawlerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueCrawlerTargets(d *schema.ResourceData) (*glue.CrawlerTargets, error) {
+	targets := &glue.CrawlerTargets{}
+
+	for _, v := range d.Get("s3_target").([]interface{}) {
+		m := v.(map[string]interface{})
+		targets.S3Targets = append(targets.S3Targets, &glue.S3Target{
+			Exclusions: expandStringList(m["exclusions"].([]interface{})),
+			Path:       aws.String(m["path"].(string)),
+		})
+	}
+
+	for _, v := range d.Get("jdbc_target").([]interface{}) {
+		m := v.(map[string]interface{})
+		targets.JdbcTargets = append(targets.JdbcTargets, &glue.JdbcTarget{
+			ConnectionName: aws.String(m["connection_name"].(string)),
+			Exclusions:     expandStringList(m["exclusions"].([]interface{})),
+			Path:           aws.String(m["path"].(string)),
+		})
+	}
+
+	if len(targets.S3Targets) == 0 && len(targets.JdbcTargets) == 0 {
+		return nil, fmt.Errorf("At least one of s3_target or jdbc_target must be configured")
+	}
+
+	return targets, nil
+}
+
+func expandGlueSchemaChangePolicy(l []interface{}) *glue.SchemaChangePolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &glue.SchemaChangePolicy{
+		DeleteBehavior: aws.String(m["delete_behavior"].(string)),
+		UpdateBehavior: aws.String(m["update_behavior"].(string)),
+	}
+}
+
+func flattenGlueSchemaChangePolicy(schemaChangePolicy *glue.SchemaChangePolicy) []map[string]interface{} {
+	if schemaChangePolicy == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"delete_behavior": aws.StringValue(schemaChangePolicy.DeleteBehavior),
+		"update_behavior": aws.StringValue(schemaChangePolicy.UpdateBehavior),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenGlueS3Targets(s3Targets []*glue.S3Target) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(s3Targets))
+
+	for _, s3Target := range s3Targets {
+		result = append(result, map[string]interface{}{
+			"exclusions": flattenStringList(s3Target.Exclusions),
+			"path":       aws.StringValue(s3Target.Path),
+		})
+	}
+
+	return result
+}
+
+func flattenGlueJdbcTargets(jdbcTargets []*glue.JdbcTarget) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(jdbcTargets))
+
+	for _, jdbcTarget := range jdbcTargets {
+		result = append(result, map[string]interface{}{
+			"connection_name": aws.StringValue(jdbcTarget.ConnectionName),
+			"exclusions":      flattenStringList(jdbcTarget.Exclusions),
+			"path":            aws.StringValue(jdbcTarget.Path),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueCrawler_S3Target(t *testing.T) {
+	var crawler glue.Crawler
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_crawler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueCrawlerConfig_S3Target(rName, "s3://bucket1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "classifiers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration", ""),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.exclusions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.path", "s3://bucket1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", ""),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "DEPRECATE_IN_DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.update_behavior", "UPDATE_IN_DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "table_prefix", ""),
+				),
+			},
+			{
+				Config: testAccAWSGlueCrawlerConfig_S3Target(rName, "s3://bucket2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.path", "s3://bucket2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCrawler_JdbcTarget(t *testing.T) {
+	var crawler glue.Crawler
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_crawler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueCrawlerConfig_JdbcTarget(rName, "database-name/%"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.exclusions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.path", "database-name/%"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSGlueCrawlerConfig_JdbcTarget(rName, "database-name/table-name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.path", "database-name/table-name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCrawler_SchemaChangePolicy(t *testing.T) {
+	var crawler glue.Crawler
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_glue_crawler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueCrawlerConfig_SchemaChangePolicy(rName, "DELETE_FROM_DATABASE", "UPDATE_IN_DATABASE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "DELETE_FROM_DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.update_behavior", "UPDATE_IN_DATABASE"),
+				),
+			},
+			{
+				Config: testAccAWSGlueCrawlerConfig_SchemaChangePolicy(rName, "LOG", "LOG"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "LOG"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.update_behavior", "LOG"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueCrawlerExists(resourceName string, crawler *glue.Crawler) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Crawler ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Crawler == nil || aws.StringValue(output.Crawler.Name) != rs.Primary.ID {
+			return fmt.Errorf("Glue Crawler %q not found", rs.Primary.ID)
+		}
+
+		*crawler = *output.Crawler
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueCrawlerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_crawler" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if output.Crawler != nil && aws.StringValue(output.Crawler.Name) == rs.Primary.ID {
+			return fmt.Errorf("Glue Crawler %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlueCrawlerConfig_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_catalog_database" "test" {
+  name = "%s"
+}
+`, testAccAWSGlueConfig_role(rName), rName)
+}
+
+func testAccAWSGlueCrawlerConfig_S3Target(rName, path string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%s"
+  role          = "${aws_iam_role.test.name}"
+
+  s3_target {
+    path = "%s"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueCrawlerConfig_base(rName), rName, path)
+}
+
+func testAccAWSGlueCrawlerConfig_JdbcTarget(rName, path string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_glue_connection" "test" {
+  name = "%[2]s"
+
+  connection_properties = {
+    JDBC_CONNECTION_URL = "jdbc:mysql://terraformacctesting.com/testdatabase"
+    PASSWORD            = "testpassword"
+    USERNAME            = "testusername"
+  }
+}
+
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%[2]s"
+  role          = "${aws_iam_role.test.name}"
+
+  jdbc_target {
+    connection_name = "${aws_glue_connection.test.name}"
+    path            = "%[3]s"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueCrawlerConfig_base(rName), rName, path)
+}
+
+func testAccAWSGlueCrawlerConfig_SchemaChangePolicy(rName, deleteBehavior, updateBehavior string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%s"
+  role          = "${aws_iam_role.test.name}"
+
+  schema_change_policy {
+    delete_behavior = "%s"
+    update_behavior = "%s"
+  }
+
+  s3_target {
+    path = "s3://bucket-name"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueCrawlerConfig_base(rName), rName, deleteBehavior, updateBehavior)
+}
@@ -19,6 +19,9 @@ func resourceAwsIamAccessKey() *schema.Resource {
 		Create: resourceAwsIamAccessKeyCreate,
 		Read:   resourceAwsIamAccessKeyRead,
 		Delete: resourceAwsIamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamAccessKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
@@ -56,6 +59,24 @@ func resourceAwsIamAccessKey() *schema.Resource {
 	}
 }
 
+func resourceAwsIamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	resp, err := iamconn.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM access key %s: %s", d.Id(), err)
+	}
+	if resp.UserName == nil {
+		return nil, fmt.Errorf("IAM access key %s is not owned by an IAM user", d.Id())
+	}
+
+	d.Set("user", resp.UserName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
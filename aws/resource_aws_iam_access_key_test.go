@@ -32,6 +32,12 @@ func TestAccAWSAccessKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_iam_access_key.a_key", "secret"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "aws_iam_access_key.a_key",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "ses_smtp_password"},
+			},
 		},
 	})
 }
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +16,9 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamGroupMembershipRead,
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -39,6 +43,19 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 	}
 }
 
+func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAME/GROUP", d.Id())
+	}
+
+	d.Set("name", idParts[0])
+	d.Set("group", idParts[1])
+	d.SetId(idParts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
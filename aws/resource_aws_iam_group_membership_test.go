@@ -51,6 +51,12 @@ func TestAccAWSGroupMembership_basic(t *testing.T) {
 					testAccCheckAWSGroupMembershipAttributes(&group, groupName, []string{userName3}),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_iam_group_membership.team",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_iam_group_membership.team", "name", "group"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
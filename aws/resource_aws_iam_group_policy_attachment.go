@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamGroupPolicyAttachmentCreate,
 		Read:   resourceAwsIamGroupPolicyAttachmentRead,
 		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group": &schema.Schema{
@@ -32,6 +36,19 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsIamGroupPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected GROUP/POLICY-ARN", d.Id())
+	}
+
+	d.Set("group", idParts[0])
+	d.Set("policy_arn", idParts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", idParts[0])))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
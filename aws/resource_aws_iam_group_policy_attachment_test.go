@@ -40,6 +40,12 @@ func TestAccAWSIAMGroupPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSGroupPolicyAttachmentAttributes([]string{policyName2, policyName3}, &out),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_iam_group_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_iam_group_policy_attachment.test-attach", "group", "policy_arn"),
+				ImportStateCheck:  testAccCheckAWSImportStateAttributesSet("group", "policy_arn"),
+			},
 		},
 	})
 }
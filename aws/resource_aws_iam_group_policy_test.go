@@ -37,6 +37,11 @@ func TestAccAWSIAMGroupPolicy_basic(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_group_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
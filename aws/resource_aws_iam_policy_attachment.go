@@ -20,6 +20,9 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamPolicyAttachmentRead,
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -55,6 +58,19 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsIamPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAME/POLICY-ARN", d.Id())
+	}
+
+	d.Set("name", idParts[0])
+	d.Set("policy_arn", idParts[1])
+	d.SetId(idParts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
@@ -50,6 +50,12 @@ func TestAccAWSIAMPolicyAttachment_basic(t *testing.T) {
 						[]string{roleName2, roleName3}, []string{groupName2, groupName3}, &out),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_iam_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_iam_policy_attachment.test-attach", "name", "policy_arn"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
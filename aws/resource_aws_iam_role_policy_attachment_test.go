@@ -38,6 +38,12 @@ func TestAccAWSRolePolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSRolePolicyAttachmentAttributes([]string{testPolicy2, testPolicy3}, &out),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_role_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_iam_role_policy_attachment.test-attach", "role", "policy_arn"),
+				ImportStateCheck:  testAccCheckAWSImportStateAttributesSet("role", "policy_arn"),
+			},
 		},
 	})
 }
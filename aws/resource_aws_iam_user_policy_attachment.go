@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamUserPolicyAttachmentCreate,
 		Read:   resourceAwsIamUserPolicyAttachmentRead,
 		Delete: resourceAwsIamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
@@ -32,6 +36,19 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsIamUserPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USER/POLICY-ARN", d.Id())
+	}
+
+	d.Set("user", idParts[0])
+	d.Set("policy_arn", idParts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", idParts[0])))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
@@ -38,6 +38,12 @@ func TestAccAWSUserPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSUserPolicyAttachmentAttributes([]string{policyName2, policyName3}, &out),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_user_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_iam_user_policy_attachment.test-attach", "user", "policy_arn"),
+				ImportStateCheck:  testAccCheckAWSImportStateAttributesSet("user", "policy_arn"),
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -18,6 +19,9 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 		Read:   resourceAwsIamUserSshKeyRead,
 		Update: resourceAwsIamUserSshKeyUpdate,
 		Delete: resourceAwsIamUserSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserSshKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ssh_public_key_id": &schema.Schema{
@@ -56,6 +60,30 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 	}
 }
 
+func resourceAwsIamUserSshKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USERNAME/SSH-PUBLIC-KEY-ID/ENCODING", d.Id())
+	}
+
+	iamconn := meta.(*AWSClient).iamconn
+	getResp, err := iamconn.GetSSHPublicKey(&iam.GetSSHPublicKeyInput{
+		UserName:       aws.String(idParts[0]),
+		SSHPublicKeyId: aws.String(idParts[1]),
+		Encoding:       aws.String(idParts[2]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM User SSH Key %s: %s", idParts[1], err)
+	}
+
+	d.Set("username", idParts[0])
+	d.Set("encoding", idParts[2])
+	d.Set("public_key", getResp.SSHPublicKey.SSHPublicKeyBody)
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamUserSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 	username := d.Get("username").(string)
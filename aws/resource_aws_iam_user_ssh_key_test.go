@@ -29,6 +29,13 @@ func TestAccAWSUserSSHKey_basic(t *testing.T) {
 					testAccCheckAWSUserSSHKeyExists("aws_iam_user_ssh_key.user", "Inactive", &conf),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "aws_iam_user_ssh_key.user",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSImportStateIdFunc("aws_iam_user_ssh_key.user", "username", "ssh_public_key_id", "encoding"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_key"},
+			},
 		},
 	})
 }
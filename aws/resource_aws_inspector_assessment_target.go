@@ -17,6 +17,9 @@ func resourceAWSInspectorAssessmentTarget() *schema.Resource {
 		Read:   resourceAwsInspectorAssessmentTargetRead,
 		Update: resourceAwsInspectorAssessmentTargetUpdate,
 		Delete: resourceAwsInspectorAssessmentTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -76,7 +79,9 @@ func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta inter
 	}
 
 	if resp.AssessmentTargets != nil && len(resp.AssessmentTargets) > 0 {
+		d.Set("arn", resp.AssessmentTargets[0].Arn)
 		d.Set("name", resp.AssessmentTargets[0].Name)
+		d.Set("resource_group_arn", resp.AssessmentTargets[0].ResourceGroupArn)
 	}
 
 	return nil
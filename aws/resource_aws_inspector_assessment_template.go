@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,9 @@ func resourceAWSInspectorAssessmentTemplate() *schema.Resource {
 		Create: resourceAwsInspectorAssessmentTemplateCreate,
 		Read:   resourceAwsInspectorAssessmentTemplateRead,
 		Delete: resourceAwsInspectorAssessmentTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -96,7 +100,14 @@ func resourceAwsInspectorAssessmentTemplateRead(d *schema.ResourceData, meta int
 	}
 
 	if resp.AssessmentTemplates != nil && len(resp.AssessmentTemplates) > 0 {
-		d.Set("name", resp.AssessmentTemplates[0].Name)
+		template := resp.AssessmentTemplates[0]
+		d.Set("arn", template.Arn)
+		d.Set("name", template.Name)
+		d.Set("target_arn", template.AssessmentTargetArn)
+		d.Set("duration", template.DurationInSeconds)
+		if err := d.Set("rules_package_arns", flattenStringList(template.RulesPackageArns)); err != nil {
+			return fmt.Errorf("error setting rules_package_arns: %s", err)
+		}
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,9 @@ func resourceAWSInspectorResourceGroup() *schema.Resource {
 		Create: resourceAwsInspectorResourceGroupCreate,
 		Read:   resourceAwsInspectorResourceGroupRead,
 		Delete: resourceAwsInspectorResourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"tags": &schema.Schema{
@@ -50,7 +54,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).inspectorconn
 
-	_, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
+	resp, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
 		ResourceGroupArns: []*string{
 			aws.String(d.Id()),
 		},
@@ -65,6 +69,14 @@ func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if len(resp.ResourceGroups) > 0 {
+		d.Set("arn", resp.ResourceGroups[0].Arn)
+		tags := keyValueTagsInspector(resp.ResourceGroups[0].Tags).IgnoreAws().IgnoreConfig(providerIgnoreTags(meta))
+		if err := d.Set("tags", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -25,6 +25,11 @@ func TestAccAWSInspectorResourceGroup_basic(t *testing.T) {
 					testAccCheckAWSInspectorTargetExists("aws_inspector_resource_group.foo"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_inspector_resource_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -41,7 +41,8 @@ func resourceAwsInstance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -68,7 +69,40 @@ func resourceAwsInstance() *schema.Resource {
 
 			"instance_type": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+
+			"launch_template": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "$Default",
+							ValidateFunc: validateLaunchTemplateVersion,
+						},
+					},
+				},
 			},
 
 			"key_name": {
@@ -477,6 +511,18 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		UserData:                          instanceOpts.UserData64,
 	}
 
+	if v, ok := d.GetOk("launch_template"); ok {
+		runOpts.LaunchTemplate = expandEc2LaunchTemplateSpecification(v.([]interface{}))
+		clearUnconfiguredInstanceOpts(d, runOpts)
+	} else {
+		if runOpts.ImageId == nil {
+			return fmt.Errorf("`ami` must be set unless a `launch_template` is used")
+		}
+		if runOpts.InstanceType == nil {
+			return fmt.Errorf("`instance_type` must be set unless a `launch_template` is used")
+		}
+	}
+
 	_, ipv6CountOk := d.GetOk("ipv6_address_count")
 	_, ipv6AddressOk := d.GetOk("ipv6_addresses")
 
@@ -488,7 +534,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	if !restricted {
 		tagsSpec := make([]*ec2.TagSpecification, 0)
 
-		if v, ok := d.GetOk("tags"); ok {
+		if v, ok := d.GetOk("tags_all"); ok {
 			tags := tagsFromMap(v.(map[string]interface{}))
 
 			spec := &ec2.TagSpecification{
@@ -643,6 +689,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("ami", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
+	if err := d.Set("launch_template", flattenInstanceLaunchTemplate(d, instance.Tags)); err != nil {
+		return fmt.Errorf("error setting launch_template: %s", err)
+	}
 	d.Set("key_name", instance.KeyName)
 	d.Set("public_dns", instance.PublicDnsName)
 	d.Set("public_ip", instance.PublicIpAddress)
@@ -736,7 +785,7 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("tags", tagsToMap(instance.Tags))
 
-	if err := readVolumeTags(conn, d); err != nil {
+	if err := readVolumeTags(conn, d, providerIgnoreTags(meta)); err != nil {
 		return err
 	}
 
@@ -805,7 +854,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !d.IsNewResource() || restricted {
 			if err := setTags(conn, d); err != nil {
 				return err
@@ -1488,7 +1537,7 @@ func readBlockDeviceMappingsFromConfig(
 	return blockDevices, nil
 }
 
-func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData, ignoreTags *ignoreTagsConfig) error {
 	volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 	if err != nil {
 		return err
@@ -1516,7 +1565,7 @@ func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		tags = append(tags, tag)
 	}
 
-	d.Set("volume_tags", tagsToMap(tags))
+	d.Set("volume_tags", keyValueTagsEC2(tags).IgnoreAws().IgnoreConfig(ignoreTags).Map())
 
 	return nil
 }
@@ -1640,8 +1689,13 @@ func buildAwsInstanceOpts(
 	opts := &awsInstanceOpts{
 		DisableAPITermination: aws.Bool(d.Get("disable_api_termination").(bool)),
 		EBSOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
-		ImageID:               aws.String(d.Get("ami").(string)),
-		InstanceType:          aws.String(d.Get("instance_type").(string)),
+	}
+
+	if v := d.Get("ami").(string); v != "" {
+		opts.ImageID = aws.String(v)
+	}
+	if v := d.Get("instance_type").(string); v != "" {
+		opts.InstanceType = aws.String(v)
 	}
 
 	if v := d.Get("instance_initiated_shutdown_behavior").(string); v != "" {
@@ -1833,3 +1887,77 @@ func getAwsInstanceVolumeIds(conn *ec2.EC2, d *schema.ResourceData) ([]*string,
 
 	return volumeIds, nil
 }
+
+func expandEc2LaunchTemplateSpecification(l []interface{}) *ec2.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	spec := &ec2.LaunchTemplateSpecification{}
+
+	if v, ok := m["id"].(string); ok && v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	} else if v, ok := m["name"].(string); ok && v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+	if v, ok := m["version"].(string); ok && v != "" {
+		spec.Version = aws.String(v)
+	}
+
+	return spec
+}
+
+// clearUnconfiguredInstanceOpts removes the launch parameters that are not
+// set in the configuration, so that those of the launch template apply.
+// Parameters given to RunInstances take precedence over the template.
+func clearUnconfiguredInstanceOpts(d *schema.ResourceData, runOpts *ec2.RunInstancesInput) {
+	if _, ok := d.GetOk("disable_api_termination"); !ok {
+		runOpts.DisableApiTermination = nil
+	}
+	if _, ok := d.GetOk("ebs_optimized"); !ok {
+		runOpts.EbsOptimized = nil
+	}
+	if _, ok := d.GetOk("monitoring"); !ok {
+		runOpts.Monitoring = nil
+	}
+	if _, ok := d.GetOk("iam_instance_profile"); !ok {
+		runOpts.IamInstanceProfile = nil
+	}
+
+	_, azOk := d.GetOk("availability_zone")
+	_, groupOk := d.GetOk("placement_group")
+	_, tenancyOk := d.GetOk("tenancy")
+	if !azOk && !groupOk && !tenancyOk {
+		runOpts.Placement = nil
+	}
+}
+
+// flattenInstanceLaunchTemplate returns the launch template an instance was
+// launched from, as recorded in the tags EC2 adds to the instance. A
+// configured "$Latest" or "$Default" version is kept as is.
+func flattenInstanceLaunchTemplate(d *schema.ResourceData, tags []*ec2.Tag) []interface{} {
+	var id, version string
+	for _, tag := range tags {
+		switch aws.StringValue(tag.Key) {
+		case "aws:ec2launchtemplate:id":
+			id = aws.StringValue(tag.Value)
+		case "aws:ec2launchtemplate:version":
+			version = aws.StringValue(tag.Value)
+		}
+	}
+	if id == "" {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"id":      id,
+		"name":    d.Get("launch_template.0.name").(string),
+		"version": version,
+	}
+	if v := d.Get("launch_template.0.version").(string); v == "$Latest" || v == "$Default" {
+		m["version"] = v
+	}
+
+	return []interface{}{m}
+}
@@ -1397,6 +1397,32 @@ func TestAccAWSInstance_getPasswordData_trueToFalse(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_launchTemplate(t *testing.T) {
+	var v ec2.Instance
+	resName := "aws_instance.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_launchTemplate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &v),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttrPair(resName, "ami", "data.aws_ami.test_ami", "id"),
+					resource.TestCheckResourceAttr(resName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resName, "launch_template.0.id", "aws_launch_template.foo", "id"),
+					resource.TestCheckResourceAttr(resName, "launch_template.0.name", fmt.Sprintf("tf-acc-test-%d", rInt)),
+					resource.TestCheckResourceAttr(resName, "launch_template.0.version", "$Default"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceNotRecreated(t *testing.T,
 	before, after *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -2970,3 +2996,33 @@ func testAccInstanceConfig_getPasswordData(val bool, rInt int) string {
 	}
 	`, rInt, val)
 }
+
+func testAccInstanceConfig_launchTemplate(rInt int) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_template" "foo" {
+  name          = "tf-acc-test-%d"
+  image_id      = "${data.aws_ami.test_ami.id}"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "foo" {
+  launch_template {
+    name = "${aws_launch_template.foo.name}"
+  }
+}
+`, rInt)
+}
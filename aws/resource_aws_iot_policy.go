@@ -14,6 +14,9 @@ func resourceAwsIotPolicy() *schema.Resource {
 		Read:   resourceAwsIotPolicyRead,
 		Update: resourceAwsIotPolicyUpdate,
 		Delete: resourceAwsIotPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -68,6 +71,8 @@ func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("arn", out.PolicyArn)
 	d.Set("default_version_id", out.DefaultVersionId)
+	d.Set("name", out.PolicyName)
+	d.Set("policy", out.PolicyDocument)
 
 	return nil
 }
@@ -24,6 +24,9 @@ func resourceAwsKmsGrant() *schema.Resource {
 		Read:   resourceAwsKmsGrantRead,
 		Delete: resourceAwsKmsGrantDelete,
 		Exists: resourceAwsKmsGrantExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -204,6 +207,9 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 
 	// The grant sometimes contains principals that identified by their unique id: "AROAJYCVIVUZIMTXXXXX"
 	// instead of "arn:aws:...", in this case don't update the state file
+	d.Set("key_id", keyId)
+	d.Set("grant_id", grantId)
+
 	if strings.HasPrefix(*grant.GranteePrincipal, "arn:aws") {
 		d.Set("grantee_principal", grant.GranteePrincipal)
 	} else {
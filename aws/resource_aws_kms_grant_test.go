@@ -29,6 +29,12 @@ func TestAWSKmsGrant_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_kms_grant.basic", "key_id"),
 				),
 			},
+			{
+				ResourceName:            "aws_kms_grant.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+			},
 		},
 	})
 }
@@ -92,7 +92,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
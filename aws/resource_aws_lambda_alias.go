@@ -17,6 +17,9 @@ func resourceAwsLambdaAlias() *schema.Resource {
 		Read:   resourceAwsLambdaAliasRead,
 		Update: resourceAwsLambdaAliasUpdate,
 		Delete: resourceAwsLambdaAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLambdaAliasImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"description": &schema.Schema{
@@ -45,6 +48,19 @@ func resourceAwsLambdaAlias() *schema.Resource {
 
 // resourceAwsLambdaAliasCreate maps to:
 // CreateAlias in the API / SDK
+func resourceAwsLambdaAliasImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// arn:PARTITION:lambda:REGION:ACCOUNT:function:FUNCTION-NAME:ALIAS-NAME
+	arnParts := strings.Split(d.Id(), ":")
+	if len(arnParts) != 8 || arnParts[0] != "arn" || arnParts[5] != "function" || arnParts[6] == "" || arnParts[7] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected a Lambda alias ARN", d.Id())
+	}
+
+	d.Set("function_name", strings.Join(arnParts[:7], ":"))
+	d.Set("name", arnParts[7])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLambdaAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lambdaconn
 
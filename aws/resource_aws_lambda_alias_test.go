@@ -36,6 +36,11 @@ func TestAccAWSLambdaAlias_basic(t *testing.T) {
 						regexp.MustCompile(`^arn:aws:lambda:[a-z]+-[a-z]+-[0-9]+:\d{12}:function:`+funcName+`:`+aliasName+`$`)),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_lambda_alias.lambda_alias_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -180,6 +180,12 @@ func TestAccAWSLambdaPermission_basic(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_lambda_permission.allow_cloudwatch", "function_name", funcArnRe),
 				),
 			},
+			{
+				ResourceName:      "aws_lambda_permission.allow_cloudwatch",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_lambda_permission.allow_cloudwatch", "function_name", "statement_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,314 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLaunchTemplate_basic(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "name", fmt.Sprintf("foo_%d", rInt)),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+					resource.TestMatchResourceAttr(resName, "arn", regexp.MustCompile(`^arn:[^:]+:ec2:[^:]+:\d{12}:launch-template/lt-.+`)),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.0.device_name", "/dev/sda1"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.0.ebs.0.volume_size", "15"),
+					resource.TestCheckResourceAttr(resName, "credit_specification.0.cpu_credits", "standard"),
+					resource.TestCheckResourceAttr(resName, "tag_specifications.#", "1"),
+					resource.TestCheckResourceAttr(resName, "tag_specifications.0.tags.Name", "test"),
+					resource.TestCheckResourceAttr(resName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_update(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_update(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.small"),
+					resource.TestCheckResourceAttr(resName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(resName, "network_interfaces.0.associate_public_ip_address", "true"),
+					resource.TestCheckResourceAttr(resName, "network_interfaces.0.ipv4_address_count", "2"),
+					resource.TestCheckResourceAttr(resName, "iam_instance_profile.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_update(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_updateDefault(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "default_version", "3"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "3"),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.medium"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_instanceMarketOptions(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_instanceMarketOptions(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.#", "1"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.market_type", "spot"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.spot_options.0.spot_instance_type", "one-time"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.spot_options.0.block_duration_minutes", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Launch Template ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.LaunchTemplates) != 1 || *resp.LaunchTemplates[0].LaunchTemplateId != rs.Primary.ID {
+			return fmt.Errorf("Launch Template not found")
+		}
+
+		*t = *resp.LaunchTemplates[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_launch_template" {
+			continue
+		}
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err == nil {
+			if len(resp.LaunchTemplates) != 0 && *resp.LaunchTemplates[0].LaunchTemplateId == rs.Primary.ID {
+				return fmt.Errorf("Launch Template still exists")
+			}
+		}
+
+		if !isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLaunchTemplateConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "foo_%d"
+  instance_type = "t2.micro"
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+
+    ebs {
+      volume_size = 15
+    }
+  }
+
+  credit_specification {
+    cpu_credits = "standard"
+  }
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags {
+      Name = "test"
+    }
+  }
+
+  tags {
+    foo = "bar"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSLaunchTemplateConfig_update(rInt int, updateDefault bool) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "test_role_%[1]d"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = "test_profile_%[1]d"
+  role = "${aws_iam_role.test.name}"
+}
+
+resource "aws_launch_template" "foo" {
+  name                   = "foo_%[1]d"
+  instance_type          = "t2.small"
+  update_default_version = %[2]t
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+
+    ebs {
+      volume_size = 15
+    }
+  }
+
+  credit_specification {
+    cpu_credits = "standard"
+  }
+
+  iam_instance_profile {
+    name = "${aws_iam_instance_profile.test.name}"
+  }
+
+  network_interfaces {
+    associate_public_ip_address = true
+    ipv4_address_count          = 2
+  }
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags {
+      Name = "test"
+    }
+  }
+
+  tags {
+    foo = "bar"
+  }
+}
+`, rInt, updateDefault)
+}
+
+func testAccAWSLaunchTemplateConfig_updateDefault(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name                   = "foo_%d"
+  instance_type          = "t2.medium"
+  update_default_version = true
+
+  tags {
+    foo = "bar"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSLaunchTemplateConfig_instanceMarketOptions(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "foo_%d"
+  instance_type = "t2.micro"
+
+  instance_market_options {
+    market_type = "spot"
+
+    spot_options {
+      block_duration_minutes = 60
+      spot_instance_type     = "one-time"
+    }
+  }
+}
+`, rInt)
+}
@@ -211,7 +211,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 	elbOpts := &elbv2.CreateLoadBalancerInput{
 		Name: aws.String(name),
 		Type: aws.String(d.Get("load_balancer_type").(string)),
-		Tags: tagsFromMapELBv2(d.Get("tags").(map[string]interface{})),
+		Tags: tagsFromMapELBv2(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if scheme, ok := d.GetOk("internal"); ok && scheme.(bool) {
@@ -323,7 +323,7 @@ func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
 	if !d.IsNewResource() {
-		if err := setElbV2Tags(elbconn, d); err != nil {
+		if err := setTagsELBv2(elbconn, d); err != nil {
 			return errwrap.Wrapf("Error Modifying Tags on ALB: {{err}}", err)
 		}
 	}
@@ -19,6 +19,9 @@ func resourceAwsLBCookieStickinessPolicy() *schema.Resource {
 		Create: resourceAwsLBCookieStickinessPolicyCreate,
 		Read:   resourceAwsLBCookieStickinessPolicyRead,
 		Delete: resourceAwsLBCookieStickinessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLBCookieStickinessPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -49,6 +52,19 @@ func resourceAwsLBCookieStickinessPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsLBCookieStickinessPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER:LB-PORT:NAME", d.Id())
+	}
+
+	d.Set("load_balancer", idParts[0])
+	d.Set("lb_port", idParts[1])
+	d.Set("name", idParts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLBCookieStickinessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 
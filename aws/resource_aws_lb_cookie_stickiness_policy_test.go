@@ -38,6 +38,11 @@ func TestAccAWSLBCookieStickinessPolicy_basic(t *testing.T) {
 					),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_lb_cookie_stickiness_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
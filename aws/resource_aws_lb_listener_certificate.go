@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -18,6 +19,9 @@ func resourceAwsLbListenerCertificate() *schema.Resource {
 		Create: resourceAwsLbListenerCertificateCreate,
 		Read:   resourceAwsLbListenerCertificateRead,
 		Delete: resourceAwsLbListenerCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLbListenerCertificateImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"listener_arn": {
@@ -34,6 +38,20 @@ func resourceAwsLbListenerCertificate() *schema.Resource {
 	}
 }
 
+func resourceAwsLbListenerCertificateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Load balancer names cannot contain underscores, so the first one
+	// separates the listener ARN from the certificate ARN.
+	idParts := strings.SplitN(d.Id(), "_", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LISTENER-ARN_CERTIFICATE-ARN", d.Id())
+	}
+
+	d.Set("listener_arn", idParts[0])
+	d.Set("certificate_arn", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLbListenerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elbv2conn
 
@@ -20,6 +20,9 @@ func resourceAwsLBSSLNegotiationPolicy() *schema.Resource {
 		Create: resourceAwsLBSSLNegotiationPolicyCreate,
 		Read:   resourceAwsLBSSLNegotiationPolicyRead,
 		Delete: resourceAwsLBSSLNegotiationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLBSSLNegotiationPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -68,6 +71,19 @@ func resourceAwsLBSSLNegotiationPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsLBSSLNegotiationPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER:LB-PORT:NAME", d.Id())
+	}
+
+	d.Set("load_balancer", idParts[0])
+	d.Set("lb_port", idParts[1])
+	d.Set("name", idParts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLBSSLNegotiationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 
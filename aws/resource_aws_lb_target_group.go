@@ -279,7 +279,7 @@ func resourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) erro
 func resourceAwsLbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
-	if err := setElbV2Tags(elbconn, d); err != nil {
+	if err := setTagsELBv2(elbconn, d); err != nil {
 		return errwrap.Wrapf("Error Modifying Tags on LB Target Group: {{err}}", err)
 	}
 
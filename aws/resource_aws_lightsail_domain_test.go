@@ -28,6 +28,11 @@ func TestAccAWSLightsailDomain_basic(t *testing.T) {
 					testAccCheckAWSLightsailDomainExists("aws_lightsail_domain.domain_test", &domain),
 				),
 			},
+			{
+				ResourceName:      "aws_lightsail_domain.domain_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
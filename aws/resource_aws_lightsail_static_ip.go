@@ -14,6 +14,9 @@ func resourceAwsLightsailStaticIp() *schema.Resource {
 		Create: resourceAwsLightsailStaticIpCreate,
 		Read:   resourceAwsLightsailStaticIpRead,
 		Delete: resourceAwsLightsailStaticIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLightsailStaticIpImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -37,6 +40,12 @@ func resourceAwsLightsailStaticIp() *schema.Resource {
 	}
 }
 
+func resourceAwsLightsailStaticIpImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLightsailStaticIpCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
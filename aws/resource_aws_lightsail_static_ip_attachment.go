@@ -14,6 +14,9 @@ func resourceAwsLightsailStaticIpAttachment() *schema.Resource {
 		Create: resourceAwsLightsailStaticIpAttachmentCreate,
 		Read:   resourceAwsLightsailStaticIpAttachmentRead,
 		Delete: resourceAwsLightsailStaticIpAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLightsailStaticIpAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"static_ip_name": {
@@ -30,6 +33,12 @@ func resourceAwsLightsailStaticIpAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsLightsailStaticIpAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("static_ip_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLightsailStaticIpAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
@@ -17,6 +17,9 @@ func resourceAwsLoadBalancerBackendServerPolicies() *schema.Resource {
 		Read:   resourceAwsLoadBalancerBackendServerPoliciesRead,
 		Update: resourceAwsLoadBalancerBackendServerPoliciesCreate,
 		Delete: resourceAwsLoadBalancerBackendServerPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLoadBalancerBackendServerPoliciesImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": &schema.Schema{
@@ -39,6 +42,18 @@ func resourceAwsLoadBalancerBackendServerPolicies() *schema.Resource {
 	}
 }
 
+func resourceAwsLoadBalancerBackendServerPoliciesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:INSTANCE-PORT", d.Id())
+	}
+
+	d.Set("load_balancer_name", idParts[0])
+	d.Set("instance_port", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLoadBalancerBackendServerPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 
@@ -33,6 +33,11 @@ func TestAccAWSLoadBalancerBackendServerPolicy_basic(t *testing.T) {
 					testAccCheckAWSLoadBalancerBackendServerPolicyState(lbName, "test-backend-auth-policy0", true),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_load_balancer_backend_server_policy.test-backend-auth-policies-443",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccAWSLoadBalancerBackendServerPolicyConfig_basic1(lbName),
 				Check: resource.ComposeTestCheckFunc(
@@ -17,6 +17,9 @@ func resourceAwsLoadBalancerListenerPolicies() *schema.Resource {
 		Read:   resourceAwsLoadBalancerListenerPoliciesRead,
 		Update: resourceAwsLoadBalancerListenerPoliciesCreate,
 		Delete: resourceAwsLoadBalancerListenerPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLoadBalancerListenerPoliciesImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": &schema.Schema{
@@ -39,6 +42,18 @@ func resourceAwsLoadBalancerListenerPolicies() *schema.Resource {
 	}
 }
 
+func resourceAwsLoadBalancerListenerPoliciesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:LOAD-BALANCER-PORT", d.Id())
+	}
+
+	d.Set("load_balancer_name", idParts[0])
+	d.Set("load_balancer_port", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLoadBalancerListenerPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 
@@ -30,6 +30,11 @@ func TestAccAWSLoadBalancerListenerPolicy_basic(t *testing.T) {
 					testAccCheckAWSLoadBalancerListenerPolicyState(lbName, int64(80), mcName, true),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_load_balancer_listener_policy.test-lb-listener-policies-80",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccAWSLoadBalancerListenerPolicyConfig_basic1(lbName, mcName),
 				Check: resource.ComposeTestCheckFunc(
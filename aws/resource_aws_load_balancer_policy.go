@@ -16,6 +16,9 @@ func resourceAwsLoadBalancerPolicy() *schema.Resource {
 		Read:   resourceAwsLoadBalancerPolicyRead,
 		Update: resourceAwsLoadBalancerPolicyUpdate,
 		Delete: resourceAwsLoadBalancerPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLoadBalancerPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": &schema.Schema{
@@ -57,6 +60,18 @@ func resourceAwsLoadBalancerPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsLoadBalancerPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:POLICY-NAME", d.Id())
+	}
+
+	d.Set("load_balancer_name", idParts[0])
+	d.Set("policy_name", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLoadBalancerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 
@@ -15,6 +15,9 @@ func resourceAwsMainRouteTableAssociation() *schema.Resource {
 		Read:   resourceAwsMainRouteTableAssociationRead,
 		Update: resourceAwsMainRouteTableAssociationUpdate,
 		Delete: resourceAwsMainRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsMainRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -39,6 +42,29 @@ func resourceAwsMainRouteTableAssociation() *schema.Resource {
 	}
 }
 
+func resourceAwsMainRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	// The association is imported using the ID of its VPC.
+	vpcId := d.Id()
+	mainAssociation, err := findMainRouteTableAssociation(conn, vpcId)
+	if err != nil {
+		return nil, err
+	}
+	if mainAssociation == nil {
+		return nil, fmt.Errorf("No main route table association found for VPC %s", vpcId)
+	}
+
+	d.Set("vpc_id", vpcId)
+	d.Set("route_table_id", mainAssociation.RouteTableId)
+	// The table created along with the VPC is not known any more, so the
+	// current main route table is kept as the main one on destroy.
+	d.Set("original_route_table_id", mainAssociation.RouteTableId)
+	d.SetId(aws.StringValue(mainAssociation.RouteTableAssociationId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsMainRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	vpcId := d.Get("vpc_id").(string)
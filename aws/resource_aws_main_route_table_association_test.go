@@ -25,6 +25,13 @@ func TestAccAWSMainRouteTableAssociation_basic(t *testing.T) {
 					),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "aws_main_route_table_association.foo",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSImportStateIdFunc("aws_main_route_table_association.foo", "vpc_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_route_table_id"},
+			},
 			resource.TestStep{
 				Config: testAccMainRouteTableAssociationConfigUpdate,
 				Check: resource.ComposeTestCheckFunc(
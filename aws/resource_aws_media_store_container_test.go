@@ -23,6 +23,11 @@ func TestAccAWSMediaStoreContainer_basic(t *testing.T) {
 					testAccCheckAwsMediaStoreContainerExists("aws_media_store_container.test"),
 				),
 			},
+			{
+				ResourceName:      "aws_media_store_container.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
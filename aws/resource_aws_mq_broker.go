@@ -21,6 +21,15 @@ func resourceAwsMqBroker() *schema.Resource {
 		Read:   resourceAwsMqBrokerRead,
 		Update: resourceAwsMqBrokerUpdate,
 		Delete: resourceAwsMqBrokerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"apply_immediately": {
@@ -223,7 +232,7 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 			mq.BrokerStateRebootInProgress,
 		},
 		Target:  []string{mq.BrokerStateRunning},
-		Timeout: 30 * time.Minute,
+		Timeout: d.Timeout(schema.TimeoutCreate),
 		Refresh: func() (interface{}, string, error) {
 			out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
 				BrokerId: aws.String(d.Id()),
@@ -348,7 +357,7 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 				mq.BrokerStateRebootInProgress,
 			},
 			Target:  []string{mq.BrokerStateRunning},
-			Timeout: 30 * time.Minute,
+			Timeout: d.Timeout(schema.TimeoutUpdate),
 			Refresh: func() (interface{}, string, error) {
 				out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
 					BrokerId: aws.String(d.Id()),
@@ -380,7 +389,7 @@ func resourceAwsMqBrokerDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return waitForMqBrokerDeletion(conn, d.Id())
+	return waitForMqBrokerDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 }
 
 func resourceAwsMqUserHash(v interface{}) int {
@@ -403,7 +412,7 @@ func resourceAwsMqUserHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func waitForMqBrokerDeletion(conn *mq.MQ, id string) error {
+func waitForMqBrokerDeletion(conn *mq.MQ, id string, timeout time.Duration) error {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{
 			mq.BrokerStateRunning,
@@ -411,7 +420,7 @@ func waitForMqBrokerDeletion(conn *mq.MQ, id string) error {
 			mq.BrokerStateDeletionInProgress,
 		},
 		Target:  []string{""},
-		Timeout: 30 * time.Minute,
+		Timeout: timeout,
 		Refresh: func() (interface{}, string, error) {
 			out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
 				BrokerId: aws.String(id),
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
@@ -102,7 +103,7 @@ func testSweepMqBrokers(region string) error {
 		if err != nil {
 			return err
 		}
-		err = waitForMqBrokerDeletion(conn, *bs.BrokerId)
+		err = waitForMqBrokerDeletion(conn, *bs.BrokerId, 30*time.Minute)
 		if err != nil {
 			return err
 		}
@@ -280,6 +281,12 @@ func TestAccAWSMqBroker_basic(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_mq_broker.test", "instances.0.endpoints.4", regexp.MustCompile(`^wss://[a-z0-9-\.]+:61619$`)),
 				),
 			},
+			{
+				ResourceName:            "aws_mq_broker.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+			},
 		},
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Create: resourceAwsNetworkAclRuleCreate,
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkAclRuleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
@@ -89,6 +93,31 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 	}
 }
 
+func resourceAwsNetworkAclRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NETWORK-ACL-ID:RULE-NUMBER:PROTOCOL:EGRESS", d.Id())
+	}
+
+	networkAclId := idParts[0]
+	ruleNumber, err := strconv.Atoi(idParts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid rule number %q: %s", idParts[1], err)
+	}
+	protocol := idParts[2]
+	egress, err := strconv.ParseBool(idParts[3])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid egress %q: %s", idParts[3], err)
+	}
+
+	d.Set("network_acl_id", networkAclId)
+	d.Set("rule_number", ruleNumber)
+	d.Set("egress", egress)
+	d.SetId(networkAclIdRuleNumberEgressHash(networkAclId, ruleNumber, egress, protocol))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsNetworkAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
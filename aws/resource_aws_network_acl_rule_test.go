@@ -29,6 +29,12 @@ func TestAccAWSNetworkAclRule_basic(t *testing.T) {
 					testAccCheckAWSNetworkAclRuleExists("aws_network_acl_rule.wibble", &networkAcl),
 				),
 			},
+			{
+				ResourceName:      "aws_network_acl_rule.baz",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_network_acl_rule.baz", ":", "network_acl_id", "rule_number", "protocol", "egress"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 		Create: resourceAwsNetworkInterfaceAttachmentCreate,
 		Read:   resourceAwsNetworkInterfaceAttachmentRead,
 		Delete: resourceAwsNetworkInterfaceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkInterfaceAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"device_index": {
@@ -50,6 +53,29 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsNetworkInterfaceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.attachment-id"),
+				Values: []*string{aws.String(d.Id())},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving ENI for attachment %s: %s", d.Id(), err)
+	}
+	if len(resp.NetworkInterfaces) != 1 {
+		return nil, fmt.Errorf("Unable to find ENI for attachment %s", d.Id())
+	}
+
+	d.Set("network_interface_id", resp.NetworkInterfaces[0].NetworkInterfaceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
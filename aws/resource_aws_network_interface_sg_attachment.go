@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -15,6 +16,9 @@ func resourceAwsNetworkInterfaceSGAttachment() *schema.Resource {
 		Create: resourceAwsNetworkInterfaceSGAttachmentCreate,
 		Read:   resourceAwsNetworkInterfaceSGAttachmentRead,
 		Delete: resourceAwsNetworkInterfaceSGAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkInterfaceSGAttachmentImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -30,6 +34,18 @@ func resourceAwsNetworkInterfaceSGAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsNetworkInterfaceSGAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "_")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SECURITY-GROUP-ID_NETWORK-INTERFACE-ID", d.Id())
+	}
+
+	d.Set("security_group_id", idParts[0])
+	d.Set("network_interface_id", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + d.Get("network_interface_id").(string)
 	awsMutexKV.Lock(mk)
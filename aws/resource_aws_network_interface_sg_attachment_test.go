@@ -36,6 +36,11 @@ func TestAccAWSNetworkInterfaceSGAttachment(t *testing.T) {
 						Config: tc.Config(true),
 						Check:  checkSecurityGroupAttached(tc.ResourceAttr, true),
 					},
+					resource.TestStep{
+						ResourceName:      "aws_network_interface_sg_attachment.sg_attachment",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
 					resource.TestStep{
 						Config: tc.Config(false),
 						Check:  checkSecurityGroupAttached(tc.ResourceAttr, false),
@@ -21,6 +21,9 @@ func resourceAwsOpsworksApplication() *schema.Resource {
 		Read:   resourceAwsOpsworksApplicationRead,
 		Update: resourceAwsOpsworksApplicationUpdate,
 		Delete: resourceAwsOpsworksApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -275,6 +278,7 @@ func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}
 	app := resp.Apps[0]
 
 	d.Set("name", app.Name)
+	d.Set("short_name", app.Shortname)
 	d.Set("stack_id", app.StackId)
 	d.Set("type", app.Type)
 	d.Set("description", app.Description)
@@ -136,6 +136,11 @@ func TestAccAWSOpsworksApplication(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_application.tf-acc-app",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +20,9 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 		Update: resourceAwsOpsworksSetPermission,
 		Delete: resourceAwsOpsworksPermissionDelete,
 		Read:   resourceAwsOpsworksPermissionRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allow_ssh": {
@@ -106,6 +111,19 @@ func resourceAwsOpsworksPermissionRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceAwsOpsworksPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected STACK-ID/USER-ARN", d.Id())
+	}
+
+	d.Set("stack_id", idParts[0])
+	d.Set("user_arn", idParts[1])
+	d.SetId(idParts[1] + idParts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsOpsworksSetPermission(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).opsworksconn
 
@@ -88,6 +88,12 @@ func TestAccAWSOpsworksPermission(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_permission.tf-acc-perm",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_opsworks_permission.tf-acc-perm", "stack_id", "user_arn"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
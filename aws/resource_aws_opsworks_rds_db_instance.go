@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 		Update: resourceAwsOpsworksRdsDbInstanceUpdate,
 		Delete: resourceAwsOpsworksRdsDbInstanceDeregister,
 		Read:   resourceAwsOpsworksRdsDbInstanceRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksRdsDbInstanceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
@@ -165,6 +169,19 @@ func resourceAwsOpsworksRdsDbInstanceRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsOpsworksRdsDbInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected STACK-ID/RDS-DB-INSTANCE-ARN", d.Id())
+	}
+
+	d.Set("stack_id", idParts[0])
+	d.Set("rds_db_instance_arn", idParts[1])
+	d.SetId(idParts[1] + idParts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsOpsworksRdsDbInstanceRegister(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).opsworksconn
 
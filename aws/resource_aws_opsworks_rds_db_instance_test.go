@@ -64,6 +64,13 @@ func TestAccAWSOpsworksRdsDbInstance(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:            "aws_opsworks_rds_db_instance.tf-acc-opsworks-db",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSImportStateIdFunc("aws_opsworks_rds_db_instance.tf-acc-opsworks-db", "stack_id", "rds_db_instance_arn"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"db_password"},
+			},
 		},
 	})
 }
@@ -16,6 +16,9 @@ func resourceAwsOpsworksUserProfile() *schema.Resource {
 		Read:   resourceAwsOpsworksUserProfileRead,
 		Update: resourceAwsOpsworksUserProfileUpdate,
 		Delete: resourceAwsOpsworksUserProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_arn": {
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsAccountCreate,
+		Read:   resourceAwsOrganizationsAccountRead,
+		Update: resourceAwsOrganizationsAccountUpdate,
+		Delete: resourceAwsOrganizationsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOrganizationsAccountEmail,
+			},
+			"iam_user_access_to_billing": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					organizations.IAMUserAccessToBillingAllow,
+					organizations.IAMUserAccessToBillingDeny,
+				}, false),
+			},
+			"joined_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"parent_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOrganizationsParentId,
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOrganizationsAccountRoleName,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	createOpts := &organizations.CreateAccountInput{
+		AccountName: aws.String(d.Get("name").(string)),
+		Email:       aws.String(d.Get("email").(string)),
+	}
+
+	if v, ok := d.GetOk("iam_user_access_to_billing"); ok {
+		createOpts.IamUserAccessToBilling = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_name"); ok {
+		createOpts.RoleName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Account: %s", createOpts)
+	resp, err := conn.CreateAccount(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating Organizations Account: %s", err)
+	}
+
+	requestId := aws.StringValue(resp.CreateAccountStatus.Id)
+
+	// Account creation is asynchronous; wait for the request to settle.
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{organizations.CreateAccountStateInProgress},
+		Target:       []string{organizations.CreateAccountStateSucceeded},
+		Refresh:      resourceAwsOrganizationsAccountStateRefreshFunc(conn, requestId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 10 * time.Second,
+	}
+	stateResp, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Organizations Account creation request (%s): %s", requestId, err)
+	}
+
+	accountId := aws.StringValue(stateResp.(*organizations.CreateAccountStatus).AccountId)
+	d.SetId(accountId)
+
+	if v, ok := d.GetOk("parent_id"); ok {
+		newParentId := v.(string)
+
+		existingParentId, err := resourceAwsOrganizationsParentId(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error reading Organizations Account (%s) parent: %s", d.Id(), err)
+		}
+
+		if newParentId != existingParentId {
+			if err := resourceAwsOrganizationsAccountMove(conn, d.Id(), existingParentId, newParentId); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
+func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[INFO] Reading Organizations Account: %s", d.Id())
+	resp, err := conn.DescribeAccount(&organizations.DescribeAccountInput{
+		AccountId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+			log.Printf("[WARN] Organizations Account (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Organizations Account (%s): %s", d.Id(), err)
+	}
+
+	account := resp.Account
+	if account == nil {
+		log.Printf("[WARN] Organizations Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	parentId, err := resourceAwsOrganizationsParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Organizations Account (%s) parent: %s", d.Id(), err)
+	}
+
+	d.Set("arn", account.Arn)
+	d.Set("email", account.Email)
+	d.Set("joined_method", account.JoinedMethod)
+	if account.JoinedTimestamp != nil {
+		d.Set("joined_timestamp", account.JoinedTimestamp.Format(time.RFC3339))
+	}
+	d.Set("name", account.Name)
+	d.Set("parent_id", parentId)
+	d.Set("status", account.Status)
+
+	return nil
+}
+
+func resourceAwsOrganizationsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("parent_id") {
+		o, n := d.GetChange("parent_id")
+		if err := resourceAwsOrganizationsAccountMove(conn, d.Id(), o.(string), n.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
+func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[INFO] Removing Organizations Account from organization: %s", d.Id())
+	_, err := conn.RemoveAccountFromOrganization(&organizations.RemoveAccountFromOrganizationInput{
+		AccountId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing Organizations Account (%s) from organization: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsAccountMove(conn *organizations.Organizations, accountId, sourceParentId, destinationParentId string) error {
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(accountId),
+		DestinationParentId: aws.String(destinationParentId),
+		SourceParentId:      aws.String(sourceParentId),
+	}
+
+	log.Printf("[DEBUG] Moving Organizations Account: %s", input)
+	if _, err := conn.MoveAccount(input); err != nil {
+		return fmt.Errorf("Error moving Organizations Account (%s) to %s: %s", accountId, destinationParentId, err)
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsAccountStateRefreshFunc(conn *organizations.Organizations, requestId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeCreateAccountStatus(&organizations.DescribeCreateAccountStatusInput{
+			CreateAccountRequestId: aws.String(requestId),
+		})
+		if err != nil {
+			if isAWSErr(err, organizations.ErrCodeCreateAccountStatusNotFoundException, "") {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		status := resp.CreateAccountStatus
+		if status == nil {
+			return nil, "", nil
+		}
+
+		state := aws.StringValue(status.State)
+		if state == organizations.CreateAccountStateFailed {
+			return nil, state, fmt.Errorf("%s", aws.StringValue(status.FailureReason))
+		}
+
+		return status, state, nil
+	}
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Accounts created by this test can only be removed from the organization
+// once they have been set up as standalone accounts, so the test runs against
+// an existing organization and is skipped unless an email domain is provided.
+func testAccAwsOrganizationsAccount_basic(t *testing.T) {
+	domain := os.Getenv("ORGANIZATIONS_ACCOUNT_EMAIL_DOMAIN")
+	if domain == "" {
+		t.Skip("Environment variable ORGANIZATIONS_ACCOUNT_EMAIL_DOMAIN is not set")
+	}
+
+	var account organizations.Account
+
+	rInt := acctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, domain)
+	resourceName := "aws_organizations_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsAccountConfig(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_method"),
+					resource.TestCheckResourceAttrSet(resourceName, "joined_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "parent_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_user_access_to_billing", "role_name"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_account" {
+			continue
+		}
+
+		resp, err := conn.DescribeAccount(&organizations.DescribeAccountInput{
+			AccountId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if resp != nil && resp.Account != nil {
+			return fmt.Errorf("Bad: Organizations Account still exists: %q", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsAccountExists(n string, a *organizations.Account) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Organizations Account ID not set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		resp, err := conn.DescribeAccount(&organizations.DescribeAccountInput{
+			AccountId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.Account == nil {
+			return fmt.Errorf("Organizations Account %q does not exist", rs.Primary.ID)
+		}
+
+		*a = *resp.Account
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsAccountConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name  = "%s"
+  email = "%s"
+}
+`, name, email)
+}
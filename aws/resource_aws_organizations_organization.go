@@ -46,6 +46,26 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 					organizations.OrganizationFeatureSetConsolidatedBilling,
 				}, true),
 			},
+			"roots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -88,6 +108,20 @@ func resourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta inter
 	d.Set("master_account_arn", org.Organization.MasterAccountArn)
 	d.Set("master_account_email", org.Organization.MasterAccountEmail)
 	d.Set("master_account_id", org.Organization.MasterAccountId)
+
+	var roots []*organizations.Root
+	err = conn.ListRootsPages(&organizations.ListRootsInput{}, func(page *organizations.ListRootsOutput, lastPage bool) bool {
+		roots = append(roots, page.Roots...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Organization roots: %s", err)
+	}
+
+	if err := d.Set("roots", flattenOrganizationsRoots(roots)); err != nil {
+		return fmt.Errorf("error setting roots: %s", err)
+	}
+
 	return nil
 }
 
@@ -103,3 +137,19 @@ func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func flattenOrganizationsRoots(roots []*organizations.Root) []map[string]interface{} {
+	if len(roots) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(roots))
+	for _, r := range roots {
+		result = append(result, map[string]interface{}{
+			"arn":  aws.StringValue(r.Arn),
+			"id":   aws.StringValue(r.Id),
+			"name": aws.StringValue(r.Name),
+		})
+	}
+	return result
+}
@@ -26,6 +26,8 @@ func testAccAwsOrganizationsOrganization_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "master_account_arn"),
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "master_account_email"),
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "feature_set"),
+					resource.TestCheckResourceAttr("aws_organizations_organization.test", "roots.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "roots.0.id"),
 				),
 			},
 		},
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsOrganizationalUnit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsOrganizationalUnitCreate,
+		Read:   resourceAwsOrganizationsOrganizationalUnitRead,
+		Update: resourceAwsOrganizationsOrganizationalUnitUpdate,
+		Delete: resourceAwsOrganizationsOrganizationalUnitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateOrganizationsParentId,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsOrganizationalUnitCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	createOpts := &organizations.CreateOrganizationalUnitInput{
+		Name:     aws.String(d.Get("name").(string)),
+		ParentId: aws.String(d.Get("parent_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Organizational Unit: %s", createOpts)
+	resp, err := conn.CreateOrganizationalUnit(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating Organizational Unit: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.OrganizationalUnit.Id))
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[INFO] Reading Organizational Unit: %s", d.Id())
+	resp, err := conn.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+			log.Printf("[WARN] Organizational Unit (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	parentId, err := resourceAwsOrganizationsParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Organizational Unit (%s) parent: %s", d.Id(), err)
+	}
+
+	d.Set("arn", resp.OrganizationalUnit.Arn)
+	d.Set("name", resp.OrganizationalUnit.Name)
+	d.Set("parent_id", parentId)
+
+	return nil
+}
+
+func resourceAwsOrganizationsOrganizationalUnitUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("name") {
+		updateOpts := &organizations.UpdateOrganizationalUnitInput{
+			Name:                 aws.String(d.Get("name").(string)),
+			OrganizationalUnitId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Organizational Unit: %s", updateOpts)
+		if _, err := conn.UpdateOrganizationalUnit(updateOpts); err != nil {
+			return fmt.Errorf("Error updating Organizational Unit (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[INFO] Deleting Organizational Unit: %s", d.Id())
+	_, err := conn.DeleteOrganizationalUnit(&organizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsOrganizationsParentId returns the ID of the root or
+// organizational unit that directly contains the given account or OU.
+func resourceAwsOrganizationsParentId(conn *organizations.Organizations, childId string) (string, error) {
+	resp, err := conn.ListParents(&organizations.ListParentsInput{
+		ChildId: aws.String(childId),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// An account or OU can only have a single parent.
+	if len(resp.Parents) != 1 {
+		return "", fmt.Errorf("expected 1 parent for %s, found %d", childId, len(resp.Parents))
+	}
+
+	return aws.StringValue(resp.Parents[0].Id), nil
+}
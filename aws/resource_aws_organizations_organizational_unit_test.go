@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsOrganizationsOrganizationalUnit_basic(t *testing.T) {
+	var unit organizations.OrganizationalUnit
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsOrganizationalUnitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsOrganizationalUnitConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationalUnitExists(resourceName, &unit),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_id", "aws_organizations_organization.test", "roots.0.id"),
+				),
+			},
+			{
+				Config: testAccAwsOrganizationsOrganizationalUnitConfig(rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationalUnitExists(resourceName, &unit),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsOrganizationsOrganizationalUnit_nested(t *testing.T) {
+	var unit organizations.OrganizationalUnit
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_organizational_unit.child"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsOrganizationalUnitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsOrganizationalUnitConfig_nested(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsOrganizationalUnitExists(resourceName, &unit),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_id", "aws_organizations_organizational_unit.parent", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsOrganizationalUnitDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_organizational_unit" {
+			continue
+		}
+
+		resp, err := conn.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+			OrganizationalUnitId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+				continue
+			}
+			if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
+				continue
+			}
+			return err
+		}
+
+		if resp != nil && resp.OrganizationalUnit != nil {
+			return fmt.Errorf("Bad: Organizational Unit still exists: %q", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsOrganizationalUnitExists(n string, ou *organizations.OrganizationalUnit) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Organizational Unit ID not set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		resp, err := conn.DescribeOrganizationalUnit(&organizations.DescribeOrganizationalUnitInput{
+			OrganizationalUnitId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.OrganizationalUnit == nil {
+			return fmt.Errorf("Organizational Unit %q does not exist", rs.Primary.ID)
+		}
+
+		*ou = *resp.OrganizationalUnit
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsOrganizationalUnitConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = "%s"
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+`, name)
+}
+
+func testAccAwsOrganizationsOrganizationalUnitConfig_nested(name string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "parent" {
+  name      = "%[1]s-parent"
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+resource "aws_organizations_organizational_unit" "child" {
+  name      = "%[1]s-child"
+  parent_id = "${aws_organizations_organizational_unit.parent.id}"
+}
+`, name)
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsPolicyCreate,
+		Read:   resourceAwsOrganizationsPolicyRead,
+		Update: resourceAwsOrganizationsPolicyUpdate,
+		Delete: resourceAwsOrganizationsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				ValidateFunc:     validateJsonString,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  organizations.PolicyTypeServiceControlPolicy,
+				ValidateFunc: validation.StringInSlice([]string{
+					organizations.PolicyTypeServiceControlPolicy,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	// Description is required by the API, but may be empty.
+	createOpts := &organizations.CreatePolicyInput{
+		Content:     aws.String(d.Get("content").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+		Type:        aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Policy: %s", createOpts)
+	resp, err := conn.CreatePolicy(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating Organizations Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Policy.PolicySummary.Id))
+
+	return resourceAwsOrganizationsPolicyRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[INFO] Reading Organizations Policy: %s", d.Id())
+	resp, err := conn.DescribePolicy(&organizations.DescribePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			log.Printf("[WARN] Organizations Policy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Organizations Policy (%s): %s", d.Id(), err)
+	}
+
+	if resp.Policy == nil || resp.Policy.PolicySummary == nil {
+		log.Printf("[WARN] Organizations Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resp.Policy.PolicySummary.Arn)
+	d.Set("content", resp.Policy.Content)
+	d.Set("description", resp.Policy.PolicySummary.Description)
+	d.Set("name", resp.Policy.PolicySummary.Name)
+	d.Set("type", resp.Policy.PolicySummary.Type)
+
+	return nil
+}
+
+func resourceAwsOrganizationsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	updateOpts := &organizations.UpdatePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("content") {
+		updateOpts.Content = aws.String(d.Get("content").(string))
+	}
+
+	if d.HasChange("description") {
+		updateOpts.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("name") {
+		updateOpts.Name = aws.String(d.Get("name").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Organizations Policy: %s", updateOpts)
+	if _, err := conn.UpdatePolicy(updateOpts); err != nil {
+		return fmt.Errorf("Error updating Organizations Policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsOrganizationsPolicyRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	log.Printf("[INFO] Deleting Organizations Policy: %s", d.Id())
+	_, err := conn.DeletePolicy(&organizations.DeletePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Organizations Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOrganizationsPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsPolicyAttachmentCreate,
+		Read:   resourceAwsOrganizationsPolicyAttachmentRead,
+		Delete: resourceAwsOrganizationsPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	policyID := d.Get("policy_id").(string)
+	targetID := d.Get("target_id").(string)
+
+	input := &organizations.AttachPolicyInput{
+		PolicyId: aws.String(policyID),
+		TargetId: aws.String(targetID),
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Policy Attachment: %s", input)
+	if _, err := conn.AttachPolicy(input); err != nil {
+		return fmt.Errorf("Error creating Organizations Policy Attachment: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", targetID, policyID))
+
+	return resourceAwsOrganizationsPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsOrganizationsPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.ListTargetsForPolicyInput{
+		PolicyId: aws.String(policyID),
+	}
+
+	log.Printf("[DEBUG] Listing Organizations Policies for Target: %s", input)
+	var target *organizations.PolicyTargetSummary
+	err = conn.ListTargetsForPolicyPages(input, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+		for _, t := range page.Targets {
+			if aws.StringValue(t.TargetId) == targetID {
+				target = t
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			log.Printf("[WARN] Policy (%s) not found, removing Organizations Policy Attachment (%s) from state", policyID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Organizations Policy Attachment (%s): %s", d.Id(), err)
+	}
+
+	if target == nil {
+		log.Printf("[WARN] Target not found, removing Organizations Policy Attachment (%s) from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("policy_id", policyID)
+	d.Set("target_id", targetID)
+
+	return nil
+}
+
+func resourceAwsOrganizationsPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.DetachPolicyInput{
+		PolicyId: aws.String(policyID),
+		TargetId: aws.String(targetID),
+	}
+
+	log.Printf("[DEBUG] Detaching Organizations Policy %q from %q", policyID, targetID)
+	_, err = conn.DetachPolicy(input)
+	if err != nil {
+		if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+			return nil
+		}
+		if isAWSErr(err, organizations.ErrCodeTargetNotFoundException, "") {
+			return nil
+		}
+		if isAWSErr(err, organizations.ErrCodePolicyNotAttachedException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Organizations Policy Attachment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAwsOrganizationsPolicyAttachmentID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected TARGETID:POLICYID", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeAwsOrganizationsPolicyAttachmentID(t *testing.T) {
+	cases := []struct {
+		ID       string
+		TargetID string
+		PolicyID string
+		ErrCount int
+	}{
+		{
+			ID:       "r-abcd:p-12345678",
+			TargetID: "r-abcd",
+			PolicyID: "p-12345678",
+		},
+		{
+			ID:       "123456789012:p-12345678",
+			TargetID: "123456789012",
+			PolicyID: "p-12345678",
+		},
+		{
+			ID:       "p-12345678",
+			ErrCount: 1,
+		},
+		{
+			ID:       "r-abcd:",
+			ErrCount: 1,
+		},
+		{
+			ID:       "r-abcd:p-12345678:extra",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if targetID != tc.TargetID || policyID != tc.PolicyID {
+			t.Fatalf("expected %q to parse as %s/%s, received: %s/%s",
+				tc.ID, tc.TargetID, tc.PolicyID, targetID, policyID)
+		}
+	}
+}
+
+func testAccAwsOrganizationsPolicyAttachment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_policy_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsPolicyAttachmentConfig_base(rName),
+			},
+			{
+				// Service control policies can only be attached once the
+				// policy type has been enabled in the organization root.
+				PreConfig: func() { testAccAwsOrganizationsEnableServiceControlPolicies(t) },
+				Config:    testAccAwsOrganizationsPolicyAttachmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "aws_organizations_policy.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "aws_organizations_organization.test", "roots.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsOrganizationsEnableServiceControlPolicies(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	resp, err := conn.ListRoots(&organizations.ListRootsInput{})
+	if err != nil {
+		t.Fatalf("error listing Organization roots: %s", err)
+	}
+	if len(resp.Roots) != 1 {
+		t.Fatalf("expected 1 Organization root, found %d", len(resp.Roots))
+	}
+
+	_, err = conn.EnablePolicyType(&organizations.EnablePolicyTypeInput{
+		PolicyType: aws.String(organizations.PolicyTypeServiceControlPolicy),
+		RootId:     resp.Roots[0].Id,
+	})
+	if err != nil && !isAWSErr(err, organizations.ErrCodePolicyTypeAlreadyEnabledException, "") {
+		t.Fatalf("error enabling service control policies: %s", err)
+	}
+}
+
+func testAccCheckAwsOrganizationsPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_policy_attachment" {
+			continue
+		}
+
+		targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		err = conn.ListTargetsForPolicyPages(&organizations.ListTargetsForPolicyInput{
+			PolicyId: aws.String(policyID),
+		}, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+			for _, target := range page.Targets {
+				if aws.StringValue(target.TargetId) == targetID {
+					found = true
+					return false
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+				continue
+			}
+			if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
+				continue
+			}
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Bad: Organizations Policy Attachment still exists: %q", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsPolicyAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Organizations Policy Attachment ID not set")
+		}
+
+		targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		found := false
+		err = conn.ListTargetsForPolicyPages(&organizations.ListTargetsForPolicyInput{
+			PolicyId: aws.String(policyID),
+		}, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+			for _, target := range page.Targets {
+				if aws.StringValue(target.TargetId) == targetID {
+					found = true
+					return false
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Organizations Policy Attachment %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsPolicyAttachmentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_policy" "test" {
+  content = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*"
+  }
+}
+EOF
+
+  name = "%s"
+
+  depends_on = ["aws_organizations_organization.test"]
+}
+`, rName)
+}
+
+func testAccAwsOrganizationsPolicyAttachmentConfig(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = "${aws_organizations_policy.test.id}"
+  target_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+`, testAccAwsOrganizationsPolicyAttachmentConfig_base(rName))
+}
@@ -11,6 +11,20 @@ func TestAccAWSOrganizations(t *testing.T) {
 			"importBasic":         testAccAwsOrganizationsOrganization_importBasic,
 			"consolidatedBilling": testAccAwsOrganizationsOrganization_consolidatedBilling,
 		},
+		"Account": {
+			"basic": testAccAwsOrganizationsAccount_basic,
+		},
+		"OrganizationalUnit": {
+			"basic":  testAccAwsOrganizationsOrganizationalUnit_basic,
+			"nested": testAccAwsOrganizationsOrganizationalUnit_nested,
+		},
+		"Policy": {
+			"basic":       testAccAwsOrganizationsPolicy_basic,
+			"description": testAccAwsOrganizationsPolicy_description,
+		},
+		"PolicyAttachment": {
+			"basic": testAccAwsOrganizationsPolicyAttachment_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -17,6 +17,9 @@ func resourceAwsProxyProtocolPolicy() *schema.Resource {
 		Read:   resourceAwsProxyProtocolPolicyRead,
 		Update: resourceAwsProxyProtocolPolicyUpdate,
 		Delete: resourceAwsProxyProtocolPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsProxyProtocolPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer": &schema.Schema{
@@ -34,6 +37,17 @@ func resourceAwsProxyProtocolPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsProxyProtocolPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER:POLICY-NAME", d.Id())
+	}
+
+	d.Set("load_balancer", idParts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsProxyProtocolPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 	elbname := aws.String(d.Get("load_balancer").(string))
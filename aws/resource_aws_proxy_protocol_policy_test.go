@@ -42,6 +42,11 @@ func TestAccAWSProxyProtocolPolicy_basic(t *testing.T) {
 						"aws_proxy_protocol_policy.smtp", "instance_ports.1925441437", "587"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_proxy_protocol_policy.smtp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -27,6 +28,9 @@ func resourceAwsRoute() *schema.Resource {
 		Update: resourceAwsRouteUpdate,
 		Delete: resourceAwsRouteDelete,
 		Exists: resourceAwsRouteExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -108,6 +112,29 @@ func resourceAwsRoute() *schema.Resource {
 	}
 }
 
+func resourceAwsRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "_", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ROUTE-TABLE-ID_DESTINATION", d.Id())
+	}
+
+	routeTableId := idParts[0]
+	destination := idParts[1]
+	d.Set("route_table_id", routeTableId)
+
+	route := &ec2.Route{}
+	if strings.Contains(destination, ":") {
+		d.Set("destination_ipv6_cidr_block", destination)
+		route.DestinationIpv6CidrBlock = aws.String(destination)
+	} else {
+		d.Set("destination_cidr_block", destination)
+		route.DestinationCidrBlock = aws.String(destination)
+	}
+	d.SetId(routeIDHash(d, route))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	var numTargets int
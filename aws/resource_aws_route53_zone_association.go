@@ -20,6 +20,9 @@ func resourceAwsRoute53ZoneAssociation() *schema.Resource {
 		Read:   resourceAwsRoute53ZoneAssociationRead,
 		Update: resourceAwsRoute53ZoneAssociationUpdate,
 		Delete: resourceAwsRoute53ZoneAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRoute53ZoneAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"zone_id": &schema.Schema{
@@ -41,6 +44,18 @@ func resourceAwsRoute53ZoneAssociation() *schema.Resource {
 	}
 }
 
+func resourceAwsRoute53ZoneAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ZONE-ID:VPC-ID", d.Id())
+	}
+
+	d.Set("zone_id", idParts[0])
+	d.Set("vpc_id", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsRoute53ZoneAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	r53 := meta.(*AWSClient).r53conn
 
@@ -105,6 +120,9 @@ func resourceAwsRoute53ZoneAssociationRead(d *schema.ResourceData, meta interfac
 	for _, vpc := range zone.VPCs {
 		if vpc_id == *vpc.VPCId {
 			// association is there, return
+			d.Set("zone_id", zone_id)
+			d.Set("vpc_id", vpc_id)
+			d.Set("vpc_region", vpc.VPCRegion)
 			return nil
 		}
 	}
@@ -26,6 +26,11 @@ func TestAccAWSRoute53ZoneAssociation_basic(t *testing.T) {
 					testAccCheckRoute53ZoneAssociationExists("aws_route53_zone_association.foobar", &zone),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_route53_zone_association.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
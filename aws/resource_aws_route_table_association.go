@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsRouteTableAssociation() *schema.Resource {
 		Read:   resourceAwsRouteTableAssociationRead,
 		Update: resourceAwsRouteTableAssociationUpdate,
 		Delete: resourceAwsRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"subnet_id": &schema.Schema{
@@ -34,6 +38,38 @@ func resourceAwsRouteTableAssociation() *schema.Resource {
 	}
 }
 
+func resourceAwsRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SUBNET-ID/ROUTE-TABLE-ID", d.Id())
+	}
+
+	subnetId := idParts[0]
+	routeTableId := idParts[1]
+
+	conn := meta.(*AWSClient).ec2conn
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, routeTableId)()
+	if err != nil {
+		return nil, err
+	}
+	if rtRaw == nil {
+		return nil, fmt.Errorf("Route table %s not found", routeTableId)
+	}
+	rt := rtRaw.(*ec2.RouteTable)
+
+	for _, a := range rt.Associations {
+		if aws.StringValue(a.SubnetId) == subnetId {
+			d.Set("subnet_id", subnetId)
+			d.Set("route_table_id", routeTableId)
+			d.SetId(aws.StringValue(a.RouteTableAssociationId))
+
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Subnet %s is not associated with route table %s", subnetId, routeTableId)
+}
+
 func resourceAwsRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -34,6 +34,12 @@ func TestAccAWSRouteTableAssociation_basic(t *testing.T) {
 						"aws_route_table_association.foo", &v2),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_route_table_association.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_route_table_association.foo", "subnet_id", "route_table_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -45,6 +45,12 @@ func TestAccAWSRoute_basic(t *testing.T) {
 					testCheck,
 				),
 			},
+			{
+				ResourceName:      "aws_route.bar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_route.bar", "_", "route_table_id", "destination_cidr_block"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -26,6 +26,9 @@ func resourceAwsS3BucketObject() *schema.Resource {
 		Read:   resourceAwsS3BucketObjectRead,
 		Update: resourceAwsS3BucketObjectPut,
 		Delete: resourceAwsS3BucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsS3BucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -151,6 +154,19 @@ func resourceAwsS3BucketObject() *schema.Resource {
 	}
 }
 
+func resourceAwsS3BucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected BUCKET/KEY", d.Id())
+	}
+
+	d.Set("bucket", idParts[0])
+	d.Set("key", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -229,7 +245,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		if restricted {
 			return fmt.Errorf("This region does not allow for tags on S3 objects")
 		}
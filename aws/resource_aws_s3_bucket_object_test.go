@@ -73,6 +73,13 @@ func TestAccAWSS3BucketObject_content(t *testing.T) {
 					},
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "aws_s3_bucket_object.object",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSImportStateIdFunc("aws_s3_bucket_object.object", "bucket", "key"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "content"},
+			},
 		},
 	})
 }
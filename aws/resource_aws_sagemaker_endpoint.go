@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointCreate,
+		Read:   resourceAwsSagemakerEndpointRead,
+		Update: resourceAwsSagemakerEndpointUpdate,
+		Delete: resourceAwsSagemakerEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"endpoint_config_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	createOpts := &sagemaker.CreateEndpointInput{
+		EndpointName:       aws.String(name),
+		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+		Tags:               tagsFromMapSageMaker(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] SageMaker endpoint create config: %#v", *createOpts)
+
+	_, err := conn.CreateEndpoint(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating SageMaker endpoint: %s", err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForSagemakerEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker endpoint (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	endpoint, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			log.Printf("[WARN] SageMaker endpoint %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker endpoint %s: %s", d.Id(), err)
+	}
+
+	if aws.StringValue(endpoint.EndpointStatus) == sagemaker.EndpointStatusDeleting {
+		log.Printf("[WARN] SageMaker endpoint %s is being deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", endpoint.EndpointArn)
+	d.Set("name", endpoint.EndpointName)
+	d.Set("endpoint_config_name", endpoint.EndpointConfigName)
+
+	tags, err := listTagsSageMaker(conn, aws.StringValue(endpoint.EndpointArn))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	d.Partial(true)
+
+	if err := setTagsSageMaker(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
+	// The endpoint keeps serving requests with the old configuration
+	// until the new one has been deployed.
+	if d.HasChange("endpoint_config_name") {
+		modifyOpts := &sagemaker.UpdateEndpointInput{
+			EndpointName:       aws.String(d.Id()),
+			EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+		}
+
+		log.Printf("[INFO] Updating SageMaker endpoint: %s", modifyOpts)
+
+		if _, err := conn.UpdateEndpoint(modifyOpts); err != nil {
+			return fmt.Errorf("Error updating SageMaker endpoint %s: %s", d.Id(), err)
+		}
+
+		if err := waitForSagemakerEndpointInService(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for SageMaker endpoint (%s) to be updated: %s", d.Id(), err)
+		}
+
+		// A failed deployment rolls back to the previous configuration
+		// and leaves the endpoint InService.
+		endpoint, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading SageMaker endpoint %s: %s", d.Id(), err)
+		}
+		if aws.StringValue(endpoint.EndpointConfigName) != d.Get("endpoint_config_name").(string) {
+			return fmt.Errorf("SageMaker endpoint %s was rolled back to configuration %s: %s",
+				d.Id(), aws.StringValue(endpoint.EndpointConfigName), aws.StringValue(endpoint.FailureReason))
+		}
+
+		d.SetPartial("endpoint_config_name")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[INFO] Deleting SageMaker endpoint: %s", d.Id())
+
+	_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker endpoint %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{sagemaker.EndpointStatusDeleting},
+		Target:     []string{},
+		Refresh:    sagemakerEndpointStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker endpoint (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForSagemakerEndpointInService(conn *sagemaker.SageMaker, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			sagemaker.EndpointStatusCreating,
+			sagemaker.EndpointStatusUpdating,
+			sagemaker.EndpointStatusRollingBack,
+		},
+		Target:     []string{sagemaker.EndpointStatusInService},
+		Refresh:    sagemakerEndpointStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func sagemakerEndpointStateRefreshFunc(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		endpoint, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		status := aws.StringValue(endpoint.EndpointStatus)
+		if status == sagemaker.EndpointStatusFailed {
+			return endpoint, status, fmt.Errorf("SageMaker endpoint failed: %s", aws.StringValue(endpoint.FailureReason))
+		}
+
+		return endpoint, status, nil
+	}
+}
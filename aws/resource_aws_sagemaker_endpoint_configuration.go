@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointConfigurationCreate,
+		Read:   resourceAwsSagemakerEndpointConfigurationRead,
+		Update: resourceAwsSagemakerEndpointConfigurationUpdate,
+		Delete: resourceAwsSagemakerEndpointConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"production_variants": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"variant_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSagemakerName,
+						},
+
+						"model_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSagemakerName,
+						},
+
+						"initial_instance_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"initial_variant_weight": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							ForceNew: true,
+							Default:  1,
+						},
+					},
+				},
+			},
+
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	createOpts := &sagemaker.CreateEndpointConfigInput{
+		EndpointConfigName: aws.String(name),
+		ProductionVariants: expandSagemakerProductionVariants(d.Get("production_variants").([]interface{})),
+		Tags:               tagsFromMapSageMaker(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		createOpts.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] SageMaker endpoint configuration create config: %#v", *createOpts)
+
+	_, err := conn.CreateEndpointConfig(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating SageMaker endpoint configuration: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	endpointConfig, err := conn.DescribeEndpointConfig(&sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+			log.Printf("[WARN] SageMaker endpoint configuration %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker endpoint configuration %s: %s", d.Id(), err)
+	}
+
+	d.Set("arn", endpointConfig.EndpointConfigArn)
+	d.Set("name", endpointConfig.EndpointConfigName)
+	d.Set("kms_key_arn", endpointConfig.KmsKeyId)
+
+	if err := d.Set("production_variants", flattenSagemakerProductionVariants(endpointConfig.ProductionVariants)); err != nil {
+		return fmt.Errorf("error setting production_variants: %s", err)
+	}
+
+	tags, err := listTagsSageMaker(conn, aws.StringValue(endpointConfig.EndpointConfigArn))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := setTagsSageMaker(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[INFO] Deleting SageMaker endpoint configuration: %s", d.Id())
+
+	_, err := conn.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker endpoint configuration %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerProductionVariants(configured []interface{}) []*sagemaker.ProductionVariant {
+	variants := make([]*sagemaker.ProductionVariant, 0, len(configured))
+
+	for i, lRaw := range configured {
+		data := lRaw.(map[string]interface{})
+
+		l := &sagemaker.ProductionVariant{
+			InitialInstanceCount: aws.Int64(int64(data["initial_instance_count"].(int))),
+			InitialVariantWeight: aws.Float64(data["initial_variant_weight"].(float64)),
+			InstanceType:         aws.String(data["instance_type"].(string)),
+			ModelName:            aws.String(data["model_name"].(string)),
+		}
+
+		if v, ok := data["variant_name"].(string); ok && v != "" {
+			l.VariantName = aws.String(v)
+		} else {
+			l.VariantName = aws.String(fmt.Sprintf("variant-%d", i+1))
+		}
+
+		variants = append(variants, l)
+	}
+
+	return variants
+}
+
+func flattenSagemakerProductionVariants(list []*sagemaker.ProductionVariant) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+
+	for _, i := range list {
+		l := map[string]interface{}{
+			"initial_instance_count": aws.Int64Value(i.InitialInstanceCount),
+			"initial_variant_weight": aws.Float64Value(i.InitialVariantWeight),
+			"instance_type":          aws.StringValue(i.InstanceType),
+			"model_name":             aws.StringValue(i.ModelName),
+			"variant_name":           aws.StringValue(i.VariantName),
+		}
+
+		result = append(result, l)
+	}
+
+	return result
+}
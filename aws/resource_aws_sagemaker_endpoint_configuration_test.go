@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandSagemakerProductionVariants(t *testing.T) {
+	expanded := []interface{}{
+		map[string]interface{}{
+			"variant_name":           "",
+			"model_name":             "model-1",
+			"initial_instance_count": 1,
+			"instance_type":          "ml.t2.medium",
+			"initial_variant_weight": 1.0,
+		},
+		map[string]interface{}{
+			"variant_name":           "canary",
+			"model_name":             "model-2",
+			"initial_instance_count": 2,
+			"instance_type":          "ml.m4.xlarge",
+			"initial_variant_weight": 0.5,
+		},
+	}
+
+	expected := []*sagemaker.ProductionVariant{
+		{
+			VariantName:          aws.String("variant-1"),
+			ModelName:            aws.String("model-1"),
+			InitialInstanceCount: aws.Int64(1),
+			InstanceType:         aws.String("ml.t2.medium"),
+			InitialVariantWeight: aws.Float64(1.0),
+		},
+		{
+			VariantName:          aws.String("canary"),
+			ModelName:            aws.String("model-2"),
+			InitialInstanceCount: aws.Int64(2),
+			InstanceType:         aws.String("ml.m4.xlarge"),
+			InitialVariantWeight: aws.Float64(0.5),
+		},
+	}
+
+	result := expandSagemakerProductionVariants(expanded)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+}
+
+func TestAccAWSSagemakerEndpointConfiguration_basic(t *testing.T) {
+	var endpointConfig sagemaker.DescribeEndpointConfigOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfigurationConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointConfigurationExists(resourceName, &endpointConfig),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "production_variants.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "production_variants.0.variant_name", "variant-1"),
+					resource.TestCheckResourceAttr(resourceName, "production_variants.0.initial_instance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "production_variants.0.initial_variant_weight", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpointConfiguration_kmsKeyArn(t *testing.T) {
+	var endpointConfig sagemaker.DescribeEndpointConfigOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfigurationConfigKmsKeyArn(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointConfigurationExists(resourceName, &endpointConfig),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpointConfiguration_tags(t *testing.T) {
+	var endpointConfig sagemaker.DescribeEndpointConfigOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfigurationConfigTags(rName, "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointConfigurationExists(resourceName, &endpointConfig),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfigurationConfigTags(rName, "baz"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointConfigurationExists(resourceName, &endpointConfig),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerEndpointConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_endpoint_configuration" {
+			continue
+		}
+
+		_, err := conn.DescribeEndpointConfig(&sagemaker.DescribeEndpointConfigInput{
+			EndpointConfigName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SageMaker endpoint configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerEndpointConfigurationExists(n string, endpointConfig *sagemaker.DescribeEndpointConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker endpoint configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		resp, err := conn.DescribeEndpointConfig(&sagemaker.DescribeEndpointConfigInput{
+			EndpointConfigName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*endpointConfig = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerEndpointConfigurationConfig(rName string) string {
+	return testAccAWSSagemakerModelConfig(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name = %q
+
+  production_variants {
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerEndpointConfigurationConfigKmsKeyArn(rName string) string {
+	return testAccAWSSagemakerModelConfig(rName) + fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name        = %[1]q
+  kms_key_arn = "${aws_kms_key.test.arn}"
+
+  production_variants {
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerEndpointConfigurationConfigTags(rName, tagValue string) string {
+	return testAccAWSSagemakerModelConfig(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name = %q
+
+  production_variants {
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+
+  tags {
+    foo = %q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
+	var endpoint sagemaker.DescribeEndpointOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpoint_endpointConfigName(t *testing.T) {
+	var before, after sagemaker.DescribeEndpointOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &before),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.test", "name"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfigEndpointConfigNameUpdate(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &after),
+					testAccCheckAWSSagemakerEndpointNotRecreated(&before, &after),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.updated", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpoint_tags(t *testing.T) {
+	var endpoint sagemaker.DescribeEndpointOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfigTags(rName, "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfigTags(rName, "baz"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_endpoint" {
+			continue
+		}
+
+		_, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SageMaker endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerEndpointExists(n string, endpoint *sagemaker.DescribeEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		resp, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*endpoint = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSagemakerEndpointNotRecreated(before, after *sagemaker.DescribeEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(before.CreationTime).Equal(aws.TimeValue(after.CreationTime)) {
+			return fmt.Errorf("SageMaker endpoint %s was recreated", aws.StringValue(before.EndpointName))
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerEndpointConfig(rName string) string {
+	return testAccAWSSagemakerEndpointConfigurationConfig(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.test.name}"
+}
+`, rName)
+}
+
+func testAccAWSSagemakerEndpointConfigEndpointConfigNameUpdate(rName string) string {
+	return testAccAWSSagemakerEndpointConfigurationConfig(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "updated" {
+  name = "%[1]s-updated"
+
+  production_variants {
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 2
+    instance_type          = "ml.t2.medium"
+  }
+}
+
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.updated.name}"
+}
+`, rName)
+}
+
+func testAccAWSSagemakerEndpointConfigTags(rName, tagValue string) string {
+	return testAccAWSSagemakerEndpointConfigurationConfig(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.test.name}"
+
+  tags {
+    foo = %q
+  }
+}
+`, rName, tagValue)
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerModelCreate,
+		Read:   resourceAwsSagemakerModelRead,
+		Update: resourceAwsSagemakerModelUpdate,
+		Delete: resourceAwsSagemakerModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"primary_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_hostname": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSagemakerName,
+						},
+
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"model_data_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	createOpts := &sagemaker.CreateModelInput{
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		ModelName:        aws.String(name),
+		PrimaryContainer: expandSagemakerContainer(d.Get("primary_container").([]interface{})),
+		Tags:             tagsFromMapSageMaker(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] SageMaker model create config: %#v", *createOpts)
+
+	// IAM roles take some time to propagate
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateModel(createOpts)
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not assume role") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating SageMaker model: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	model, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find model") {
+			log.Printf("[WARN] SageMaker model %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker model %s: %s", d.Id(), err)
+	}
+
+	d.Set("arn", model.ModelArn)
+	d.Set("execution_role_arn", model.ExecutionRoleArn)
+	d.Set("name", model.ModelName)
+
+	if err := d.Set("primary_container", flattenSagemakerContainer(model.PrimaryContainer)); err != nil {
+		return fmt.Errorf("error setting primary_container: %s", err)
+	}
+
+	tags, err := listTagsSageMaker(conn, aws.StringValue(model.ModelArn))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := setTagsSageMaker(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[INFO] Deleting SageMaker model: %s", d.Id())
+
+	_, err := conn.DeleteModel(&sagemaker.DeleteModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Could not find model") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker model %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerContainer(l []interface{}) *sagemaker.ContainerDefinition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	container := &sagemaker.ContainerDefinition{
+		Image: aws.String(m["image"].(string)),
+	}
+
+	if v, ok := m["container_hostname"].(string); ok && v != "" {
+		container.ContainerHostname = aws.String(v)
+	}
+
+	if v, ok := m["model_data_url"].(string); ok && v != "" {
+		container.ModelDataUrl = aws.String(v)
+	}
+
+	if v, ok := m["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		container.Environment = stringMapToPointers(v)
+	}
+
+	return container
+}
+
+func flattenSagemakerContainer(container *sagemaker.ContainerDefinition) []map[string]interface{} {
+	if container == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"container_hostname": aws.StringValue(container.ContainerHostname),
+		"image":              aws.StringValue(container.Image),
+		"model_data_url":     aws.StringValue(container.ModelDataUrl),
+		"environment":        pointersMapToStringList(container.Environment),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandSagemakerContainer(t *testing.T) {
+	expanded := []interface{}{
+		map[string]interface{}{
+			"container_hostname": "test",
+			"image":              "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1",
+			"model_data_url":     "",
+			"environment": map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+	}
+
+	expected := &sagemaker.ContainerDefinition{
+		ContainerHostname: aws.String("test"),
+		Image:             aws.String("174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"),
+		Environment: map[string]*string{
+			"foo": aws.String("bar"),
+		},
+	}
+
+	result := expandSagemakerContainer(expanded)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+
+	if result := expandSagemakerContainer([]interface{}{}); result != nil {
+		t.Fatalf("Expected nil, got: %#v", result)
+	}
+}
+
+func TestFlattenSagemakerContainer(t *testing.T) {
+	result := flattenSagemakerContainer(&sagemaker.ContainerDefinition{
+		Image:        aws.String("174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"),
+		ModelDataUrl: aws.String("s3://bucket/model.tar.gz"),
+	})
+
+	expected := []map[string]interface{}{
+		{
+			"container_hostname": "",
+			"image":              "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1",
+			"model_data_url":     "s3://bucket/model.tar.gz",
+			"environment":        map[string]interface{}{},
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+}
+
+func TestAccAWSSagemakerModel_basic(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_tags(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfigTags(rName, "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerModelConfigTags(rName, "baz"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModel_primaryContainerEnvironment(t *testing.T) {
+	var model sagemaker.DescribeModelOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelConfigPrimaryContainerEnvironment(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerModelExists(resourceName, &model),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.container_hostname", "test"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "primary_container.0.environment.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_model" {
+			continue
+		}
+
+		_, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+			ModelName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Could not find model") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SageMaker model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerModelExists(n string, model *sagemaker.DescribeModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		resp, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+			ModelName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*model = *resp
+
+		return nil
+	}
+}
+
+// testAccAWSSagemakerModelConfigBase is shared by the SageMaker hosting
+// acceptance tests. The image is the public k-means algorithm container.
+func testAccAWSSagemakerModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "sagemaker.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "cloudwatch:PutMetricData",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:DescribeLogStreams",
+        "logs:PutLogEvents",
+        "ecr:GetAuthorizationToken",
+        "ecr:BatchCheckLayerAvailability",
+        "ecr:GetDownloadUrlForLayer",
+        "ecr:BatchGetImage"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSSagemakerModelConfig(rName string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerModelConfigTags(rName, tagValue string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+  }
+
+  tags {
+    foo = %q
+  }
+}
+`, rName, tagValue)
+}
+
+func testAccAWSSagemakerModelConfigPrimaryContainerEnvironment(rName string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    container_hostname = "test"
+    image              = "174872318107.dkr.ecr.us-west-2.amazonaws.com/kmeans:1"
+
+    environment {
+      foo = "bar"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,376 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerNotebookInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerNotebookInstanceCreate,
+		Read:   resourceAwsSagemakerNotebookInstanceRead,
+		Update: resourceAwsSagemakerNotebookInstanceUpdate,
+		Delete: resourceAwsSagemakerNotebookInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"direct_internet_access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  sagemaker.DirectInternetAccessEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					sagemaker.DirectInternetAccessEnabled,
+					sagemaker.DirectInternetAccessDisabled,
+				}, false),
+			},
+
+			"lifecycle_config_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerNotebookInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := d.Get("name").(string)
+
+	createOpts := &sagemaker.CreateNotebookInstanceInput{
+		DirectInternetAccess: aws.String(d.Get("direct_internet_access").(string)),
+		InstanceType:         aws.String(d.Get("instance_type").(string)),
+		NotebookInstanceName: aws.String(name),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 tagsFromMapSageMaker(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		createOpts.SubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_groups"); ok {
+		createOpts.SecurityGroupIds = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		createOpts.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lifecycle_config_name"); ok {
+		createOpts.LifecycleConfigName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] SageMaker notebook instance create config: %#v", *createOpts)
+
+	_, err := conn.CreateNotebookInstance(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating SageMaker notebook instance: %s", err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), sagemaker.NotebookInstanceStatusInService, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker notebook instance (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	notebookInstance, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			log.Printf("[WARN] SageMaker notebook instance %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker notebook instance %s: %s", d.Id(), err)
+	}
+
+	d.Set("arn", notebookInstance.NotebookInstanceArn)
+	d.Set("direct_internet_access", notebookInstance.DirectInternetAccess)
+	d.Set("instance_type", notebookInstance.InstanceType)
+	d.Set("kms_key_id", notebookInstance.KmsKeyId)
+	d.Set("lifecycle_config_name", notebookInstance.NotebookInstanceLifecycleConfigName)
+	d.Set("name", notebookInstance.NotebookInstanceName)
+	d.Set("network_interface_id", notebookInstance.NetworkInterfaceId)
+	d.Set("role_arn", notebookInstance.RoleArn)
+	d.Set("subnet_id", notebookInstance.SubnetId)
+	d.Set("url", notebookInstance.Url)
+
+	if err := d.Set("security_groups", flattenStringList(notebookInstance.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_groups: %s", err)
+	}
+
+	tags, err := listTagsSageMaker(conn, aws.StringValue(notebookInstance.NotebookInstanceArn))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	d.Partial(true)
+
+	if err := setTagsSageMaker(conn, d, d.Get("arn").(string)); err != nil {
+		return err
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
+	if d.HasChange("instance_type") || d.HasChange("role_arn") {
+		updateOpts := &sagemaker.UpdateNotebookInstanceInput{
+			NotebookInstanceName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("instance_type") {
+			updateOpts.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			updateOpts.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		// Only stopped notebook instances can be updated, so a running
+		// instance is stopped for the update and started again afterwards.
+		restart, err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Updating SageMaker notebook instance: %s", updateOpts)
+
+		if _, err := conn.UpdateNotebookInstance(updateOpts); err != nil {
+			return fmt.Errorf("Error updating SageMaker notebook instance %s: %s", d.Id(), err)
+		}
+
+		if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), sagemaker.NotebookInstanceStatusStopped, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for SageMaker notebook instance (%s) to be updated: %s", d.Id(), err)
+		}
+
+		if restart {
+			log.Printf("[INFO] Starting SageMaker notebook instance: %s", d.Id())
+
+			if _, err := conn.StartNotebookInstance(&sagemaker.StartNotebookInstanceInput{
+				NotebookInstanceName: aws.String(d.Id()),
+			}); err != nil {
+				return fmt.Errorf("Error starting SageMaker notebook instance %s: %s", d.Id(), err)
+			}
+
+			if err := waitForSagemakerNotebookInstanceStatus(conn, d.Id(), sagemaker.NotebookInstanceStatusInService, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("Error waiting for SageMaker notebook instance (%s) to start: %s", d.Id(), err)
+			}
+		}
+
+		d.SetPartial("instance_type")
+		d.SetPartial("role_arn")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	// Only stopped or failed notebook instances can be deleted
+	if _, err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return nil
+		}
+		return err
+	}
+
+	log.Printf("[INFO] Deleting SageMaker notebook instance: %s", d.Id())
+
+	_, err := conn.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker notebook instance %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{sagemaker.NotebookInstanceStatusDeleting},
+		Target:     []string{},
+		Refresh:    sagemakerNotebookInstanceStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for SageMaker notebook instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// stopSagemakerNotebookInstance stops the notebook instance if it is running
+// and waits until it is stopped. It reports whether the instance was running.
+func stopSagemakerNotebookInstance(conn *sagemaker.SageMaker, name string, timeout time.Duration) (bool, error) {
+	notebookInstance, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	switch aws.StringValue(notebookInstance.NotebookInstanceStatus) {
+	case sagemaker.NotebookInstanceStatusStopped, sagemaker.NotebookInstanceStatusFailed:
+		return false, nil
+	case sagemaker.NotebookInstanceStatusPending:
+		// A pending instance cannot be stopped until it is in service
+		if err := waitForSagemakerNotebookInstanceStatus(conn, name, sagemaker.NotebookInstanceStatusInService, timeout); err != nil {
+			return false, fmt.Errorf("Error waiting for SageMaker notebook instance (%s) to start: %s", name, err)
+		}
+	case sagemaker.NotebookInstanceStatusStopping:
+		if err := waitForSagemakerNotebookInstanceStatus(conn, name, sagemaker.NotebookInstanceStatusStopped, timeout); err != nil {
+			return false, fmt.Errorf("Error waiting for SageMaker notebook instance (%s) to stop: %s", name, err)
+		}
+		return false, nil
+	}
+
+	log.Printf("[INFO] Stopping SageMaker notebook instance: %s", name)
+
+	if _, err := conn.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+	}); err != nil {
+		return false, fmt.Errorf("Error stopping SageMaker notebook instance %s: %s", name, err)
+	}
+
+	if err := waitForSagemakerNotebookInstanceStatus(conn, name, sagemaker.NotebookInstanceStatusStopped, timeout); err != nil {
+		return false, fmt.Errorf("Error waiting for SageMaker notebook instance (%s) to stop: %s", name, err)
+	}
+
+	return true, nil
+}
+
+func waitForSagemakerNotebookInstanceStatus(conn *sagemaker.SageMaker, name, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			sagemaker.NotebookInstanceStatusPending,
+			sagemaker.NotebookInstanceStatusStopping,
+			// Reported while an update is applied, but not part of the SDK enum
+			"Updating",
+		},
+		Target:     []string{target},
+		Refresh:    sagemakerNotebookInstanceStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func sagemakerNotebookInstanceStateRefreshFunc(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		notebookInstance, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "RecordNotFound") {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		status := aws.StringValue(notebookInstance.NotebookInstanceStatus)
+		if status == sagemaker.NotebookInstanceStatusFailed {
+			return notebookInstance, status, fmt.Errorf("SageMaker notebook instance failed: %s", aws.StringValue(notebookInstance.FailureReason))
+		}
+
+		return notebookInstance, status, nil
+	}
+}
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerNotebookInstanceLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerNotebookInstanceLifecycleConfigurationCreate,
+		Read:   resourceAwsSagemakerNotebookInstanceLifecycleConfigurationRead,
+		Update: resourceAwsSagemakerNotebookInstanceLifecycleConfigurationUpdate,
+		Delete: resourceAwsSagemakerNotebookInstanceLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			// The scripts are passed base64 encoded, as expected by the API
+			"on_create": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(16384),
+			},
+
+			"on_start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMaxLength(16384),
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerNotebookInstanceLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	createOpts := &sagemaker.CreateNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("on_create"); ok {
+		createOpts.OnCreate = []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String(v.(string))}}
+	}
+
+	if v, ok := d.GetOk("on_start"); ok {
+		createOpts.OnStart = []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String(v.(string))}}
+	}
+
+	log.Printf("[DEBUG] SageMaker notebook instance lifecycle configuration create config: %#v", *createOpts)
+
+	_, err := conn.CreateNotebookInstanceLifecycleConfig(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating SageMaker notebook instance lifecycle configuration: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerNotebookInstanceLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	lifecycleConfig, err := conn.DescribeNotebookInstanceLifecycleConfig(&sagemaker.DescribeNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Unable to describe Notebook Instance Lifecycle Config") {
+			log.Printf("[WARN] SageMaker notebook instance lifecycle configuration %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SageMaker notebook instance lifecycle configuration %s: %s", d.Id(), err)
+	}
+
+	d.Set("arn", lifecycleConfig.NotebookInstanceLifecycleConfigArn)
+	d.Set("name", lifecycleConfig.NotebookInstanceLifecycleConfigName)
+
+	d.Set("on_create", "")
+	if len(lifecycleConfig.OnCreate) > 0 {
+		d.Set("on_create", lifecycleConfig.OnCreate[0].Content)
+	}
+
+	d.Set("on_start", "")
+	if len(lifecycleConfig.OnStart) > 0 {
+		d.Set("on_start", lifecycleConfig.OnStart[0].Content)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerNotebookInstanceLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	// The hooks are replaced as a whole, so both are always sent
+	updateOpts := &sagemaker.UpdateNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(d.Id()),
+		OnCreate:                            []*sagemaker.NotebookInstanceLifecycleHook{},
+		OnStart:                             []*sagemaker.NotebookInstanceLifecycleHook{},
+	}
+
+	if v, ok := d.GetOk("on_create"); ok {
+		updateOpts.OnCreate = []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String(v.(string))}}
+	}
+
+	if v, ok := d.GetOk("on_start"); ok {
+		updateOpts.OnStart = []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String(v.(string))}}
+	}
+
+	log.Printf("[INFO] Updating SageMaker notebook instance lifecycle configuration: %s", updateOpts)
+
+	_, err := conn.UpdateNotebookInstanceLifecycleConfig(updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating SageMaker notebook instance lifecycle configuration %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerNotebookInstanceLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[INFO] Deleting SageMaker notebook instance lifecycle configuration: %s", d.Id())
+
+	_, err := conn.DeleteNotebookInstanceLifecycleConfig(&sagemaker.DeleteNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "ValidationException", "Unable to describe Notebook Instance Lifecycle Config") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SageMaker notebook instance lifecycle configuration %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
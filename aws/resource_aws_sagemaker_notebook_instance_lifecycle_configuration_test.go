@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerNotebookInstanceLifecycleConfiguration_basic(t *testing.T) {
+	var lifecycleConfig sagemaker.DescribeNotebookInstanceLifecycleConfigOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance_lifecycle_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "on_create", ""),
+					resource.TestCheckResourceAttr(resourceName, "on_start", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerNotebookInstanceLifecycleConfiguration_update(t *testing.T) {
+	var lifecycleConfig sagemaker.DescribeNotebookInstanceLifecycleConfigOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance_lifecycle_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfigHooks(rName, "echo create", "echo start"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					resource.TestCheckResourceAttr(resourceName, "on_create", base64.StdEncoding.EncodeToString([]byte("echo create"))),
+					resource.TestCheckResourceAttr(resourceName, "on_start", base64.StdEncoding.EncodeToString([]byte("echo start"))),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfigHooks(rName, "echo create updated", "echo start updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					resource.TestCheckResourceAttr(resourceName, "on_create", base64.StdEncoding.EncodeToString([]byte("echo create updated"))),
+					resource.TestCheckResourceAttr(resourceName, "on_start", base64.StdEncoding.EncodeToString([]byte("echo start updated"))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_notebook_instance_lifecycle_configuration" {
+			continue
+		}
+
+		_, err := conn.DescribeNotebookInstanceLifecycleConfig(&sagemaker.DescribeNotebookInstanceLifecycleConfigInput{
+			NotebookInstanceLifecycleConfigName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "Unable to describe Notebook Instance Lifecycle Config") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SageMaker notebook instance lifecycle configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(n string, lifecycleConfig *sagemaker.DescribeNotebookInstanceLifecycleConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker notebook instance lifecycle configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		resp, err := conn.DescribeNotebookInstanceLifecycleConfig(&sagemaker.DescribeNotebookInstanceLifecycleConfigInput{
+			NotebookInstanceLifecycleConfigName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*lifecycleConfig = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
+  name = %q
+}
+`, rName)
+}
+
+func testAccAWSSagemakerNotebookInstanceLifecycleConfigurationConfigHooks(rName, onCreate, onStart string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
+  name      = %q
+  on_create = "${base64encode(%q)}"
+  on_start  = "${base64encode(%q)}"
+}
+`, rName, onCreate, onStart)
+}
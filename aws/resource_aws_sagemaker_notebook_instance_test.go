@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerNotebookInstance_basic(t *testing.T) {
+	var notebookInstance sagemaker.DescribeNotebookInstanceOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfig(rName, "ml.t2.medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebookInstance),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.t2.medium"),
+					resource.TestCheckResourceAttr(resourceName, "direct_internet_access", "Enabled"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerNotebookInstance_instanceType(t *testing.T) {
+	var notebookInstance sagemaker.DescribeNotebookInstanceOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfig(rName, "ml.t2.medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebookInstance),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.t2.medium"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfig(rName, "ml.t2.large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebookInstance),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.t2.large"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerNotebookInstance_lifecycleConfigName(t *testing.T) {
+	var notebookInstance sagemaker.DescribeNotebookInstanceOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfigLifecycleConfigName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebookInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "lifecycle_config_name", "aws_sagemaker_notebook_instance_lifecycle_configuration.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerNotebookInstance_tags(t *testing.T) {
+	var notebookInstance sagemaker.DescribeNotebookInstanceOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfigTags(rName, "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebookInstance),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerNotebookInstanceConfigTags(rName, "baz"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceExists(resourceName, &notebookInstance),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_notebook_instance" {
+			continue
+		}
+
+		_, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "ValidationException", "RecordNotFound") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SageMaker notebook instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceExists(n string, notebookInstance *sagemaker.DescribeNotebookInstanceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker notebook instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		resp, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*notebookInstance = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerNotebookInstanceConfig(rName, instanceType string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance" "test" {
+  name          = %q
+  role_arn      = "${aws_iam_role.test.arn}"
+  instance_type = %q
+}
+`, rName, instanceType)
+}
+
+func testAccAWSSagemakerNotebookInstanceConfigLifecycleConfigName(rName string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
+  name     = %[1]q
+  on_start = "${base64encode("echo start")}"
+}
+
+resource "aws_sagemaker_notebook_instance" "test" {
+  name                  = %[1]q
+  role_arn              = "${aws_iam_role.test.arn}"
+  instance_type         = "ml.t2.medium"
+  lifecycle_config_name = "${aws_sagemaker_notebook_instance_lifecycle_configuration.test.name}"
+}
+`, rName)
+}
+
+func testAccAWSSagemakerNotebookInstanceConfigTags(rName, tagValue string) string {
+	return testAccAWSSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance" "test" {
+  name          = %q
+  role_arn      = "${aws_iam_role.test.arn}"
+  instance_type = "ml.t2.medium"
+
+  tags {
+    foo = %q
+  }
+}
+`, rName, tagValue)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +28,9 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSecurityGroupRuleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"type": {
@@ -116,6 +120,74 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 	}
 }
 
+// resourceAwsSecurityGroupRuleImport imports a rule from an ID of the form
+// SECURITY-GROUP-ID_TYPE_PROTOCOL_FROM-PORT_TO-PORT_SOURCE[_SOURCE]*, where
+// each source is an IPv4 or IPv6 CIDR block, a prefix list ID, a source
+// security group ID or "self".
+func resourceAwsSecurityGroupRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "_")
+	if len(idParts) < 6 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SECURITY-GROUP-ID_TYPE_PROTOCOL_FROM-PORT_TO-PORT_SOURCE[_SOURCE]*", d.Id())
+	}
+	for _, part := range idParts {
+		if part == "" {
+			return nil, fmt.Errorf("Unexpected format of ID (%q), expected SECURITY-GROUP-ID_TYPE_PROTOCOL_FROM-PORT_TO-PORT_SOURCE[_SOURCE]*", d.Id())
+		}
+	}
+
+	sgId := idParts[0]
+	ruleType := idParts[1]
+	if ruleType != "ingress" && ruleType != "egress" {
+		return nil, fmt.Errorf("Invalid security group rule type %q, expected ingress or egress", ruleType)
+	}
+	fromPort, err := strconv.Atoi(idParts[3])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid from port %q: %s", idParts[3], err)
+	}
+	toPort, err := strconv.Atoi(idParts[4])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid to port %q: %s", idParts[4], err)
+	}
+
+	var cidrBlocks, ipv6CidrBlocks, prefixListIds []string
+	for _, source := range idParts[5:] {
+		switch {
+		case source == "self":
+			d.Set("self", true)
+		case strings.HasPrefix(source, "sg-"):
+			d.Set("source_security_group_id", source)
+		case strings.HasPrefix(source, "pl-"):
+			prefixListIds = append(prefixListIds, source)
+		case strings.Contains(source, ":"):
+			ipv6CidrBlocks = append(ipv6CidrBlocks, source)
+		default:
+			cidrBlocks = append(cidrBlocks, source)
+		}
+	}
+
+	d.Set("security_group_id", sgId)
+	d.Set("type", ruleType)
+	d.Set("protocol", idParts[2])
+	d.Set("from_port", fromPort)
+	d.Set("to_port", toPort)
+	d.Set("cidr_blocks", cidrBlocks)
+	d.Set("ipv6_cidr_blocks", ipv6CidrBlocks)
+	d.Set("prefix_list_ids", prefixListIds)
+
+	conn := meta.(*AWSClient).ec2conn
+	sg, err := findResourceSecurityGroup(conn, sgId)
+	if err != nil {
+		return nil, err
+	}
+	perm, err := expandIPPerm(d, sg)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(ipPermissionIDHash(sgId, ruleType, perm))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
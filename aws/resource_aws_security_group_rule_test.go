@@ -142,6 +142,12 @@ func TestAccAWSSecurityGroupRule_Ingress_VPC(t *testing.T) {
 					testRuleCount,
 				),
 			},
+			{
+				ResourceName:      "aws_security_group_rule.ingress_1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_security_group_rule.ingress_1", "_", "security_group_id", "type", "protocol", "from_port", "to_port", "cidr_blocks.0"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
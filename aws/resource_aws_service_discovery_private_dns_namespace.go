@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +16,9 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
 		Create: resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate,
 		Read:   resourceAwsServiceDiscoveryPrivateDnsNamespaceRead,
 		Delete: resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsServiceDiscoveryPrivateDnsNamespaceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -44,6 +48,18 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
 	}
 }
 
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAMESPACE-ID:VPC-ID", d.Id())
+	}
+
+	d.Set("vpc", idParts[1])
+	d.SetId(idParts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sdconn
 
@@ -95,6 +111,7 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d *schema.ResourceData,
 		return err
 	}
 
+	d.Set("name", resp.Namespace.Name)
 	d.Set("description", resp.Namespace.Description)
 	d.Set("arn", resp.Namespace.Arn)
 	if resp.Namespace.Properties != nil {
@@ -25,6 +25,12 @@ func TestAccAWSServiceDiscoveryPrivateDnsNamespace_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_service_discovery_private_dns_namespace.test", "hosted_zone"),
 				),
 			},
+			{
+				ResourceName:      "aws_service_discovery_private_dns_namespace.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_service_discovery_private_dns_namespace.test", ":", "id", "vpc"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -76,7 +76,7 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := []*servicecatalog.Tag{}
 		t := v.(map[string]interface{})
 		for k, v := range t {
@@ -159,8 +159,9 @@ func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if d.HasChange("tags") {
-		currentTags, requiredTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		currentTags, _ := d.GetChange("tags_all")
+		requiredTags := d.Get("tags_all")
 		log.Printf("[DEBUG] Current Tags: %#v", currentTags)
 		log.Printf("[DEBUG] Required Tags: %#v", requiredTags)
 
@@ -15,6 +15,9 @@ func resourceAwsSesActiveReceiptRuleSet() *schema.Resource {
 		Update: resourceAwsSesActiveReceiptRuleSetUpdate,
 		Read:   resourceAwsSesActiveReceiptRuleSetRead,
 		Delete: resourceAwsSesActiveReceiptRuleSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rule_set_name": &schema.Schema{
@@ -27,6 +27,12 @@ func TestAccAWSSESReceiptRule_basic(t *testing.T) {
 					testAccCheckAwsSESReceiptRuleExists("aws_ses_receipt_rule.basic"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_ses_receipt_rule.basic",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_ses_receipt_rule.basic", ":", "rule_set_name", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
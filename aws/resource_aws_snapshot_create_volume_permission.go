@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,6 +17,9 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 		Create: resourceAwsSnapshotCreateVolumePermissionCreate,
 		Read:   resourceAwsSnapshotCreateVolumePermissionRead,
 		Delete: resourceAwsSnapshotCreateVolumePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSnapshotCreateVolumePermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"snapshot_id": &schema.Schema{
@@ -40,6 +44,19 @@ func resourceAwsSnapshotCreateVolumePermissionExists(d *schema.ResourceData, met
 	return hasCreateVolumePermission(conn, snapshot_id, account_id)
 }
 
+func resourceAwsSnapshotCreateVolumePermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Snapshot IDs contain a hyphen but account IDs do not.
+	i := strings.LastIndex(d.Id(), "-")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SNAPSHOT-ID-ACCOUNT-ID", d.Id())
+	}
+
+	d.Set("snapshot_id", d.Id()[:i])
+	d.Set("account_id", d.Id()[i+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
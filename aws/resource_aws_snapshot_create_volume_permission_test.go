@@ -23,6 +23,11 @@ func TestAccAWSSnapshotCreateVolumePermission_Basic(t *testing.T) {
 					testAccAWSSnapshotCreateVolumePermissionExists(&accountId, &snapshotId),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_snapshot_create_volume_permission.self-test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Drop just create volume permission to test destruction
 			resource.TestStep{
 				Config: testAccAWSSnapshotCreateVolumePermissionConfig(false),
@@ -18,6 +18,9 @@ func resourceAwsSnsTopicPolicy() *schema.Resource {
 		Read:   resourceAwsSnsTopicPolicyRead,
 		Update: resourceAwsSnsTopicPolicyUpsert,
 		Delete: resourceAwsSnsTopicPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -89,6 +92,7 @@ func resourceAwsSnsTopicPolicyRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
+	d.Set("arn", d.Id())
 	d.Set("policy", policy)
 
 	return nil
@@ -37,6 +37,12 @@ func TestAccAWSSpotFleetRequest_associatePublicIpAddress(t *testing.T) {
 						"aws_spot_fleet_request.foo", "launch_specification.24370212.associate_public_ip_address", "true"),
 				),
 			},
+			{
+				ResourceName:            "aws_spot_fleet_request.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+			},
 		},
 	})
 }
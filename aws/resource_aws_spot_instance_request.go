@@ -36,6 +36,14 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// Spot instance requests cannot be launched from a launch template.
+			delete(s, "launch_template")
+			for _, k := range []string{"ami", "instance_type"} {
+				s[k].Required = true
+				s[k].Optional = false
+				s[k].Computed = false
+			}
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -18,6 +18,9 @@ func resourceAwsSsmAssociation() *schema.Resource {
 
 		MigrateState:  resourceAwsSsmAssociationMigrateState,
 		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"association_name": {
@@ -26,6 +26,9 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Read:   resourceAwsSsmDocumentRead,
 		Update: resourceAwsSsmDocumentUpdate,
 		Delete: resourceAwsSsmDocumentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmDocumentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -144,6 +147,24 @@ func resourceAwsSsmDocument() *schema.Resource {
 	}
 }
 
+func resourceAwsSsmDocumentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ssmconn := meta.(*AWSClient).ssmconn
+
+	// The document content is not returned by DescribeDocument, so it is
+	// only fetched when importing.
+	resp, err := ssmconn.GetDocument(&ssm.GetDocumentInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading SSM document %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", d.Id())
+	d.Set("content", resp.Content)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
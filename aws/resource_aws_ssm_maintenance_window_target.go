@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -14,6 +15,9 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 		Create: resourceAwsSsmMaintenanceWindowTargetCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTargetRead,
 		Delete: resourceAwsSsmMaintenanceWindowTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -57,6 +61,18 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 	}
 }
 
+func resourceAwsSsmMaintenanceWindowTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW-ID/WINDOW-TARGET-ID", d.Id())
+	}
+
+	d.Set("window_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSsmMaintenanceWindowTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
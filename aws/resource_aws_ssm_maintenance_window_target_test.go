@@ -27,6 +27,12 @@ func TestAccAWSSSMMaintenanceWindowTarget_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_ssm_maintenance_window_target.target", "targets.1.key", "tag:Name2"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window_target.target",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_ssm_maintenance_window_target.target", "window_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
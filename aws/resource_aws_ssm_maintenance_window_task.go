@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -14,6 +15,9 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 		Create: resourceAwsSsmMaintenanceWindowTaskCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTaskRead,
 		Delete: resourceAwsSsmMaintenanceWindowTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTaskImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -179,6 +183,18 @@ func flattenAwsSsmTaskParameters(taskParameters map[string]*ssm.MaintenanceWindo
 	return result
 }
 
+func resourceAwsSsmMaintenanceWindowTaskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW-ID/WINDOW-TASK-ID", d.Id())
+	}
+
+	d.Set("window_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSsmMaintenanceWindowTaskCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
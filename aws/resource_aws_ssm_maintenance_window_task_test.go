@@ -27,6 +27,12 @@ func TestAccAWSSSMMaintenanceWindowTask_basic(t *testing.T) {
 					testAccCheckAWSSSMMaintenanceWindowTaskExists("aws_ssm_maintenance_window_task.target", &task),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window_task.target",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_ssm_maintenance_window_task.target", "window_id", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
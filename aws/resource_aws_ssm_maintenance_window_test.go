@@ -50,6 +50,11 @@ func TestAccAWSSSMMaintenanceWindow_basic(t *testing.T) {
 						"aws_ssm_maintenance_window.foo", "enabled", "true"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
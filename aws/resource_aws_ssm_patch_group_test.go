@@ -24,6 +24,11 @@ func TestAccAWSSSMPatchGroup_basic(t *testing.T) {
 					testAccCheckAWSSSMPatchGroupExists("aws_ssm_patch_group.patchgroup"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_patch_group.patchgroup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,9 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 		Read:   resourceAwsVolumeAttachmentRead,
 		Update: resourceAwsVolumeAttachmentUpdate,
 		Delete: resourceAwsVolumeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVolumeAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"device_name": {
@@ -52,6 +56,20 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsVolumeAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected DEVICE-NAME:VOLUME-ID:INSTANCE-ID", d.Id())
+	}
+
+	d.Set("device_name", idParts[0])
+	d.Set("volume_id", idParts[1])
+	d.Set("instance_id", idParts[2])
+	d.SetId(volumeAttachmentID(idParts[0], idParts[1], idParts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	name := d.Get("device_name").(string)
@@ -34,6 +34,13 @@ func TestAccAWSVolumeAttachment_basic(t *testing.T) {
 						"aws_volume_attachment.ebs_att", &i, &v),
 				),
 			},
+			{
+				ResourceName:            "aws_volume_attachment.ebs_att",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSImportStateIdFuncWithSeparator("aws_volume_attachment.ebs_att", ":", "device_name", "volume_id", "instance_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_detach", "skip_destroy"},
+			},
 		},
 	})
 }
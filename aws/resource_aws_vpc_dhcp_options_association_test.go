@@ -26,6 +26,12 @@ func TestAccAWSDHCPOptionsAssociation_basic(t *testing.T) {
 					testAccCheckDHCPOptionsAssociationExist("aws_vpc_dhcp_options_association.foo", &v),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_vpc_dhcp_options_association.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_vpc_dhcp_options_association.foo", "vpc_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -15,6 +16,9 @@ func resourceAwsVpcEndpointServiceAllowedPrincipal() *schema.Resource {
 		Create: resourceAwsVpcEndpointServiceAllowedPrincipalCreate,
 		Read:   resourceAwsVpcEndpointServiceAllowedPrincipalRead,
 		Delete: resourceAwsVpcEndpointServiceAllowedPrincipalDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcEndpointServiceAllowedPrincipalImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_endpoint_service_id": {
@@ -31,6 +35,19 @@ func resourceAwsVpcEndpointServiceAllowedPrincipal() *schema.Resource {
 	}
 }
 
+func resourceAwsVpcEndpointServiceAllowedPrincipalImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPC-ENDPOINT-SERVICE-ID/PRINCIPAL-ARN", d.Id())
+	}
+
+	d.Set("vpc_endpoint_service_id", idParts[0])
+	d.Set("principal_arn", idParts[1])
+	d.SetId(vpcEndpointServiceIdPrincipalArnHash(idParts[0], idParts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVpcEndpointServiceAllowedPrincipalCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
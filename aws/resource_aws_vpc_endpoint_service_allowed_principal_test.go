@@ -27,6 +27,12 @@ func TestAccAWSVpcEndpointServiceAllowedPrincipal_basic(t *testing.T) {
 					testAccCheckVpcEndpointServiceAllowedPrincipalExists("aws_vpc_endpoint_service_allowed_principal.foo"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_vpc_endpoint_service_allowed_principal.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFunc("aws_vpc_endpoint_service_allowed_principal.foo", "vpc_endpoint_service_id", "principal_arn"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcIpv4CidrBlockAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpv4CidrBlockAssociationCreate,
+		Read:   resourceAwsVpcIpv4CidrBlockAssociationRead,
+		Delete: resourceAwsVpcIpv4CidrBlockAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.AssociateVpcCidrBlockInput{
+		VpcId:     aws.String(d.Get("vpc_id").(string)),
+		CidrBlock: aws.String(d.Get("cidr_block").(string)),
+	}
+	log.Printf("[DEBUG] Creating VPC IPv4 CIDR block association: %s", req)
+	resp, err := conn.AssociateVpcCidrBlock(req)
+	if err != nil {
+		return fmt.Errorf("Error creating VPC IPv4 CIDR block association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.CidrBlockAssociation.AssociationId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeAssociating},
+		Target:     []string{ec2.VpcCidrBlockStateCodeAssociated},
+		Refresh:    vpcIpv4CidrBlockAssociationStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC IPv4 CIDR block association (%s) to become associated: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpv4CidrBlockAssociationRead(d, meta)
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpc, assoc, err := findVpcIpv4CidrBlockAssociation(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading VPC IPv4 CIDR block association (%s): %s", d.Id(), err)
+	}
+
+	if assoc == nil {
+		log.Printf("[WARN] VPC IPv4 CIDR block association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	switch aws.StringValue(assoc.CidrBlockState.State) {
+	case ec2.VpcCidrBlockStateCodeDisassociating, ec2.VpcCidrBlockStateCodeDisassociated, ec2.VpcCidrBlockStateCodeFailed:
+		log.Printf("[WARN] VPC IPv4 CIDR block association (%s) is %s, removing from state", d.Id(), aws.StringValue(assoc.CidrBlockState.State))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_id", vpc.VpcId)
+	d.Set("cidr_block", assoc.CidrBlock)
+
+	return nil
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting VPC IPv4 CIDR block association: %s", d.Id())
+	_, err := conn.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{
+		AssociationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcCidrBlockAssociationID.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPC IPv4 CIDR block association (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeDisassociating},
+		Target:     []string{ec2.VpcCidrBlockStateCodeDisassociated},
+		Refresh:    vpcIpv4CidrBlockAssociationStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC IPv4 CIDR block association (%s) to become disassociated: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findVpcIpv4CidrBlockAssociation returns the VPC and the IPv4 CIDR block
+// association with the given ID, or nils if the association does not exist.
+func findVpcIpv4CidrBlockAssociation(conn *ec2.EC2, id string) (*ec2.Vpc, *ec2.VpcCidrBlockAssociation, error) {
+	resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: buildEC2AttributeFilterList(
+			map[string]string{
+				"cidr-block-association.association-id": id,
+			},
+		),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, vpc := range resp.Vpcs {
+		for _, assoc := range vpc.CidrBlockAssociationSet {
+			if aws.StringValue(assoc.AssociationId) == id {
+				return vpc, assoc, nil
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+func vpcIpv4CidrBlockAssociationStateRefresh(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		_, assoc, err := findVpcIpv4CidrBlockAssociation(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if assoc == nil {
+			// Disassociated blocks eventually disappear from the VPC.
+			return "", ec2.VpcCidrBlockStateCodeDisassociated, nil
+		}
+
+		state := assoc.CidrBlockState
+		if aws.StringValue(state.State) == ec2.VpcCidrBlockStateCodeFailed {
+			return assoc, ec2.VpcCidrBlockStateCodeFailed, fmt.Errorf("%s", aws.StringValue(state.StatusMessage))
+		}
+
+		return assoc, aws.StringValue(state.State), nil
+	}
+}
@@ -14,6 +14,9 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 		Read:   resourceAwsVPCPeeringRead,
 		Update: resourceAwsVPCPeeringUpdate,
 		Delete: resourceAwsVPCPeeringAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVPCPeeringAccepterImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_peering_connection_id": &schema.Schema{
@@ -53,6 +56,12 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 	}
 }
 
+func resourceAwsVPCPeeringAccepterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("vpc_peering_connection_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Get("vpc_peering_connection_id").(string)
 	d.SetId(id)
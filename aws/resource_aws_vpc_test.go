@@ -89,6 +89,10 @@ func TestAccAWSVpc_basic(t *testing.T) {
 						"aws_vpc.foo", "default_route_table_id"),
 					resource.TestCheckResourceAttr(
 						"aws_vpc.foo", "enable_dns_support", "true"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc.foo", "cidr_block_associations.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_vpc.foo", "cidr_block_associations.0.cidr_block", "10.1.0.0/16"),
 				),
 			},
 		},
@@ -22,16 +22,42 @@ import (
 )
 
 type XmlVpnConnectionConfig struct {
-	Tunnels []XmlIpsecTunnel `xml:"ipsec_tunnel"`
+	ID                string           `xml:"id,attr"`
+	CustomerGatewayID string           `xml:"customer_gateway_id"`
+	VpnGatewayID      string           `xml:"vpn_gateway_id"`
+	Type              string           `xml:"vpn_connection_type"`
+	Tunnels           []XmlIpsecTunnel `xml:"ipsec_tunnel"`
 }
 
 type XmlIpsecTunnel struct {
-	OutsideAddress   string `xml:"vpn_gateway>tunnel_outside_address>ip_address"`
-	BGPASN           string `xml:"vpn_gateway>bgp>asn"`
-	BGPHoldTime      int    `xml:"vpn_gateway>bgp>hold_time"`
-	PreSharedKey     string `xml:"ike>pre_shared_key"`
-	CgwInsideAddress string `xml:"customer_gateway>tunnel_inside_address>ip_address"`
-	VgwInsideAddress string `xml:"vpn_gateway>tunnel_inside_address>ip_address"`
+	OutsideAddress    string `xml:"vpn_gateway>tunnel_outside_address>ip_address"`
+	BGPASN            string `xml:"vpn_gateway>bgp>asn"`
+	BGPHoldTime       int    `xml:"vpn_gateway>bgp>hold_time"`
+	PreSharedKey      string `xml:"ike>pre_shared_key"`
+	CgwInsideAddress  string `xml:"customer_gateway>tunnel_inside_address>ip_address"`
+	VgwInsideAddress  string `xml:"vpn_gateway>tunnel_inside_address>ip_address"`
+	CgwOutsideAddress string `xml:"customer_gateway>tunnel_outside_address>ip_address"`
+	CgwBGPASN         string `xml:"customer_gateway>bgp>asn"`
+	InsideNetworkMask string `xml:"vpn_gateway>tunnel_inside_address>network_mask"`
+	InsideNetworkCidr int    `xml:"vpn_gateway>tunnel_inside_address>network_cidr"`
+
+	IkeAuthenticationProtocol string `xml:"ike>authentication_protocol"`
+	IkeEncryptionProtocol     string `xml:"ike>encryption_protocol"`
+	IkeLifetime               int    `xml:"ike>lifetime"`
+	IkePerfectForwardSecrecy  string `xml:"ike>perfect_forward_secrecy"`
+	IkeMode                   string `xml:"ike>mode"`
+
+	IpsecProtocol                      string `xml:"ipsec>protocol"`
+	IpsecAuthenticationProtocol        string `xml:"ipsec>authentication_protocol"`
+	IpsecEncryptionProtocol            string `xml:"ipsec>encryption_protocol"`
+	IpsecLifetime                      int    `xml:"ipsec>lifetime"`
+	IpsecPerfectForwardSecrecy         string `xml:"ipsec>perfect_forward_secrecy"`
+	IpsecMode                          string `xml:"ipsec>mode"`
+	IpsecClearDfBit                    bool   `xml:"ipsec>clear_df_bit"`
+	IpsecFragmentationBeforeEncryption bool   `xml:"ipsec>fragmentation_before_encryption"`
+	IpsecTCPMSSAdjustment              int    `xml:"ipsec>tcp_mss_adjustment"`
+	DPDDelay                           int    `xml:"ipsec>dead_peer_detection>delay"`
+	DPDRetry                           int    `xml:"ipsec>dead_peer_detection>retry"`
 }
 
 type TunnelInfo struct {
@@ -530,7 +556,9 @@ func telemetryToMapList(telemetry []*ec2.VgwTelemetry) []map[string]interface{}
 	return result
 }
 
-func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
+// parseXmlVpnConnectionConfig unmarshals the customer gateway configuration
+// of a VPN connection, ordering the tunnels by their outside address.
+func parseXmlVpnConnectionConfig(xmlConfig string) (*XmlVpnConnectionConfig, error) {
 	var vpnConfig XmlVpnConnectionConfig
 	if err := xml.Unmarshal([]byte(xmlConfig), &vpnConfig); err != nil {
 		return nil, errwrap.Wrapf("Error Unmarshalling XML: {{err}}", err)
@@ -539,6 +567,15 @@ func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
 	// don't expect consistent ordering from the XML
 	sort.Sort(vpnConfig)
 
+	return &vpnConfig, nil
+}
+
+func xmlConfigToTunnelInfo(xmlConfig string) (*TunnelInfo, error) {
+	vpnConfig, err := parseXmlVpnConnectionConfig(xmlConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	tunnelInfo := TunnelInfo{
 		Tunnel1Address:          vpnConfig.Tunnels[0].OutsideAddress,
 		Tunnel1PreSharedKey:     vpnConfig.Tunnels[0].PreSharedKey,
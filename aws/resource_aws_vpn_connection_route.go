@@ -21,6 +21,9 @@ func resourceAwsVpnConnectionRoute() *schema.Resource {
 		Create: resourceAwsVpnConnectionRouteCreate,
 		Read:   resourceAwsVpnConnectionRouteRead,
 		Delete: resourceAwsVpnConnectionRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnConnectionRouteImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": &schema.Schema{
@@ -38,6 +41,18 @@ func resourceAwsVpnConnectionRoute() *schema.Resource {
 	}
 }
 
+func resourceAwsVpnConnectionRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected CIDR-BLOCK:VPN-CONNECTION-ID", d.Id())
+	}
+
+	d.Set("destination_cidr_block", idParts[0])
+	d.Set("vpn_connection_id", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVpnConnectionRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -31,6 +31,12 @@ func TestAccAWSVpnConnectionRoute_basic(t *testing.T) {
 					),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_vpn_connection_route.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_vpn_connection_route.foo", ":", "destination_cidr_block", "vpn_connection_id"),
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccAwsVpnConnectionRouteConfigUpdate(rBgpAsn),
 				Check: resource.ComposeTestCheckFunc(
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsVpnGatewayAttachment() *schema.Resource {
 		Create: resourceAwsVpnGatewayAttachmentCreate,
 		Read:   resourceAwsVpnGatewayAttachmentRead,
 		Delete: resourceAwsVpnGatewayAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnGatewayAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -34,6 +38,19 @@ func resourceAwsVpnGatewayAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsVpnGatewayAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPC-ID:VPN-GATEWAY-ID", d.Id())
+	}
+
+	d.Set("vpc_id", idParts[0])
+	d.Set("vpn_gateway_id", idParts[1])
+	d.SetId(vpnGatewayAttachmentId(idParts[0], idParts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVpnGatewayAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
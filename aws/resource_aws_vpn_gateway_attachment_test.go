@@ -34,6 +34,12 @@ func TestAccAWSVpnGatewayAttachment_basic(t *testing.T) {
 						&vpc, &vgw),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_vpn_gateway_attachment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_vpn_gateway_attachment.test", ":", "vpc_id", "vpn_gateway_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
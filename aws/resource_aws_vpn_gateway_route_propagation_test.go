@@ -53,6 +53,12 @@ func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
 					return nil
 				},
 			},
+			{
+				ResourceName:      "aws_vpn_gateway_route_propagation.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_vpn_gateway_route_propagation.foo", "_", "vpn_gateway_id", "route_table_id"),
+				ImportStateVerify: true,
+			},
 		},
 		CheckDestroy: func(state *terraform.State) error {
 			conn := testAccProvider.Meta().(*AWSClient).ec2conn
@@ -16,6 +16,9 @@ func resourceAwsWafGeoMatchSet() *schema.Resource {
 		Read:   resourceAwsWafGeoMatchSetRead,
 		Update: resourceAwsWafGeoMatchSetUpdate,
 		Delete: resourceAwsWafGeoMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -35,6 +35,11 @@ func TestAccAWSWafIPSet_basic(t *testing.T) {
 						"aws_waf_ipset.ipset", "ip_set_descriptors.4037960608.value", "192.0.7.0/24"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_waf_ipset.ipset",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -45,6 +45,11 @@ func TestAccAWSWafSizeConstraintSet_basic(t *testing.T) {
 						"aws_waf_size_constraint_set.size_constraint_set", "size_constraints.2029852522.text_transformation", "NONE"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_waf_size_constraint_set.size_constraint_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
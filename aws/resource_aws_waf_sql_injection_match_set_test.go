@@ -41,6 +41,11 @@ func TestAccAWSWafSqlInjectionMatchSet_basic(t *testing.T) {
 						"aws_waf_sql_injection_match_set.sql_injection_match_set", "sql_injection_match_tuples.3367958210.text_transformation", "URL_DECODE"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_waf_sql_injection_match_set.sql_injection_match_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func resourceAwsWafWebAcl() *schema.Resource {
 		Read:   resourceAwsWafWebAclRead,
 		Update: resourceAwsWafWebAclUpdate,
 		Delete: resourceAwsWafWebAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -132,6 +135,9 @@ func resourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("name", resp.WebACL.Name)
 	d.Set("metric_name", resp.WebACL.MetricName)
+	if err := d.Set("rules", flattenWafActivatedRules(resp.WebACL.Rules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
 
 	return nil
 }
@@ -237,3 +243,16 @@ func flattenDefaultAction(n *waf.WafAction) []map[string]interface{} {
 	m.SetString("type", n.Type)
 	return m.MapList()
 }
+
+func flattenWafActivatedRules(ts []*waf.ActivatedRule) []interface{} {
+	out := make([]interface{}, len(ts), len(ts))
+	for i, r := range ts {
+		m := make(map[string]interface{})
+		m["action"] = flattenDefaultAction(r.Action)
+		m["priority"] = int(aws.Int64Value(r.Priority))
+		m["rule_id"] = aws.StringValue(r.RuleId)
+		m["type"] = aws.StringValue(r.Type)
+		out[i] = m
+	}
+	return out
+}
@@ -49,6 +49,11 @@ func TestAccAWSWafXssMatchSet_basic(t *testing.T) {
 						"aws_waf_xss_match_set.xss_match_set", "xss_match_tuples.2786024938.text_transformation", "NONE"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_waf_xss_match_set.xss_match_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
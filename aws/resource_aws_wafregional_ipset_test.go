@@ -36,6 +36,11 @@ func TestAccAWSWafRegionalIPSet_basic(t *testing.T) {
 						"aws_wafregional_ipset.ipset", "ip_set_descriptor.4037960608.value", "192.0.7.0/24"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_wafregional_ipset.ipset",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
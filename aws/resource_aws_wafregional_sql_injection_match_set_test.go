@@ -39,6 +39,11 @@ func TestAccAWSWafRegionalSqlInjectionMatchSet_basic(t *testing.T) {
 						"aws_wafregional_sql_injection_match_set.sql_injection_match_set", "sql_injection_match_tuple.1913782288.text_transformation", "URL_DECODE"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_wafregional_sql_injection_match_set.sql_injection_match_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -16,6 +16,9 @@ func resourceAwsWafRegionalWebAcl() *schema.Resource {
 		Read:   resourceAwsWafRegionalWebAclRead,
 		Update: resourceAwsWafRegionalWebAclUpdate,
 		Delete: resourceAwsWafRegionalWebAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -17,6 +17,9 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 		Create: resourceAwsWafRegionalWebAclAssociationCreate,
 		Read:   resourceAwsWafRegionalWebAclAssociationRead,
 		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafRegionalWebAclAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"web_acl_id": &schema.Schema{
@@ -33,6 +36,18 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 	}
 }
 
+func resourceAwsWafRegionalWebAclAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected WEB-ACL-ID:RESOURCE-ARN", d.Id())
+	}
+
+	d.Set("web_acl_id", idParts[0])
+	d.Set("resource_arn", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).wafregionalconn
 
@@ -24,6 +24,12 @@ func TestAccAWSWafRegionalWebAclAssociation_basic(t *testing.T) {
 					testAccCheckWafRegionalWebAclAssociationExists("aws_wafregional_web_acl_association.foo"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_wafregional_web_acl_association.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSImportStateIdFuncWithSeparator("aws_wafregional_web_acl_association.foo", ":", "web_acl_id", "resource_arn"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
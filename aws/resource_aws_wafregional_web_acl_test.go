@@ -39,6 +39,11 @@ func TestAccAWSWafRegionalWebAcl_basic(t *testing.T) {
 						"aws_wafregional_web_acl.waf_acl", "metric_name", wafAclName),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_wafregional_web_acl.waf_acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
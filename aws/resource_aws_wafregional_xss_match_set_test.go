@@ -48,6 +48,11 @@ func TestAccAWSWafRegionalXssMatchSet_basic(t *testing.T) {
 						"aws_wafregional_xss_match_set.xss_match_set", "xss_match_tuple.2786024938.text_transformation", "NONE"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_wafregional_xss_match_set.xss_match_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,277 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// Defaults used once either backoff bound is configured, matching the
+	// bounds of the SDK default retryer.
+	retryDefaultMinBackoff = 30 * time.Millisecond
+	retryDefaultMaxBackoff = 5 * time.Minute
+)
+
+// retryConfig holds the provider retry configuration.
+type retryConfig struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+	Jitter      bool
+	RateLimit   float64
+	Burst       int
+	Services    map[string]*retryServiceConfig
+}
+
+// retryServiceConfig holds the retry settings for a single service client.
+type retryServiceConfig struct {
+	RetryableErrorCodes []string
+	RateLimit           float64
+	Burst               int
+}
+
+// expandRetryConfig reads the provider retry block.
+func expandRetryConfig(l []interface{}) (*retryConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &retryConfig{
+		MaxAttempts: m["max_attempts"].(int),
+		Jitter:      m["jitter"].(bool),
+		RateLimit:   m["rate_limit"].(float64),
+		Burst:       m["burst"].(int),
+		Services:    make(map[string]*retryServiceConfig),
+	}
+
+	var err error
+	if v, ok := m["min_backoff"].(string); ok && v != "" {
+		if config.MinBackoff, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("error parsing retry min_backoff: %s", err)
+		}
+	}
+	if v, ok := m["max_backoff"].(string); ok && v != "" {
+		if config.MaxBackoff, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("error parsing retry max_backoff: %s", err)
+		}
+	}
+	if config.RateLimit < 0 {
+		return nil, fmt.Errorf("retry rate_limit must not be negative")
+	}
+	if config.MinBackoff > 0 && config.MaxBackoff > 0 && config.MinBackoff > config.MaxBackoff {
+		return nil, fmt.Errorf("retry min_backoff (%s) must not exceed max_backoff (%s)", config.MinBackoff, config.MaxBackoff)
+	}
+
+	if v, ok := m["service"].(*schema.Set); ok {
+		for _, raw := range v.List() {
+			s := raw.(map[string]interface{})
+			name := s["name"].(string)
+			if _, ok := config.Services[name]; ok {
+				return nil, fmt.Errorf("retry service %q is configured more than once", name)
+			}
+
+			service := &retryServiceConfig{
+				RateLimit: s["rate_limit"].(float64),
+				Burst:     s["burst"].(int),
+			}
+			if service.RateLimit < 0 {
+				return nil, fmt.Errorf("retry service %q rate_limit must not be negative", name)
+			}
+			if codes, ok := s["retryable_error_codes"].(*schema.Set); ok {
+				for _, code := range codes.List() {
+					service.RetryableErrorCodes = append(service.RetryableErrorCodes, code.(string))
+				}
+			}
+			config.Services[name] = service
+		}
+	}
+
+	return config, nil
+}
+
+// apply installs the retryer and rate limiter on every service client.
+// maxRetries is the provider max_retries, used when max_attempts is unset.
+func (c *retryConfig) apply(clients map[string]*client.Client, maxRetries int) error {
+	for name := range c.Services {
+		if _, ok := clients[name]; !ok {
+			return fmt.Errorf("retry service %q is not a known service client", name)
+		}
+	}
+
+	if c.MaxAttempts > 0 {
+		maxRetries = c.MaxAttempts - 1
+	}
+
+	for name, cl := range clients {
+		retryer := awsRetryer{
+			DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+			minBackoff:     c.MinBackoff,
+			maxBackoff:     c.MaxBackoff,
+			jitter:         c.Jitter,
+		}
+		rate, burst := c.RateLimit, c.Burst
+
+		if s, ok := c.Services[name]; ok {
+			retryer.errorCodes = s.RetryableErrorCodes
+			if s.RateLimit > 0 {
+				rate, burst = s.RateLimit, s.Burst
+			}
+		}
+
+		cl.Retryer = retryer
+		if rate > 0 {
+			bucket := newTokenBucket(rate, burst)
+			log.Printf("[DEBUG] Limiting %s API requests to %g per second (burst %g)", name, bucket.rate, bucket.burst)
+			cl.Handlers.Sign.PushFrontNamed(bucket.handler())
+		}
+	}
+
+	return nil
+}
+
+// awsRetryer extends the SDK default retryer with the backoff bounds, jitter
+// and additional retryable error codes from the provider retry block.
+type awsRetryer struct {
+	client.DefaultRetryer
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	jitter     bool
+	errorCodes []string
+}
+
+// ShouldRetry returns true if the request should be retried.
+func (r awsRetryer) ShouldRetry(req *request.Request) bool {
+	if req.Retryable == nil {
+		if err, ok := req.Error.(awserr.Error); ok {
+			for _, code := range r.errorCodes {
+				if err.Code() == code {
+					return true
+				}
+			}
+		}
+	}
+
+	return r.DefaultRetryer.ShouldRetry(req)
+}
+
+// RetryRules returns the delay before the request is retried. Without
+// configured backoff bounds the SDK default behaviour is kept.
+func (r awsRetryer) RetryRules(req *request.Request) time.Duration {
+	if r.minBackoff == 0 && r.maxBackoff == 0 {
+		return r.DefaultRetryer.RetryRules(req)
+	}
+
+	return r.backoff(req.RetryCount)
+}
+
+// backoff returns the exponential delay for the given retry, capped at the
+// maximum backoff. With jitter the delay is randomised over its upper half.
+func (r awsRetryer) backoff(retryCount int) time.Duration {
+	minBackoff, maxBackoff := r.minBackoff, r.maxBackoff
+	if minBackoff == 0 {
+		minBackoff = retryDefaultMinBackoff
+	}
+	if maxBackoff == 0 {
+		maxBackoff = retryDefaultMaxBackoff
+	}
+
+	delay := maxBackoff
+	if retryCount < 32 {
+		if d := minBackoff << uint(retryCount); d > 0 && d < maxBackoff {
+			delay = d
+		}
+	}
+
+	if r.jitter && delay > 1 {
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+
+	return delay
+}
+
+// tokenBucket is a client-side rate limiter shared by every request made
+// through a single service client.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before
+// it may be used.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or the context is done.
+func (b *tokenBucket) wait(ctx aws.Context) error {
+	d := b.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// handler returns a request handler that waits on the bucket before each
+// attempt is signed and sent.
+func (b *tokenBucket) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			if err := b.wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request canceled while rate limited", err)
+			}
+		},
+	}
+}
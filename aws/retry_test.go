@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestExpandRetryConfig(t *testing.T) {
+	l := []interface{}{
+		map[string]interface{}{
+			"max_attempts": 10,
+			"min_backoff":  "100ms",
+			"max_backoff":  "30s",
+			"jitter":       false,
+			"rate_limit":   20.0,
+			"burst":        40,
+			"service": schema.NewSet(func(v interface{}) int { return schema.HashString(v.(map[string]interface{})["name"]) }, []interface{}{
+				map[string]interface{}{
+					"name":                  "ec2",
+					"retryable_error_codes": schema.NewSet(schema.HashString, []interface{}{"RequestLimitExceeded"}),
+					"rate_limit":            5.0,
+					"burst":                 0,
+				},
+			}),
+		},
+	}
+
+	config, err := expandRetryConfig(l)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if config.MaxAttempts != 10 {
+		t.Fatalf("expected max attempts 10, got %d", config.MaxAttempts)
+	}
+	if config.MinBackoff != 100*time.Millisecond || config.MaxBackoff != 30*time.Second {
+		t.Fatalf("unexpected backoff bounds: %s, %s", config.MinBackoff, config.MaxBackoff)
+	}
+	if config.Jitter {
+		t.Fatal("expected jitter to be disabled")
+	}
+	if config.RateLimit != 20 || config.Burst != 40 {
+		t.Fatalf("unexpected rate limit: %g, %d", config.RateLimit, config.Burst)
+	}
+
+	ec2, ok := config.Services["ec2"]
+	if !ok {
+		t.Fatal("expected ec2 service configuration")
+	}
+	if len(ec2.RetryableErrorCodes) != 1 || ec2.RetryableErrorCodes[0] != "RequestLimitExceeded" {
+		t.Fatalf("unexpected ec2 retryable error codes: %v", ec2.RetryableErrorCodes)
+	}
+	if ec2.RateLimit != 5 {
+		t.Fatalf("expected ec2 rate limit 5, got %g", ec2.RateLimit)
+	}
+
+	if config, err := expandRetryConfig(nil); config != nil || err != nil {
+		t.Fatalf("expected nil config for empty block, got %#v, %s", config, err)
+	}
+}
+
+func TestExpandRetryConfig_invalidBackoff(t *testing.T) {
+	l := []interface{}{
+		map[string]interface{}{
+			"max_attempts": 0,
+			"min_backoff":  "1m",
+			"max_backoff":  "1s",
+			"jitter":       true,
+			"rate_limit":   0.0,
+			"burst":        0,
+		},
+	}
+
+	if _, err := expandRetryConfig(l); err == nil {
+		t.Fatal("expected error when min_backoff exceeds max_backoff")
+	}
+}
+
+func TestRetryConfigApply(t *testing.T) {
+	ec2 := &client.Client{}
+	s3 := &client.Client{}
+	clients := map[string]*client.Client{"ec2": ec2, "s3": s3}
+
+	config := &retryConfig{
+		MaxAttempts: 5,
+		Services: map[string]*retryServiceConfig{
+			"ec2": {RetryableErrorCodes: []string{"RequestLimitExceeded"}, RateLimit: 10},
+		},
+	}
+	if err := config.apply(clients, 25); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if ec2.Retryer.MaxRetries() != 4 || s3.Retryer.MaxRetries() != 4 {
+		t.Fatalf("expected max attempts to override max_retries, got %d and %d", ec2.Retryer.MaxRetries(), s3.Retryer.MaxRetries())
+	}
+	if ec2.Handlers.Sign.Len() != 1 {
+		t.Fatalf("expected ec2 rate limit handler, got %d sign handlers", ec2.Handlers.Sign.Len())
+	}
+	if s3.Handlers.Sign.Len() != 0 {
+		t.Fatalf("expected no s3 rate limit handler, got %d sign handlers", s3.Handlers.Sign.Len())
+	}
+
+	config = &retryConfig{Services: map[string]*retryServiceConfig{"nope": {}}}
+	if err := config.apply(clients, 25); err == nil {
+		t.Fatal("expected error for unknown service")
+	}
+}
+
+func TestAwsRetryerShouldRetry(t *testing.T) {
+	retryer := awsRetryer{errorCodes: []string{"RequestLimitExceeded"}}
+
+	cases := []struct {
+		Code      string
+		Retryable *bool
+		Expected  bool
+	}{
+		{Code: "RequestLimitExceeded", Expected: true},
+		{Code: "InvalidParameterValue", Expected: false},
+		{Code: "RequestLimitExceeded", Retryable: aws.Bool(false), Expected: false},
+	}
+
+	for _, tc := range cases {
+		req := &request.Request{
+			Error:        awserr.New(tc.Code, "", nil),
+			HTTPResponse: &http.Response{StatusCode: 400},
+			Retryable:    tc.Retryable,
+		}
+		if actual := retryer.ShouldRetry(req); actual != tc.Expected {
+			t.Fatalf("%s: expected %t, got %t", tc.Code, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAwsRetryerBackoff(t *testing.T) {
+	retryer := awsRetryer{minBackoff: 100 * time.Millisecond, maxBackoff: time.Second}
+
+	cases := []struct {
+		RetryCount int
+		Expected   time.Duration
+	}{
+		{RetryCount: 0, Expected: 100 * time.Millisecond},
+		{RetryCount: 1, Expected: 200 * time.Millisecond},
+		{RetryCount: 3, Expected: 800 * time.Millisecond},
+		{RetryCount: 4, Expected: time.Second},
+		{RetryCount: 100, Expected: time.Second},
+	}
+
+	for _, tc := range cases {
+		if actual := retryer.backoff(tc.RetryCount); actual != tc.Expected {
+			t.Fatalf("retry %d: expected %s, got %s", tc.RetryCount, tc.Expected, actual)
+		}
+	}
+
+	retryer.jitter = true
+	for i := 0; i < 100; i++ {
+		if d := retryer.backoff(3); d < 400*time.Millisecond || d > 800*time.Millisecond {
+			t.Fatalf("expected jittered delay between 400ms and 800ms, got %s", d)
+		}
+	}
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newTokenBucket(2, 2)
+	b.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if d := b.reserve(); d != 0 {
+			t.Fatalf("expected burst request %d not to wait, got %s", i, d)
+		}
+	}
+	if d := b.reserve(); d != 500*time.Millisecond {
+		t.Fatalf("expected to wait 500ms once the burst is used, got %s", d)
+	}
+
+	now = now.Add(2 * time.Second)
+	if d := b.reserve(); d != 0 {
+		t.Fatalf("expected refilled bucket not to wait, got %s", d)
+	}
+}
@@ -3436,6 +3436,57 @@ func flattenDynamoDbTtl(ttlDesc *dynamodb.TimeToLiveDescription) []interface{} {
 	return []interface{}{}
 }
 
+func flattenDynamoDbPitr(output *dynamodb.DescribeContinuousBackupsOutput) []interface{} {
+	if output == nil || output.ContinuousBackupsDescription == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.StringValue(output.ContinuousBackupsDescription.ContinuousBackupsStatus) == dynamodb.ContinuousBackupsStatusEnabled,
+	}
+
+	return []interface{}{m}
+}
+
+// flattenDynamoDbGlobalSecondaryIndexes returns the indexes as
+// global_secondary_index set elements, as read into state and as passed to
+// diffDynamoDbGSI.
+func flattenDynamoDbGlobalSecondaryIndexes(gsis []*dynamodb.GlobalSecondaryIndexDescription) []interface{} {
+	result := make([]interface{}, 0, len(gsis))
+
+	for _, gsiObject := range gsis {
+		gsi := map[string]interface{}{
+			"name":               aws.StringValue(gsiObject.IndexName),
+			"write_capacity":     int(aws.Int64Value(gsiObject.ProvisionedThroughput.WriteCapacityUnits)),
+			"read_capacity":      int(aws.Int64Value(gsiObject.ProvisionedThroughput.ReadCapacityUnits)),
+			"hash_key":           "",
+			"range_key":          "",
+			"projection_type":    aws.StringValue(gsiObject.Projection.ProjectionType),
+			"non_key_attributes": []interface{}{},
+		}
+
+		for _, attribute := range gsiObject.KeySchema {
+			if *attribute.KeyType == dynamodb.KeyTypeHash {
+				gsi["hash_key"] = *attribute.AttributeName
+			}
+
+			if *attribute.KeyType == dynamodb.KeyTypeRange {
+				gsi["range_key"] = *attribute.AttributeName
+			}
+		}
+
+		nonKeyAttrs := make([]interface{}, 0, len(gsiObject.Projection.NonKeyAttributes))
+		for _, nonKeyAttr := range gsiObject.Projection.NonKeyAttributes {
+			nonKeyAttrs = append(nonKeyAttrs, *nonKeyAttr)
+		}
+		gsi["non_key_attributes"] = nonKeyAttrs
+
+		result = append(result, gsi)
+	}
+
+	return result
+}
+
 func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.TableDescription) error {
 	d.Set("write_capacity", table.ProvisionedThroughput.WriteCapacityUnits)
 	d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
@@ -3489,35 +3540,6 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.Tab
 		return err
 	}
 
-	gsiList := make([]map[string]interface{}, 0, len(table.GlobalSecondaryIndexes))
-	for _, gsiObject := range table.GlobalSecondaryIndexes {
-		gsi := map[string]interface{}{
-			"write_capacity": *gsiObject.ProvisionedThroughput.WriteCapacityUnits,
-			"read_capacity":  *gsiObject.ProvisionedThroughput.ReadCapacityUnits,
-			"name":           *gsiObject.IndexName,
-		}
-
-		for _, attribute := range gsiObject.KeySchema {
-			if *attribute.KeyType == dynamodb.KeyTypeHash {
-				gsi["hash_key"] = *attribute.AttributeName
-			}
-
-			if *attribute.KeyType == dynamodb.KeyTypeRange {
-				gsi["range_key"] = *attribute.AttributeName
-			}
-		}
-
-		gsi["projection_type"] = *(gsiObject.Projection.ProjectionType)
-
-		nonKeyAttrs := make([]string, 0, len(gsiObject.Projection.NonKeyAttributes))
-		for _, nonKeyAttr := range gsiObject.Projection.NonKeyAttributes {
-			nonKeyAttrs = append(nonKeyAttrs, *nonKeyAttr)
-		}
-		gsi["non_key_attributes"] = nonKeyAttrs
-
-		gsiList = append(gsiList, gsi)
-	}
-
 	if table.StreamSpecification != nil {
 		d.Set("stream_view_type", table.StreamSpecification.StreamViewType)
 		d.Set("stream_enabled", table.StreamSpecification.StreamEnabled)
@@ -3529,7 +3551,7 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.Tab
 	d.Set("stream_arn", table.LatestStreamArn)
 	d.Set("stream_label", table.LatestStreamLabel)
 
-	err = d.Set("global_secondary_index", gsiList)
+	err = d.Set("global_secondary_index", flattenDynamoDbGlobalSecondaryIndexes(table.GlobalSecondaryIndexes))
 	if err != nil {
 		return err
 	}
@@ -3653,6 +3675,30 @@ func flattenVpcEndpointServiceAllowedPrincipals(allowedPrincipals []*ec2.Allowed
 	return result
 }
 
+// flattenVpcCidrBlockAssociations flattens the IPv4 CIDR blocks associated
+// with a VPC, skipping those that have been disassociated or failed to
+// associate.
+func flattenVpcCidrBlockAssociations(associations []*ec2.VpcCidrBlockAssociation) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(associations))
+	for _, a := range associations {
+		state := ""
+		if a.CidrBlockState != nil {
+			state = aws.StringValue(a.CidrBlockState.State)
+		}
+		switch state {
+		case ec2.VpcCidrBlockStateCodeDisassociated, ec2.VpcCidrBlockStateCodeFailed:
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"association_id": aws.StringValue(a.AssociationId),
+			"cidr_block":     aws.StringValue(a.CidrBlock),
+			"state":          state,
+		})
+	}
+	return result
+}
+
 func expandDynamoDbTableItemAttributes(input string) (map[string]*dynamodb.AttributeValue, error) {
 	var attributes map[string]*dynamodb.AttributeValue
 
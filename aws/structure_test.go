@@ -1443,3 +1443,55 @@ const testExampleXML_from_msdn_flawed = `
     </items>
 </purchaseOrder>
 `
+
+func TestFlattenVpcCidrBlockAssociations(t *testing.T) {
+	associations := []*ec2.VpcCidrBlockAssociation{
+		{
+			AssociationId: aws.String("vpc-cidr-assoc-primary"),
+			CidrBlock:     aws.String("10.0.0.0/16"),
+			CidrBlockState: &ec2.VpcCidrBlockState{
+				State: aws.String(ec2.VpcCidrBlockStateCodeAssociated),
+			},
+		},
+		{
+			AssociationId: aws.String("vpc-cidr-assoc-removed"),
+			CidrBlock:     aws.String("10.1.0.0/16"),
+			CidrBlockState: &ec2.VpcCidrBlockState{
+				State: aws.String(ec2.VpcCidrBlockStateCodeDisassociated),
+			},
+		},
+		{
+			AssociationId: aws.String("vpc-cidr-assoc-failed"),
+			CidrBlock:     aws.String("192.168.0.0/16"),
+			CidrBlockState: &ec2.VpcCidrBlockState{
+				State:         aws.String(ec2.VpcCidrBlockStateCodeFailed),
+				StatusMessage: aws.String("restricted CIDR block"),
+			},
+		},
+		{
+			AssociationId: aws.String("vpc-cidr-assoc-secondary"),
+			CidrBlock:     aws.String("10.2.0.0/16"),
+			CidrBlockState: &ec2.VpcCidrBlockState{
+				State: aws.String(ec2.VpcCidrBlockStateCodeAssociating),
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"association_id": "vpc-cidr-assoc-primary",
+			"cidr_block":     "10.0.0.0/16",
+			"state":          "associated",
+		},
+		{
+			"association_id": "vpc-cidr-assoc-secondary",
+			"cidr_block":     "10.2.0.0/16",
+			"state":          "associating",
+		},
+	}
+
+	result := flattenVpcCidrBlockAssociations(associations)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+}
@@ -52,8 +52,8 @@ func TestDiffBeanstalkTags(t *testing.T) {
 		c, r := diffTagsBeanstalk(tagsFromMapBeanstalk(tc.Old), tagsFromMapBeanstalk(tc.New))
 		cm := tagsToMapBeanstalk(c)
 		rl := []string{}
-		for _, tagName := range r {
-			rl = append(rl, *tagName)
+		for _, tag := range r {
+			rl = append(rl, *tag.Key)
 		}
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// tagsSchemaAll returns the schema to use for the full set of tags applied
+// to a resource, including those inherited from the provider default_tags.
+func tagsSchemaAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+// resourceDefaultTags extends a resource with a top-level "tags" map so that
+// the provider default_tags are applied to it and the provider ignore_tags
+// are left alone.
+//
+// The tags actually sent to AWS are tracked in the computed "tags_all"
+// attribute, which is what the tag helpers diff against when creating and
+// updating tags. "tags" only ever holds the tags configured on the resource
+// itself, so inherited tags never show up as drift. Ignored tags are kept out
+// of both.
+func resourceDefaultTags(r *schema.Resource) {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional {
+		return
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
+
+	r.Schema["tags_all"] = tagsSchemaAll()
+
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, setTagsAllDiff(s.ForceNew))
+	} else {
+		r.CustomizeDiff = setTagsAllDiff(s.ForceNew)
+	}
+
+	create, read, update := r.Create, r.Read, r.Update
+
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		configured := d.Get("tags").(map[string]interface{})
+		if err := d.Set("tags_all", providerTags(meta, configured)); err != nil {
+			return err
+		}
+
+		err := create(d, meta)
+		if d.Id() != "" {
+			if serr := setTagsAllState(d, meta, configured, true); serr != nil && err == nil {
+				err = serr
+			}
+		}
+		return err
+	}
+
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		configured := d.Get("tags").(map[string]interface{})
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			return nil
+		}
+		return setTagsAllState(d, meta, configured, false)
+	}
+
+	if update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			if err := d.Set("tags_all", providerTags(meta, configured)); err != nil {
+				return err
+			}
+
+			err := update(d, meta)
+			if d.Id() != "" {
+				if serr := setTagsAllState(d, meta, configured, true); serr != nil && err == nil {
+					err = serr
+				}
+			}
+			return err
+		}
+	}
+}
+
+// setTagsAllDiff returns a CustomizeDiffFunc planning "tags_all" as the
+// resource tags merged over the provider default_tags.
+func setTagsAllDiff(forceNew bool) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if _, ok := diff.GetOk("tags.%"); !ok && len(diff.GetChangedKeysPrefix("tags.")) > 0 {
+			// The tags are not known until apply.
+			return diff.SetNewComputed("tags_all")
+		}
+
+		configured := diff.Get("tags").(map[string]interface{})
+		tags := providerTags(meta, configured)
+		o, _ := diff.GetChange("tags_all")
+		if tagsMapsEqual(o.(map[string]interface{}), tags) {
+			return nil
+		}
+
+		if err := diff.SetNew("tags_all", tags); err != nil {
+			return err
+		}
+		if forceNew && diff.Id() != "" {
+			return diff.ForceNew("tags_all")
+		}
+
+		return nil
+	}
+}
+
+// setTagsAllState records the tags read back from AWS in "tags_all" and
+// leaves only those configured on the resource in "tags".
+func setTagsAllState(d *schema.ResourceData, meta interface{}, configured map[string]interface{}, merge bool) error {
+	tags := d.Get("tags").(map[string]interface{})
+	if merge {
+		// The tags have not necessarily been read back yet.
+		tags = mergeDefaultTags(meta, tags)
+	}
+	tags = removeIgnoredTags(meta, tags)
+
+	if err := d.Set("tags_all", tags); err != nil {
+		return err
+	}
+	return d.Set("tags", removeDefaultTags(meta, tags, configured))
+}
+
+// providerTags returns the tags to apply to a resource given the tags
+// configured on it.
+func providerTags(meta interface{}, configured map[string]interface{}) map[string]interface{} {
+	return removeIgnoredTags(meta, mergeDefaultTags(meta, configured))
+}
+
+// mergeDefaultTags returns the provider default_tags overlaid with the given
+// resource tags. Resource tags take precedence.
+func mergeDefaultTags(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range providerDefaultTags(meta) {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeDefaultTags returns the given tags without those inherited from the
+// provider default_tags. A tag is kept if it was configured on the resource
+// or if its value no longer matches the default.
+func removeDefaultTags(meta interface{}, tags, configured map[string]interface{}) map[string]interface{} {
+	defaults := providerDefaultTags(meta)
+
+	result := make(map[string]interface{})
+	for k, v := range tags {
+		if _, ok := configured[k]; !ok {
+			if dv, ok := defaults[k]; ok && dv == v {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+func providerDefaultTags(meta interface{}) map[string]interface{} {
+	if client, ok := meta.(*AWSClient); ok && client != nil {
+		return client.defaulttags
+	}
+	return nil
+}
+
+func tagsMapsEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		Defaults, Tags, Expected map[string]interface{}
+	}{
+		// No defaults
+		{
+			Tags: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// Defaults only
+		{
+			Defaults: map[string]interface{}{
+				"Owner": "ops",
+			},
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Owner": "ops",
+			},
+		},
+
+		// Resource tags take precedence
+		{
+			Defaults: map[string]interface{}{
+				"Owner":       "ops",
+				"Environment": "prod",
+			},
+			Tags: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "dev",
+			},
+			Expected: map[string]interface{}{
+				"Name":        "foo",
+				"Owner":       "dev",
+				"Environment": "prod",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		meta := &AWSClient{defaulttags: tc.Defaults}
+		actual := mergeDefaultTags(meta, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	cases := []struct {
+		Defaults, Tags, Configured, Expected map[string]interface{}
+	}{
+		// Inherited tags are removed
+		{
+			Defaults: map[string]interface{}{
+				"Owner": "ops",
+			},
+			Tags: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "ops",
+			},
+			Configured: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// Tags also configured on the resource are kept
+		{
+			Defaults: map[string]interface{}{
+				"Owner": "ops",
+			},
+			Tags: map[string]interface{}{
+				"Owner": "ops",
+			},
+			Configured: map[string]interface{}{
+				"Owner": "ops",
+			},
+			Expected: map[string]interface{}{
+				"Owner": "ops",
+			},
+		},
+
+		// Tags no longer matching the default are kept as drift
+		{
+			Defaults: map[string]interface{}{
+				"Owner": "ops",
+			},
+			Tags: map[string]interface{}{
+				"Owner": "someone-else",
+			},
+			Configured: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Owner": "someone-else",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		meta := &AWSClient{defaulttags: tc.Defaults}
+		actual := removeDefaultTags(meta, tc.Tags, tc.Configured)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestResourceDefaultTags(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}
+	resourceDefaultTags(r)
+
+	if _, ok := r.Schema["tags_all"]; !ok {
+		t.Fatal("expected tags_all to be added to the schema")
+	}
+	if r.CustomizeDiff == nil {
+		t.Fatal("expected CustomizeDiff to be set")
+	}
+
+	r = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeMap},
+			},
+		},
+	}
+	resourceDefaultTags(r)
+
+	if _, ok := r.Schema["tags_all"]; ok {
+		t.Fatal("expected tags_all not to be added for non-map tags")
+	}
+}
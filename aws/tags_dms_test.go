@@ -20,7 +20,7 @@ func TestDmsTagsToMap(t *testing.T) {
 		},
 	}
 
-	result := dmsTagsToMap(tags)
+	result := tagsToMapDMS(tags)
 
 	for _, tag := range tags {
 		if v, ok := result[*tag.Key]; ok {
@@ -39,7 +39,7 @@ func TestDmsTagsFromMap(t *testing.T) {
 		"test-key-2": "test-value-2",
 	}
 
-	result := dmsTagsFromMap(tagMap)
+	result := tagsFromMapDMS(tagMap)
 
 	for k, v := range tagMap {
 		found := false
@@ -80,9 +80,9 @@ func TestDmsDiffTags(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		ar, rr := dmsDiffTags(dmsTagsFromMap(c.o), dmsTagsFromMap(c.n))
-		a := dmsTagsToMap(ar)
-		r := dmsTagsToMap(rr)
+		ar, rr := diffTagsDMS(tagsFromMapDMS(c.o), tagsFromMapDMS(c.n))
+		a := tagsToMapDMS(ar)
+		r := tagsToMapDMS(rr)
 
 		if !reflect.DeepEqual(a, c.a) {
 			t.Fatalf("Add tags mismatch: Actual %#v; Expected %#v", a, c.a)
@@ -105,10 +105,10 @@ func TestDmsGetTagKeys(t *testing.T) {
 		},
 	}
 
-	result := dmsGetTagKeys(tags)
-	expected := []*string{aws.String("test-key-1"), aws.String("test-key-2")}
+	result := keyValueTagsDMS(tags).Keys()
+	expected := []string{"test-key-1", "test-key-2"}
 
 	if !reflect.DeepEqual(result, expected) {
-		t.Fatalf("Actual %s; Expected %s", aws.StringValueSlice(result), aws.StringValueSlice(expected))
+		t.Fatalf("Actual %s; Expected %s", result, expected)
 	}
 }
@@ -0,0 +1,2850 @@
+// Code generated by internal/tagsgen/main.go; DO NOT EDIT.
+
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// keyValueTagsACM creates keyValueTags from acm service tags.
+func keyValueTagsACM(tags []*acm.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsACM returns acm service tags.
+func (tags keyValueTags) tagsACM() []*acm.Tag {
+	result := make([]*acm.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &acm.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapACM returns the acm service tags for the given map of data.
+func tagsFromMapACM(m map[string]interface{}) []*acm.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsACM()
+}
+
+// tagsToMapACM turns the acm service tags into a map.
+func tagsToMapACM(ts []*acm.Tag) map[string]string {
+	return keyValueTagsACM(ts).IgnoreAws().Map()
+}
+
+// diffTagsACM takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsACM(oldTags, newTags []*acm.Tag) ([]*acm.Tag, []*acm.Tag) {
+	o, n := keyValueTagsACM(oldTags), keyValueTagsACM(newTags)
+
+	return o.Updated(n).tagsACM(), o.Outdated(n).tagsACM()
+}
+
+// tagIgnoredACM returns whether the tag is reserved for use by AWS.
+func tagIgnoredACM(t *acm.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsACM lists acm service tags.
+func listTagsACM(conn *acm.ACM, identifier string) (keyValueTags, error) {
+	input := &acm.ListTagsForCertificateInput{
+		CertificateArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForCertificate(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsACM(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsACM updates acm service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsACM(conn *acm.ACM, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &acm.RemoveTagsFromCertificateInput{
+			CertificateArn: aws.String(identifier),
+			Tags:           removedTags.tagsACM(),
+		}
+
+		log.Printf("[DEBUG] Removing ACM tags: %s", input)
+		_, err := conn.RemoveTagsFromCertificate(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &acm.AddTagsToCertificateInput{
+			CertificateArn: aws.String(identifier),
+			Tags:           updatedTags.tagsACM(),
+		}
+
+		log.Printf("[DEBUG] Adding ACM tags: %s", input)
+		_, err := conn.AddTagsToCertificate(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsACM is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsACM(conn *acm.ACM, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsACM(conn, d.Get("arn").(string), o, n)
+}
+
+// keyValueTagsBeanstalk creates keyValueTags from elasticbeanstalk service tags.
+func keyValueTagsBeanstalk(tags []*elasticbeanstalk.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsBeanstalk returns elasticbeanstalk service tags.
+func (tags keyValueTags) tagsBeanstalk() []*elasticbeanstalk.Tag {
+	result := make([]*elasticbeanstalk.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticbeanstalk.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapBeanstalk returns the elasticbeanstalk service tags for the given map of data.
+func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsBeanstalk()
+}
+
+// tagsToMapBeanstalk turns the elasticbeanstalk service tags into a map.
+func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
+	return keyValueTagsBeanstalk(ts).IgnoreAws().Map()
+}
+
+// diffTagsBeanstalk takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbeanstalk.Tag, []*elasticbeanstalk.Tag) {
+	o, n := keyValueTagsBeanstalk(oldTags), keyValueTagsBeanstalk(newTags)
+
+	return o.Updated(n).tagsBeanstalk(), o.Removed(n).tagsBeanstalk()
+}
+
+// tagIgnoredBeanstalk returns whether the tag is reserved for use by AWS.
+func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsBeanstalk lists elasticbeanstalk service tags.
+func listTagsBeanstalk(conn *elasticbeanstalk.ElasticBeanstalk, identifier string) (keyValueTags, error) {
+	input := &elasticbeanstalk.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsBeanstalk(output.ResourceTags).IgnoreAws(), nil
+}
+
+// updateTagsBeanstalk updates elasticbeanstalk service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsBeanstalk(conn *elasticbeanstalk.ElasticBeanstalk, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &elasticbeanstalk.UpdateTagsForResourceInput{
+			ResourceArn:  aws.String(identifier),
+			TagsToRemove: aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing Beanstalk tags: %s", input)
+		_, err := conn.UpdateTagsForResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &elasticbeanstalk.UpdateTagsForResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagsToAdd:   updatedTags.tagsBeanstalk(),
+		}
+
+		log.Printf("[DEBUG] Adding Beanstalk tags: %s", input)
+		_, err := conn.UpdateTagsForResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// keyValueTagsCloudFront creates keyValueTags from cloudfront service tags.
+func keyValueTagsCloudFront(tags *cloudfront.Tags) keyValueTags {
+	result := make(keyValueTags)
+	if tags == nil {
+		return result
+	}
+	for _, tag := range tags.Items {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsCloudFront returns cloudfront service tags.
+func (tags keyValueTags) tagsCloudFront() *cloudfront.Tags {
+	result := make([]*cloudfront.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &cloudfront.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return &cloudfront.Tags{Items: result}
+}
+
+// tagsFromMapCloudFront returns the cloudfront service tags for the given map of data.
+func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
+	return newKeyValueTags(m).IgnoreAws().tagsCloudFront()
+}
+
+// tagsToMapCloudFront turns the cloudfront service tags into a map.
+func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
+	return keyValueTagsCloudFront(ts).IgnoreAws().Map()
+}
+
+// diffTagsCloudFront takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) (*cloudfront.Tags, *cloudfront.Tags) {
+	o, n := keyValueTagsCloudFront(oldTags), keyValueTagsCloudFront(newTags)
+
+	return o.Updated(n).tagsCloudFront(), o.Outdated(n).tagsCloudFront()
+}
+
+// tagIgnoredCloudFront returns whether the tag is reserved for use by AWS.
+func tagIgnoredCloudFront(t *cloudfront.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsCloudFront lists cloudfront service tags.
+func listTagsCloudFront(conn *cloudfront.CloudFront, identifier string) (keyValueTags, error) {
+	input := &cloudfront.ListTagsForResourceInput{
+		Resource: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsCloudFront(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsCloudFront updates cloudfront service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsCloudFront(conn *cloudfront.CloudFront, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &cloudfront.UntagResourceInput{
+			Resource: aws.String(identifier),
+			TagKeys:  &cloudfront.TagKeys{Items: aws.StringSlice(removedTags.Keys())},
+		}
+
+		log.Printf("[DEBUG] Removing CloudFront tags: %s", input)
+		_, err := conn.UntagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &cloudfront.TagResourceInput{
+			Resource: aws.String(identifier),
+			Tags:     updatedTags.tagsCloudFront(),
+		}
+
+		log.Printf("[DEBUG] Adding CloudFront tags: %s", input)
+		_, err := conn.TagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsCloudFront is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsCloudFront(conn, identifier, o, n)
+}
+
+// keyValueTagsCloudtrail creates keyValueTags from cloudtrail service tags.
+func keyValueTagsCloudtrail(tags []*cloudtrail.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsCloudtrail returns cloudtrail service tags.
+func (tags keyValueTags) tagsCloudtrail() []*cloudtrail.Tag {
+	result := make([]*cloudtrail.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &cloudtrail.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapCloudtrail returns the cloudtrail service tags for the given map of data.
+func tagsFromMapCloudtrail(m map[string]interface{}) []*cloudtrail.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsCloudtrail()
+}
+
+// tagsToMapCloudtrail turns the cloudtrail service tags into a map.
+func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
+	return keyValueTagsCloudtrail(ts).IgnoreAws().Map()
+}
+
+// diffTagsCloudtrail takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsCloudtrail(oldTags, newTags []*cloudtrail.Tag) ([]*cloudtrail.Tag, []*cloudtrail.Tag) {
+	o, n := keyValueTagsCloudtrail(oldTags), keyValueTagsCloudtrail(newTags)
+
+	return o.Updated(n).tagsCloudtrail(), o.Outdated(n).tagsCloudtrail()
+}
+
+// tagIgnoredCloudtrail returns whether the tag is reserved for use by AWS.
+func tagIgnoredCloudtrail(t *cloudtrail.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsCloudtrail updates cloudtrail service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsCloudtrail(conn *cloudtrail.CloudTrail, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &cloudtrail.RemoveTagsInput{
+			ResourceId: aws.String(identifier),
+			TagsList:   removedTags.tagsCloudtrail(),
+		}
+
+		log.Printf("[DEBUG] Removing Cloudtrail tags: %s", input)
+		_, err := conn.RemoveTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &cloudtrail.AddTagsInput{
+			ResourceId: aws.String(identifier),
+			TagsList:   updatedTags.tagsCloudtrail(),
+		}
+
+		log.Printf("[DEBUG] Adding Cloudtrail tags: %s", input)
+		_, err := conn.AddTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsCloudtrail is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsCloudtrail(conn *cloudtrail.CloudTrail, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsCloudtrail(conn, d.Get("arn").(string), o, n)
+}
+
+// keyValueTagsCodeBuild creates keyValueTags from codebuild service tags.
+func keyValueTagsCodeBuild(tags []*codebuild.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsCodeBuild returns codebuild service tags.
+func (tags keyValueTags) tagsCodeBuild() []*codebuild.Tag {
+	result := make([]*codebuild.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &codebuild.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapCodeBuild returns the codebuild service tags for the given map of data.
+func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsCodeBuild()
+}
+
+// tagsToMapCodeBuild turns the codebuild service tags into a map.
+func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
+	return keyValueTagsCodeBuild(ts).IgnoreAws().Map()
+}
+
+// diffTagsCodeBuild takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsCodeBuild(oldTags, newTags []*codebuild.Tag) ([]*codebuild.Tag, []*codebuild.Tag) {
+	o, n := keyValueTagsCodeBuild(oldTags), keyValueTagsCodeBuild(newTags)
+
+	return o.Updated(n).tagsCodeBuild(), o.Outdated(n).tagsCodeBuild()
+}
+
+// tagIgnoredCodeBuild returns whether the tag is reserved for use by AWS.
+func tagIgnoredCodeBuild(t *codebuild.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// keyValueTagsDax creates keyValueTags from dax service tags.
+func keyValueTagsDax(tags []*dax.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsDax returns dax service tags.
+func (tags keyValueTags) tagsDax() []*dax.Tag {
+	result := make([]*dax.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dax.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapDax returns the dax service tags for the given map of data.
+func tagsFromMapDax(m map[string]interface{}) []*dax.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsDax()
+}
+
+// tagsToMapDax turns the dax service tags into a map.
+func tagsToMapDax(ts []*dax.Tag) map[string]string {
+	return keyValueTagsDax(ts).IgnoreAws().Map()
+}
+
+// diffTagsDax takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsDax(oldTags, newTags []*dax.Tag) ([]*dax.Tag, []*dax.Tag) {
+	o, n := keyValueTagsDax(oldTags), keyValueTagsDax(newTags)
+
+	return o.Updated(n).tagsDax(), o.Outdated(n).tagsDax()
+}
+
+// tagIgnoredDax returns whether the tag is reserved for use by AWS.
+func tagIgnoredDax(t *dax.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsDax lists dax service tags.
+func listTagsDax(conn *dax.DAX, identifier string) (keyValueTags, error) {
+	input := &dax.ListTagsInput{
+		ResourceName: aws.String(identifier),
+	}
+
+	output, err := conn.ListTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsDax(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsDax updates dax service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsDax(conn *dax.DAX, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &dax.UntagResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing Dax tags: %s", input)
+		_, err := conn.UntagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &dax.TagResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.tagsDax(),
+		}
+
+		log.Printf("[DEBUG] Adding Dax tags: %s", input)
+		_, err := conn.TagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsDax is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsDax(conn *dax.DAX, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsDax(conn, identifier, o, n)
+}
+
+// keyValueTagsDMS creates keyValueTags from databasemigrationservice service tags.
+func keyValueTagsDMS(tags []*dms.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsDMS returns databasemigrationservice service tags.
+func (tags keyValueTags) tagsDMS() []*dms.Tag {
+	result := make([]*dms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dms.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapDMS returns the databasemigrationservice service tags for the given map of data.
+func tagsFromMapDMS(m map[string]interface{}) []*dms.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsDMS()
+}
+
+// tagsToMapDMS turns the databasemigrationservice service tags into a map.
+func tagsToMapDMS(ts []*dms.Tag) map[string]string {
+	return keyValueTagsDMS(ts).IgnoreAws().Map()
+}
+
+// diffTagsDMS takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsDMS(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
+	o, n := keyValueTagsDMS(oldTags), keyValueTagsDMS(newTags)
+
+	return o.Updated(n).tagsDMS(), o.Outdated(n).tagsDMS()
+}
+
+// tagIgnoredDMS returns whether the tag is reserved for use by AWS.
+func tagIgnoredDMS(t *dms.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsDMS lists databasemigrationservice service tags.
+func listTagsDMS(conn *dms.DatabaseMigrationService, identifier string) (keyValueTags, error) {
+	input := &dms.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsDMS(output.TagList).IgnoreAws(), nil
+}
+
+// updateTagsDMS updates databasemigrationservice service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsDMS(conn *dms.DatabaseMigrationService, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &dms.RemoveTagsFromResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing DMS tags: %s", input)
+		_, err := conn.RemoveTagsFromResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &dms.AddTagsToResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.tagsDMS(),
+		}
+
+		log.Printf("[DEBUG] Adding DMS tags: %s", input)
+		_, err := conn.AddTagsToResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsDMS is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsDMS(conn *dms.DatabaseMigrationService, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsDMS(conn, identifier, o, n)
+}
+
+// keyValueTagsDS creates keyValueTags from directoryservice service tags.
+func keyValueTagsDS(tags []*directoryservice.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsDS returns directoryservice service tags.
+func (tags keyValueTags) tagsDS() []*directoryservice.Tag {
+	result := make([]*directoryservice.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &directoryservice.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapDS returns the directoryservice service tags for the given map of data.
+func tagsFromMapDS(m map[string]interface{}) []*directoryservice.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsDS()
+}
+
+// tagsToMapDS turns the directoryservice service tags into a map.
+func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
+	return keyValueTagsDS(ts).IgnoreAws().Map()
+}
+
+// diffTagsDS takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsDS(oldTags, newTags []*directoryservice.Tag) ([]*directoryservice.Tag, []*directoryservice.Tag) {
+	o, n := keyValueTagsDS(oldTags), keyValueTagsDS(newTags)
+
+	return o.Updated(n).tagsDS(), o.Outdated(n).tagsDS()
+}
+
+// tagIgnoredDS returns whether the tag is reserved for use by AWS.
+func tagIgnoredDS(t *directoryservice.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsDS lists directoryservice service tags.
+func listTagsDS(conn *directoryservice.DirectoryService, identifier string) (keyValueTags, error) {
+	input := &directoryservice.ListTagsForResourceInput{
+		ResourceId: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsDS(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsDS updates directoryservice service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsDS(conn *directoryservice.DirectoryService, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &directoryservice.RemoveTagsFromResourceInput{
+			ResourceId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing DS tags: %s", input)
+		_, err := conn.RemoveTagsFromResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &directoryservice.AddTagsToResourceInput{
+			ResourceId: aws.String(identifier),
+			Tags:       updatedTags.tagsDS(),
+		}
+
+		log.Printf("[DEBUG] Adding DS tags: %s", input)
+		_, err := conn.AddTagsToResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsDS is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsDS(conn, identifier, o, n)
+}
+
+// keyValueTagsDX creates keyValueTags from directconnect service tags.
+func keyValueTagsDX(tags []*directconnect.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsDX returns directconnect service tags.
+func (tags keyValueTags) tagsDX() []*directconnect.Tag {
+	result := make([]*directconnect.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &directconnect.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapDX returns the directconnect service tags for the given map of data.
+func tagsFromMapDX(m map[string]interface{}) []*directconnect.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsDX()
+}
+
+// tagsToMapDX turns the directconnect service tags into a map.
+func tagsToMapDX(ts []*directconnect.Tag) map[string]string {
+	return keyValueTagsDX(ts).IgnoreAws().Map()
+}
+
+// diffTagsDX takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsDX(oldTags, newTags []*directconnect.Tag) ([]*directconnect.Tag, []*directconnect.Tag) {
+	o, n := keyValueTagsDX(oldTags), keyValueTagsDX(newTags)
+
+	return o.Updated(n).tagsDX(), o.Outdated(n).tagsDX()
+}
+
+// tagIgnoredDX returns whether the tag is reserved for use by AWS.
+func tagIgnoredDX(t *directconnect.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsDX updates directconnect service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsDX(conn *directconnect.DirectConnect, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &directconnect.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing DX tags: %s", input)
+		_, err := conn.UntagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &directconnect.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.tagsDX(),
+		}
+
+		log.Printf("[DEBUG] Adding DX tags: %s", input)
+		_, err := conn.TagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsDX is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsDX(conn, identifier, o, n)
+}
+
+// keyValueTagsDynamoDb creates keyValueTags from dynamodb service tags.
+func keyValueTagsDynamoDb(tags []*dynamodb.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsDynamoDb returns dynamodb service tags.
+func (tags keyValueTags) tagsDynamoDb() []*dynamodb.Tag {
+	result := make([]*dynamodb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapDynamoDb returns the dynamodb service tags for the given map of data.
+func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsDynamoDb()
+}
+
+// tagsToMapDynamoDb turns the dynamodb service tags into a map.
+func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
+	return keyValueTagsDynamoDb(ts).IgnoreAws().Map()
+}
+
+// diffTagsDynamoDb takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*dynamodb.Tag) {
+	o, n := keyValueTagsDynamoDb(oldTags), keyValueTagsDynamoDb(newTags)
+
+	return o.Updated(n).tagsDynamoDb(), o.Outdated(n).tagsDynamoDb()
+}
+
+// tagIgnoredDynamoDb returns whether the tag is reserved for use by AWS.
+func tagIgnoredDynamoDb(t *dynamodb.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsDynamoDb lists dynamodb service tags.
+func listTagsDynamoDb(conn *dynamodb.DynamoDB, identifier string) (keyValueTags, error) {
+	input := &dynamodb.ListTagsOfResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsOfResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsDynamoDb(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsDynamoDb updates dynamodb service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsDynamoDb(conn *dynamodb.DynamoDB, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &dynamodb.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing DynamoDb tags: %s", input)
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.UntagResource(input)
+			if err != nil {
+				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &dynamodb.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.tagsDynamoDb(),
+		}
+
+		log.Printf("[DEBUG] Adding DynamoDb tags: %s", input)
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.TagResource(input)
+			if err != nil {
+				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsDynamoDb is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsDynamoDb(conn, d.Get("arn").(string), o, n)
+}
+
+// keyValueTagsEC creates keyValueTags from elasticache service tags.
+func keyValueTagsEC(tags []*elasticache.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsEC returns elasticache service tags.
+func (tags keyValueTags) tagsEC() []*elasticache.Tag {
+	result := make([]*elasticache.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticache.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapEC returns the elasticache service tags for the given map of data.
+func tagsFromMapEC(m map[string]interface{}) []*elasticache.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsEC()
+}
+
+// tagsToMapEC turns the elasticache service tags into a map.
+func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
+	return keyValueTagsEC(ts).IgnoreAws().Map()
+}
+
+// diffTagsEC takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsEC(oldTags, newTags []*elasticache.Tag) ([]*elasticache.Tag, []*elasticache.Tag) {
+	o, n := keyValueTagsEC(oldTags), keyValueTagsEC(newTags)
+
+	return o.Updated(n).tagsEC(), o.Outdated(n).tagsEC()
+}
+
+// tagIgnoredEC returns whether the tag is reserved for use by AWS.
+func tagIgnoredEC(t *elasticache.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsEC lists elasticache service tags.
+func listTagsEC(conn *elasticache.ElastiCache, identifier string) (keyValueTags, error) {
+	input := &elasticache.ListTagsForResourceInput{
+		ResourceName: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsEC(output.TagList).IgnoreAws(), nil
+}
+
+// updateTagsEC updates elasticache service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsEC(conn *elasticache.ElastiCache, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &elasticache.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing EC tags: %s", input)
+		_, err := conn.RemoveTagsFromResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &elasticache.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.tagsEC(),
+		}
+
+		log.Printf("[DEBUG] Adding EC tags: %s", input)
+		_, err := conn.AddTagsToResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsEC is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsEC(conn, identifier, o, n)
+}
+
+// keyValueTagsEC2 creates keyValueTags from ec2 service tags.
+func keyValueTagsEC2(tags []*ec2.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsEC2 returns ec2 service tags.
+func (tags keyValueTags) tagsEC2() []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ec2.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMap returns the ec2 service tags for the given map of data.
+func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsEC2()
+}
+
+// tagsToMap turns the ec2 service tags into a map.
+func tagsToMap(ts []*ec2.Tag) map[string]string {
+	return keyValueTagsEC2(ts).IgnoreAws().Map()
+}
+
+// diffTags takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
+	o, n := keyValueTagsEC2(oldTags), keyValueTagsEC2(newTags)
+
+	return o.Updated(n).tagsEC2(), o.Outdated(n).tagsEC2()
+}
+
+// tagIgnored returns whether the tag is reserved for use by AWS.
+func tagIgnored(t *ec2.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsEC2 updates ec2 service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsEC2(conn *ec2.EC2, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &ec2.DeleteTagsInput{
+			Resources: aws.StringSlice([]string{identifier}),
+			Tags:      removedTags.tagsEC2(),
+		}
+
+		log.Printf("[DEBUG] Removing EC2 tags: %s", input)
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.DeleteTags(input)
+			if err != nil {
+				if isEC2NotFoundErr(err) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &ec2.CreateTagsInput{
+			Resources: aws.StringSlice([]string{identifier}),
+			Tags:      updatedTags.tagsEC2(),
+		}
+
+		log.Printf("[DEBUG] Adding EC2 tags: %s", input)
+		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			_, err := conn.CreateTags(input)
+			if err != nil {
+				if isEC2NotFoundErr(err) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTags is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsEC2(conn, d.Id(), o, n)
+}
+
+// keyValueTagsEFS creates keyValueTags from efs service tags.
+func keyValueTagsEFS(tags []*efs.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsEFS returns efs service tags.
+func (tags keyValueTags) tagsEFS() []*efs.Tag {
+	result := make([]*efs.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &efs.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapEFS returns the efs service tags for the given map of data.
+func tagsFromMapEFS(m map[string]interface{}) []*efs.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsEFS()
+}
+
+// tagsToMapEFS turns the efs service tags into a map.
+func tagsToMapEFS(ts []*efs.Tag) map[string]string {
+	return keyValueTagsEFS(ts).IgnoreAws().Map()
+}
+
+// diffTagsEFS takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsEFS(oldTags, newTags []*efs.Tag) ([]*efs.Tag, []*efs.Tag) {
+	o, n := keyValueTagsEFS(oldTags), keyValueTagsEFS(newTags)
+
+	return o.Updated(n).tagsEFS(), o.Outdated(n).tagsEFS()
+}
+
+// tagIgnoredEFS returns whether the tag is reserved for use by AWS.
+func tagIgnoredEFS(t *efs.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsEFS lists efs service tags.
+func listTagsEFS(conn *efs.EFS, identifier string) (keyValueTags, error) {
+	input := &efs.DescribeTagsInput{
+		FileSystemId: aws.String(identifier),
+	}
+
+	output, err := conn.DescribeTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsEFS(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsEFS updates efs service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsEFS(conn *efs.EFS, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &efs.DeleteTagsInput{
+			FileSystemId: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing EFS tags: %s", input)
+		_, err := conn.DeleteTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &efs.CreateTagsInput{
+			FileSystemId: aws.String(identifier),
+			Tags:         updatedTags.tagsEFS(),
+		}
+
+		log.Printf("[DEBUG] Adding EFS tags: %s", input)
+		_, err := conn.CreateTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsEFS is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsEFS(conn *efs.EFS, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsEFS(conn, d.Id(), o, n)
+}
+
+// keyValueTagsELB creates keyValueTags from elb service tags.
+func keyValueTagsELB(tags []*elb.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsELB returns elb service tags.
+func (tags keyValueTags) tagsELB() []*elb.Tag {
+	result := make([]*elb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elb.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapELB returns the elb service tags for the given map of data.
+func tagsFromMapELB(m map[string]interface{}) []*elb.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsELB()
+}
+
+// tagsToMapELB turns the elb service tags into a map.
+func tagsToMapELB(ts []*elb.Tag) map[string]string {
+	return keyValueTagsELB(ts).IgnoreAws().Map()
+}
+
+// diffTagsELB takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsELB(oldTags, newTags []*elb.Tag) ([]*elb.Tag, []*elb.Tag) {
+	o, n := keyValueTagsELB(oldTags), keyValueTagsELB(newTags)
+
+	return o.Updated(n).tagsELB(), o.Outdated(n).tagsELB()
+}
+
+// tagIgnoredELB returns whether the tag is reserved for use by AWS.
+func tagIgnoredELB(t *elb.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsELB updates elb service tags, removing and then
+// adding tags in batches of at most 10. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsELB(conn *elb.ELB, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(10) {
+		tagKeys := make([]*elb.TagKeyOnly, 0, len(removedTags))
+		for _, k := range removedTags.Keys() {
+			tagKeys = append(tagKeys, &elb.TagKeyOnly{Key: aws.String(k)})
+		}
+		input := &elb.RemoveTagsInput{
+			LoadBalancerNames: aws.StringSlice([]string{identifier}),
+			Tags:              tagKeys,
+		}
+
+		log.Printf("[DEBUG] Removing ELB tags: %s", input)
+		_, err := conn.RemoveTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(10) {
+		input := &elb.AddTagsInput{
+			LoadBalancerNames: aws.StringSlice([]string{identifier}),
+			Tags:              updatedTags.tagsELB(),
+		}
+
+		log.Printf("[DEBUG] Adding ELB tags: %s", input)
+		_, err := conn.AddTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsELB is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsELB(conn *elb.ELB, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsELB(conn, d.Get("name").(string), o, n)
+}
+
+// keyValueTagsELBv2 creates keyValueTags from elbv2 service tags.
+func keyValueTagsELBv2(tags []*elbv2.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsELBv2 returns elbv2 service tags.
+func (tags keyValueTags) tagsELBv2() []*elbv2.Tag {
+	result := make([]*elbv2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elbv2.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapELBv2 returns the elbv2 service tags for the given map of data.
+func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsELBv2()
+}
+
+// tagsToMapELBv2 turns the elbv2 service tags into a map.
+func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
+	return keyValueTagsELBv2(ts).IgnoreAws().Map()
+}
+
+// diffTagsELBv2 takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsELBv2(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
+	o, n := keyValueTagsELBv2(oldTags), keyValueTagsELBv2(newTags)
+
+	return o.Updated(n).tagsELBv2(), o.Outdated(n).tagsELBv2()
+}
+
+// tagIgnoredELBv2 returns whether the tag is reserved for use by AWS.
+func tagIgnoredELBv2(t *elbv2.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsELBv2 updates elbv2 service tags, removing and then
+// adding tags in batches of at most 10. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsELBv2(conn *elbv2.ELBV2, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(10) {
+		input := &elbv2.RemoveTagsInput{
+			ResourceArns: aws.StringSlice([]string{identifier}),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing ELBv2 tags: %s", input)
+		_, err := conn.RemoveTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(10) {
+		input := &elbv2.AddTagsInput{
+			ResourceArns: aws.StringSlice([]string{identifier}),
+			Tags:         updatedTags.tagsELBv2(),
+		}
+
+		log.Printf("[DEBUG] Adding ELBv2 tags: %s", input)
+		_, err := conn.AddTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsELBv2 is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsELBv2(conn *elbv2.ELBV2, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsELBv2(conn, d.Id(), o, n)
+}
+
+// keyValueTagsEMR creates keyValueTags from emr service tags.
+func keyValueTagsEMR(tags []*emr.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsEMR returns emr service tags.
+func (tags keyValueTags) tagsEMR() []*emr.Tag {
+	result := make([]*emr.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &emr.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapEMR returns the emr service tags for the given map of data.
+func tagsFromMapEMR(m map[string]interface{}) []*emr.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsEMR()
+}
+
+// tagsToMapEMR turns the emr service tags into a map.
+func tagsToMapEMR(ts []*emr.Tag) map[string]string {
+	return keyValueTagsEMR(ts).IgnoreAws().Map()
+}
+
+// diffTagsEMR takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
+	o, n := keyValueTagsEMR(oldTags), keyValueTagsEMR(newTags)
+
+	return o.Updated(n).tagsEMR(), o.Outdated(n).tagsEMR()
+}
+
+// tagIgnoredEMR returns whether the tag is reserved for use by AWS.
+func tagIgnoredEMR(t *emr.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsEMR updates emr service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsEMR(conn *emr.EMR, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &emr.RemoveTagsInput{
+			ResourceId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing EMR tags: %s", input)
+		_, err := conn.RemoveTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &emr.AddTagsInput{
+			ResourceId: aws.String(identifier),
+			Tags:       updatedTags.tagsEMR(),
+		}
+
+		log.Printf("[DEBUG] Adding EMR tags: %s", input)
+		_, err := conn.AddTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsEMR is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsEMR(conn, d.Id(), o, n)
+}
+
+// keyValueTagsElasticsearchService creates keyValueTags from elasticsearchservice service tags.
+func keyValueTagsElasticsearchService(tags []*elasticsearch.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsElasticsearchService returns elasticsearchservice service tags.
+func (tags keyValueTags) tagsElasticsearchService() []*elasticsearch.Tag {
+	result := make([]*elasticsearch.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticsearch.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapElasticsearchService returns the elasticsearchservice service tags for the given map of data.
+func tagsFromMapElasticsearchService(m map[string]interface{}) []*elasticsearch.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsElasticsearchService()
+}
+
+// tagsToMapElasticsearchService turns the elasticsearchservice service tags into a map.
+func tagsToMapElasticsearchService(ts []*elasticsearch.Tag) map[string]string {
+	return keyValueTagsElasticsearchService(ts).IgnoreAws().Map()
+}
+
+// diffTagsElasticsearchService takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsElasticsearchService(oldTags, newTags []*elasticsearch.Tag) ([]*elasticsearch.Tag, []*elasticsearch.Tag) {
+	o, n := keyValueTagsElasticsearchService(oldTags), keyValueTagsElasticsearchService(newTags)
+
+	return o.Updated(n).tagsElasticsearchService(), o.Outdated(n).tagsElasticsearchService()
+}
+
+// tagIgnoredElasticsearchService returns whether the tag is reserved for use by AWS.
+func tagIgnoredElasticsearchService(t *elasticsearch.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsElasticsearchService lists elasticsearchservice service tags.
+func listTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, identifier string) (keyValueTags, error) {
+	input := &elasticsearch.ListTagsInput{
+		ARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsElasticsearchService(output.TagList).IgnoreAws(), nil
+}
+
+// updateTagsElasticsearchService updates elasticsearchservice service tags, removing and then
+// adding tags in batches of at most 10. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(10) {
+		input := &elasticsearch.RemoveTagsInput{
+			ARN:     aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing ElasticsearchService tags: %s", input)
+		_, err := conn.RemoveTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(10) {
+		input := &elasticsearch.AddTagsInput{
+			ARN:     aws.String(identifier),
+			TagList: updatedTags.tagsElasticsearchService(),
+		}
+
+		log.Printf("[DEBUG] Adding ElasticsearchService tags: %s", input)
+		_, err := conn.AddTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsElasticsearchService is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsElasticsearchService(conn, identifier, o, n)
+}
+
+// keyValueTagsInspector creates keyValueTags from inspector service tags.
+func keyValueTagsInspector(tags []*inspector.ResourceGroupTag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsInspector returns inspector service tags.
+func (tags keyValueTags) tagsInspector() []*inspector.ResourceGroupTag {
+	result := make([]*inspector.ResourceGroupTag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &inspector.ResourceGroupTag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapInspector returns the inspector service tags for the given map of data.
+func tagsFromMapInspector(m map[string]interface{}) []*inspector.ResourceGroupTag {
+	return newKeyValueTags(m).IgnoreAws().tagsInspector()
+}
+
+// tagsToMapInspector turns the inspector service tags into a map.
+func tagsToMapInspector(ts []*inspector.ResourceGroupTag) map[string]string {
+	return keyValueTagsInspector(ts).IgnoreAws().Map()
+}
+
+// diffTagsInspector takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsInspector(oldTags, newTags []*inspector.ResourceGroupTag) ([]*inspector.ResourceGroupTag, []*inspector.ResourceGroupTag) {
+	o, n := keyValueTagsInspector(oldTags), keyValueTagsInspector(newTags)
+
+	return o.Updated(n).tagsInspector(), o.Outdated(n).tagsInspector()
+}
+
+// tagIgnoredInspector returns whether the tag is reserved for use by AWS.
+func tagIgnoredInspector(t *inspector.ResourceGroupTag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// keyValueTagsKMS creates keyValueTags from kms service tags.
+func keyValueTagsKMS(tags []*kms.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.TagKey)] = tag.TagValue
+	}
+
+	return result
+}
+
+// tagsKMS returns kms service tags.
+func (tags keyValueTags) tagsKMS() []*kms.Tag {
+	result := make([]*kms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &kms.Tag{
+			TagKey:   aws.String(k),
+			TagValue: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapKMS returns the kms service tags for the given map of data.
+func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsKMS()
+}
+
+// tagsToMapKMS turns the kms service tags into a map.
+func tagsToMapKMS(ts []*kms.Tag) map[string]string {
+	return keyValueTagsKMS(ts).IgnoreAws().Map()
+}
+
+// diffTagsKMS takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsKMS(oldTags, newTags []*kms.Tag) ([]*kms.Tag, []*kms.Tag) {
+	o, n := keyValueTagsKMS(oldTags), keyValueTagsKMS(newTags)
+
+	return o.Updated(n).tagsKMS(), o.Outdated(n).tagsKMS()
+}
+
+// tagIgnoredKMS returns whether the tag is reserved for use by AWS.
+func tagIgnoredKMS(t *kms.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.TagKey))
+}
+
+// listTagsKMS lists kms service tags.
+func listTagsKMS(conn *kms.KMS, identifier string) (keyValueTags, error) {
+	input := &kms.ListResourceTagsInput{
+		KeyId: aws.String(identifier),
+	}
+
+	output, err := conn.ListResourceTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsKMS(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsKMS updates kms service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsKMS(conn *kms.KMS, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &kms.UntagResourceInput{
+			KeyId:   aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing KMS tags: %s", input)
+		_, err := conn.UntagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &kms.TagResourceInput{
+			KeyId: aws.String(identifier),
+			Tags:  updatedTags.tagsKMS(),
+		}
+
+		log.Printf("[DEBUG] Adding KMS tags: %s", input)
+		_, err := conn.TagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsKMS is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsKMS(conn, identifier, o, n)
+}
+
+// keyValueTagsKinesis creates keyValueTags from kinesis service tags.
+func keyValueTagsKinesis(tags []*kinesis.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsKinesis returns kinesis service tags.
+func (tags keyValueTags) tagsKinesis() []*kinesis.Tag {
+	result := make([]*kinesis.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &kinesis.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapKinesis returns the kinesis service tags for the given map of data.
+func tagsFromMapKinesis(m map[string]interface{}) []*kinesis.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsKinesis()
+}
+
+// tagsToMapKinesis turns the kinesis service tags into a map.
+func tagsToMapKinesis(ts []*kinesis.Tag) map[string]string {
+	return keyValueTagsKinesis(ts).IgnoreAws().Map()
+}
+
+// diffTagsKinesis takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsKinesis(oldTags, newTags []*kinesis.Tag) ([]*kinesis.Tag, []*kinesis.Tag) {
+	o, n := keyValueTagsKinesis(oldTags), keyValueTagsKinesis(newTags)
+
+	return o.Updated(n).tagsKinesis(), o.Outdated(n).tagsKinesis()
+}
+
+// tagIgnoredKinesis returns whether the tag is reserved for use by AWS.
+func tagIgnoredKinesis(t *kinesis.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsKinesis lists kinesis service tags.
+func listTagsKinesis(conn *kinesis.Kinesis, identifier string) (keyValueTags, error) {
+	input := &kinesis.ListTagsForStreamInput{
+		StreamName: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForStream(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsKinesis(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsKinesis updates kinesis service tags, removing and then
+// adding tags in batches of at most 10. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsKinesis(conn *kinesis.Kinesis, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(10) {
+		input := &kinesis.RemoveTagsFromStreamInput{
+			StreamName: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing Kinesis tags: %s", input)
+		_, err := conn.RemoveTagsFromStream(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(10) {
+		input := &kinesis.AddTagsToStreamInput{
+			StreamName: aws.String(identifier),
+			Tags:       map[string]*string(updatedTags),
+		}
+
+		log.Printf("[DEBUG] Adding Kinesis tags: %s", input)
+		_, err := conn.AddTagsToStream(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsKinesis is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsKinesis(conn, d.Get("name").(string), o, n)
+}
+
+// listTagsLambda lists lambda service tags.
+func listTagsLambda(conn *lambda.Lambda, identifier string) (keyValueTags, error) {
+	input := &lambda.ListTagsInput{
+		Resource: aws.String(identifier),
+	}
+
+	output, err := conn.ListTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return newKeyValueTags(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsLambda updates lambda service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsLambda(conn *lambda.Lambda, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &lambda.UntagResourceInput{
+			Resource: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing Lambda tags: %s", input)
+		_, err := conn.UntagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &lambda.TagResourceInput{
+			Resource: aws.String(identifier),
+			Tags:     map[string]*string(updatedTags),
+		}
+
+		log.Printf("[DEBUG] Adding Lambda tags: %s", input)
+		_, err := conn.TagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsLambda is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsLambda(conn *lambda.Lambda, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsLambda(conn, identifier, o, n)
+}
+
+// listTagsOpsworks lists opsworks service tags.
+func listTagsOpsworks(conn *opsworks.OpsWorks, identifier string) (keyValueTags, error) {
+	input := &opsworks.ListTagsInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return newKeyValueTags(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsOpsworks updates opsworks service tags, removing and then
+// adding tags in batches of at most 40. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsOpsworks(conn *opsworks.OpsWorks, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(40) {
+		input := &opsworks.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing Opsworks tags: %s", input)
+		_, err := conn.UntagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(40) {
+		input := &opsworks.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        map[string]*string(updatedTags),
+		}
+
+		log.Printf("[DEBUG] Adding Opsworks tags: %s", input)
+		_, err := conn.TagResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsOpsworks is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsOpsworks(conn *opsworks.OpsWorks, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsOpsworks(conn, identifier, o, n)
+}
+
+// keyValueTagsRDS creates keyValueTags from rds service tags.
+func keyValueTagsRDS(tags []*rds.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsRDS returns rds service tags.
+func (tags keyValueTags) tagsRDS() []*rds.Tag {
+	result := make([]*rds.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &rds.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapRDS returns the rds service tags for the given map of data.
+func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsRDS()
+}
+
+// tagsToMapRDS turns the rds service tags into a map.
+func tagsToMapRDS(ts []*rds.Tag) map[string]string {
+	return keyValueTagsRDS(ts).IgnoreAws().Map()
+}
+
+// diffTagsRDS takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsRDS(oldTags, newTags []*rds.Tag) ([]*rds.Tag, []*rds.Tag) {
+	o, n := keyValueTagsRDS(oldTags), keyValueTagsRDS(newTags)
+
+	return o.Updated(n).tagsRDS(), o.Outdated(n).tagsRDS()
+}
+
+// tagIgnoredRDS returns whether the tag is reserved for use by AWS.
+func tagIgnoredRDS(t *rds.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsRDS lists rds service tags.
+func listTagsRDS(conn *rds.RDS, identifier string) (keyValueTags, error) {
+	input := &rds.ListTagsForResourceInput{
+		ResourceName: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsRDS(output.TagList).IgnoreAws(), nil
+}
+
+// updateTagsRDS updates rds service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsRDS(conn *rds.RDS, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &rds.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing RDS tags: %s", input)
+		_, err := conn.RemoveTagsFromResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &rds.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.tagsRDS(),
+		}
+
+		log.Printf("[DEBUG] Adding RDS tags: %s", input)
+		_, err := conn.AddTagsToResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsRDS is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsRDS(conn, identifier, o, n)
+}
+
+// keyValueTagsRedshift creates keyValueTags from redshift service tags.
+func keyValueTagsRedshift(tags []*redshift.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsRedshift returns redshift service tags.
+func (tags keyValueTags) tagsRedshift() []*redshift.Tag {
+	result := make([]*redshift.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &redshift.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapRedshift returns the redshift service tags for the given map of data.
+func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsRedshift()
+}
+
+// tagsToMapRedshift turns the redshift service tags into a map.
+func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
+	return keyValueTagsRedshift(ts).IgnoreAws().Map()
+}
+
+// diffTagsRedshift takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsRedshift(oldTags, newTags []*redshift.Tag) ([]*redshift.Tag, []*redshift.Tag) {
+	o, n := keyValueTagsRedshift(oldTags), keyValueTagsRedshift(newTags)
+
+	return o.Updated(n).tagsRedshift(), o.Outdated(n).tagsRedshift()
+}
+
+// tagIgnoredRedshift returns whether the tag is reserved for use by AWS.
+func tagIgnoredRedshift(t *redshift.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsRedshift updates redshift service tags, removing and then
+// adding tags in batches of at most 10. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsRedshift(conn *redshift.Redshift, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(10) {
+		input := &redshift.DeleteTagsInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing Redshift tags: %s", input)
+		_, err := conn.DeleteTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(10) {
+		input := &redshift.CreateTagsInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.tagsRedshift(),
+		}
+
+		log.Printf("[DEBUG] Adding Redshift tags: %s", input)
+		_, err := conn.CreateTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsRedshift is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsRedshift(conn, identifier, o, n)
+}
+
+// keyValueTagsRoute53 creates keyValueTags from route53 service tags.
+func keyValueTagsRoute53(tags []*route53.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsRoute53 returns route53 service tags.
+func (tags keyValueTags) tagsRoute53() []*route53.Tag {
+	result := make([]*route53.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &route53.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapR53 returns the route53 service tags for the given map of data.
+func tagsFromMapR53(m map[string]interface{}) []*route53.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsRoute53()
+}
+
+// tagsToMapR53 turns the route53 service tags into a map.
+func tagsToMapR53(ts []*route53.Tag) map[string]string {
+	return keyValueTagsRoute53(ts).IgnoreAws().Map()
+}
+
+// diffTagsR53 takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsR53(oldTags, newTags []*route53.Tag) ([]*route53.Tag, []*route53.Tag) {
+	o, n := keyValueTagsRoute53(oldTags), keyValueTagsRoute53(newTags)
+
+	return o.Updated(n).tagsRoute53(), o.Outdated(n).tagsRoute53()
+}
+
+// tagIgnoredRoute53 returns whether the tag is reserved for use by AWS.
+func tagIgnoredRoute53(t *route53.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// updateTagsRoute53 updates route53 service tags, removing and then
+// adding tags in batches of at most 10. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsRoute53(conn *route53.Route53, identifier string, resourceType string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(10) {
+		input := &route53.ChangeTagsForResourceInput{
+			ResourceId:    aws.String(identifier),
+			ResourceType:  aws.String(resourceType),
+			RemoveTagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing Route53 tags: %s", input)
+		_, err := conn.ChangeTagsForResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(10) {
+		input := &route53.ChangeTagsForResourceInput{
+			ResourceId:   aws.String(identifier),
+			ResourceType: aws.String(resourceType),
+			AddTags:      updatedTags.tagsRoute53(),
+		}
+
+		log.Printf("[DEBUG] Adding Route53 tags: %s", input)
+		_, err := conn.ChangeTagsForResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsR53 is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsRoute53(conn, d.Id(), resourceType, o, n)
+}
+
+// keyValueTagsS3 creates keyValueTags from s3 service tags.
+func keyValueTagsS3(tags []*s3.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsS3 returns s3 service tags.
+func (tags keyValueTags) tagsS3() []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &s3.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapS3 returns the s3 service tags for the given map of data.
+func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsS3()
+}
+
+// tagsToMapS3 turns the s3 service tags into a map.
+func tagsToMapS3(ts []*s3.Tag) map[string]string {
+	return keyValueTagsS3(ts).IgnoreAws().Map()
+}
+
+// diffTagsS3 takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
+	o, n := keyValueTagsS3(oldTags), keyValueTagsS3(newTags)
+
+	return o.Updated(n).tagsS3(), o.Outdated(n).tagsS3()
+}
+
+// tagIgnoredS3 returns whether the tag is reserved for use by AWS.
+func tagIgnoredS3(t *s3.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// keyValueTagsSageMaker creates keyValueTags from sagemaker service tags.
+func keyValueTagsSageMaker(tags []*sagemaker.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsSageMaker returns sagemaker service tags.
+func (tags keyValueTags) tagsSageMaker() []*sagemaker.Tag {
+	result := make([]*sagemaker.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &sagemaker.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapSageMaker returns the sagemaker service tags for the given map of data.
+func tagsFromMapSageMaker(m map[string]interface{}) []*sagemaker.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsSageMaker()
+}
+
+// tagsToMapSageMaker turns the sagemaker service tags into a map.
+func tagsToMapSageMaker(ts []*sagemaker.Tag) map[string]string {
+	return keyValueTagsSageMaker(ts).IgnoreAws().Map()
+}
+
+// diffTagsSageMaker takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsSageMaker(oldTags, newTags []*sagemaker.Tag) ([]*sagemaker.Tag, []*sagemaker.Tag) {
+	o, n := keyValueTagsSageMaker(oldTags), keyValueTagsSageMaker(newTags)
+
+	return o.Updated(n).tagsSageMaker(), o.Outdated(n).tagsSageMaker()
+}
+
+// tagIgnoredSageMaker returns whether the tag is reserved for use by AWS.
+func tagIgnoredSageMaker(t *sagemaker.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsSageMaker lists sagemaker service tags.
+func listTagsSageMaker(conn *sagemaker.SageMaker, identifier string) (keyValueTags, error) {
+	input := &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsSageMaker(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsSageMaker updates sagemaker service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsSageMaker(conn *sagemaker.SageMaker, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &sagemaker.DeleteTagsInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing SageMaker tags: %s", input)
+		_, err := conn.DeleteTags(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &sagemaker.AddTagsInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.tagsSageMaker(),
+		}
+
+		log.Printf("[DEBUG] Adding SageMaker tags: %s", input)
+		_, err := conn.AddTags(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsSageMaker is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsSageMaker(conn *sagemaker.SageMaker, d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsSageMaker(conn, identifier, o, n)
+}
+
+// listTagsSQS lists sqs service tags.
+func listTagsSQS(conn *sqs.SQS, identifier string) (keyValueTags, error) {
+	input := &sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(identifier),
+	}
+
+	output, err := conn.ListQueueTags(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return newKeyValueTags(output.Tags).IgnoreAws(), nil
+}
+
+// updateTagsSQS updates sqs service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsSQS(conn *sqs.SQS, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &sqs.UntagQueueInput{
+			QueueUrl: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing SQS tags: %s", input)
+		_, err := conn.UntagQueue(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &sqs.TagQueueInput{
+			QueueUrl: aws.String(identifier),
+			Tags:     map[string]*string(updatedTags),
+		}
+
+		log.Printf("[DEBUG] Adding SQS tags: %s", input)
+		_, err := conn.TagQueue(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsSQS is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsSQS(conn, d.Id(), o, n)
+}
+
+// keyValueTagsSSM creates keyValueTags from ssm service tags.
+func keyValueTagsSSM(tags []*ssm.Tag) keyValueTags {
+	result := make(keyValueTags)
+	for _, tag := range tags {
+		result[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return result
+}
+
+// tagsSSM returns ssm service tags.
+func (tags keyValueTags) tagsSSM() []*ssm.Tag {
+	result := make([]*ssm.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ssm.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
+
+// tagsFromMapSSM returns the ssm service tags for the given map of data.
+func tagsFromMapSSM(m map[string]interface{}) []*ssm.Tag {
+	return newKeyValueTags(m).IgnoreAws().tagsSSM()
+}
+
+// tagsToMapSSM turns the ssm service tags into a map.
+func tagsToMapSSM(ts []*ssm.Tag) map[string]string {
+	return keyValueTagsSSM(ts).IgnoreAws().Map()
+}
+
+// diffTagsSSM takes our tags locally and the ones remotely and
+// returns the set of tags that must be created, and the set of tags that
+// must be destroyed.
+func diffTagsSSM(oldTags, newTags []*ssm.Tag) ([]*ssm.Tag, []*ssm.Tag) {
+	o, n := keyValueTagsSSM(oldTags), keyValueTagsSSM(newTags)
+
+	return o.Updated(n).tagsSSM(), o.Outdated(n).tagsSSM()
+}
+
+// tagIgnoredSSM returns whether the tag is reserved for use by AWS.
+func tagIgnoredSSM(t *ssm.Tag) bool {
+	return tagIgnoredAws(aws.StringValue(t.Key))
+}
+
+// listTagsSSM lists ssm service tags.
+func listTagsSSM(conn *ssm.SSM, identifier string, resourceType string) (keyValueTags, error) {
+	input := &ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(identifier),
+		ResourceType: aws.String(resourceType),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing tags for %s: %s", identifier, err)
+	}
+
+	return keyValueTagsSSM(output.TagList).IgnoreAws(), nil
+}
+
+// updateTagsSSM updates ssm service tags, removing and then
+// adding tags in batches of at most 50. The old and new tags
+// may be passed in any form accepted by newKeyValueTags.
+func updateTagsSSM(conn *ssm.SSM, identifier string, resourceType string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	for _, removedTags := range oldTags.Removed(newTags).Chunks(50) {
+		input := &ssm.RemoveTagsFromResourceInput{
+			ResourceId:   aws.String(identifier),
+			ResourceType: aws.String(resourceType),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		log.Printf("[DEBUG] Removing SSM tags: %s", input)
+		_, err := conn.RemoveTagsFromResource(input)
+		if err != nil {
+			return fmt.Errorf("Error removing tags from %s: %s", identifier, err)
+		}
+	}
+
+	for _, updatedTags := range oldTags.Updated(newTags).Chunks(50) {
+		input := &ssm.AddTagsToResourceInput{
+			ResourceId:   aws.String(identifier),
+			ResourceType: aws.String(resourceType),
+			Tags:         updatedTags.tagsSSM(),
+		}
+
+		log.Printf("[DEBUG] Adding SSM tags: %s", input)
+		_, err := conn.AddTagsToResource(input)
+		if err != nil {
+			return fmt.Errorf("Error adding tags to %s: %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// setTagsSSM is a helper to set the tags for a resource. It expects
+// the tags field to be named "tags", with the tags to apply (including any
+// provider default_tags) in "tags_all".
+func setTagsSSM(conn *ssm.SSM, d *schema.ResourceData, identifier string, resourceType string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, _ := d.GetChange("tags_all")
+	n := d.Get("tags_all")
+
+	return updateTagsSSM(conn, identifier, resourceType, o, n)
+}
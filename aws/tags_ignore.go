@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ignoreTagsConfig holds the provider ignore_tags configuration: tags added
+// outside of Terraform that should neither be read into state nor removed.
+type ignoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// ignored returns whether the given tag key is ignored by the configuration.
+func (c *ignoreTagsConfig) ignored(k string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, key := range c.Keys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandIgnoreTagsConfig reads the provider ignore_tags block.
+func expandIgnoreTagsConfig(l []interface{}) *ignoreTagsConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &ignoreTagsConfig{}
+	if v, ok := m["keys"].(*schema.Set); ok {
+		for _, k := range v.List() {
+			config.Keys = append(config.Keys, k.(string))
+		}
+	}
+	if v, ok := m["key_prefixes"].(*schema.Set); ok {
+		for _, k := range v.List() {
+			config.KeyPrefixes = append(config.KeyPrefixes, k.(string))
+		}
+	}
+
+	return config
+}
+
+// dataSourceIgnoreTags extends a data source with a top-level "tags" map so
+// that the provider ignore_tags are filtered out of what it reads.
+func dataSourceIgnoreTags(r *schema.Resource) {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap {
+		return
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+		if providerIgnoreTags(meta) == nil {
+			return nil
+		}
+		return d.Set("tags", removeIgnoredTags(meta, d.Get("tags").(map[string]interface{})))
+	}
+}
+
+// removeIgnoredTags returns the given tags without those matching the
+// provider ignore_tags.
+func removeIgnoredTags(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	config := providerIgnoreTags(meta)
+
+	result := make(map[string]interface{})
+	for k, v := range tags {
+		if !config.ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+func providerIgnoreTags(meta interface{}) *ignoreTagsConfig {
+	if client, ok := meta.(*AWSClient); ok && client != nil {
+		return client.ignoretags
+	}
+	return nil
+}
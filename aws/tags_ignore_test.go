@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestIgnoreTagsConfigIgnored(t *testing.T) {
+	config := &ignoreTagsConfig{
+		Keys:        []string{"Owner"},
+		KeyPrefixes: []string{"kubernetes.io/", "cost:"},
+	}
+
+	cases := []struct {
+		Key     string
+		Ignored bool
+	}{
+		{Key: "Owner", Ignored: true},
+		{Key: "OwnerTeam", Ignored: false},
+		{Key: "kubernetes.io/cluster/foo", Ignored: true},
+		{Key: "cost:center", Ignored: true},
+		{Key: "Name", Ignored: false},
+	}
+
+	for _, tc := range cases {
+		if actual := config.ignored(tc.Key); actual != tc.Ignored {
+			t.Fatalf("%s: expected ignored to be %t, got %t", tc.Key, tc.Ignored, actual)
+		}
+	}
+
+	var empty *ignoreTagsConfig
+	if empty.ignored("Owner") {
+		t.Fatal("expected nil config not to ignore anything")
+	}
+}
+
+func TestExpandIgnoreTagsConfig(t *testing.T) {
+	l := []interface{}{
+		map[string]interface{}{
+			"keys":         schema.NewSet(schema.HashString, []interface{}{"Owner"}),
+			"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"kubernetes.io/"}),
+		},
+	}
+
+	expected := &ignoreTagsConfig{
+		Keys:        []string{"Owner"},
+		KeyPrefixes: []string{"kubernetes.io/"},
+	}
+
+	actual := expandIgnoreTagsConfig(l)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	if expandIgnoreTagsConfig(nil) != nil {
+		t.Fatal("expected nil config for an empty block")
+	}
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	meta := &AWSClient{
+		ignoretags: &ignoreTagsConfig{
+			Keys:        []string{"Owner"},
+			KeyPrefixes: []string{"kubernetes.io/"},
+		},
+	}
+
+	tags := map[string]interface{}{
+		"Name":                      "foo",
+		"Owner":                     "ops",
+		"kubernetes.io/cluster/foo": "owned",
+	}
+	expected := map[string]interface{}{
+		"Name": "foo",
+	}
+
+	actual := removeIgnoredTags(meta, tags)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	actual = providerTags(meta, map[string]interface{}{"Name": "foo", "Owner": "dev"})
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,189 @@
+package aws
+
+//go:generate go run internal/tagsgen/main.go
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// awsTagKeyPrefix is the prefix of the tag keys reserved for use by AWS.
+const awsTagKeyPrefix = "aws:"
+
+// keyValueTags is the service independent representation of a set of resource
+// tags. The per-service helpers in tags_gen.go convert to and from the service
+// tag types and perform the List, Add and Remove calls.
+//
+// The helpers only drop the tags reserved for use by AWS. The provider
+// ignore_tags are filtered out of "tags" and "tags_all" by resourceDefaultTags
+// before they reach the helpers, so ignored tags are never added or removed.
+// Resources whose tags it does not wrap filter them with IgnoreConfig when
+// reading their tags.
+type keyValueTags map[string]*string
+
+// newKeyValueTags creates keyValueTags from a map of tags as read from the
+// configuration or as returned by the AWS APIs.
+func newKeyValueTags(i interface{}) keyValueTags {
+	result := make(keyValueTags)
+
+	switch m := i.(type) {
+	case keyValueTags:
+		for k, v := range m {
+			result[k] = v
+		}
+	case map[string]*string:
+		for k, v := range m {
+			result[k] = v
+		}
+	case map[string]string:
+		for k, v := range m {
+			result[k] = aws.String(v)
+		}
+	case map[string]interface{}:
+		for k, v := range m {
+			result[k] = aws.String(v.(string))
+		}
+	}
+
+	return result
+}
+
+// IgnoreAws returns the tags without those reserved for use by AWS.
+func (tags keyValueTags) IgnoreAws() keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if !tagIgnoredAws(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// IgnoreConfig returns the tags without those matching the provider
+// ignore_tags configuration.
+func (tags keyValueTags) IgnoreConfig(config *ignoreTagsConfig) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if !config.ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Keys returns the tag keys, sorted.
+func (tags keyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+	for k := range tags {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// Map returns the tags as a map of strings.
+func (tags keyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		result[k] = aws.StringValue(v)
+	}
+
+	return result
+}
+
+// Removed returns the tags that are not present in newTags.
+func (tags keyValueTags) Removed(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Outdated returns the tags that are not present or have a different value
+// in newTags. The diffTags helpers destroy these, so that changed tags are
+// removed and created again.
+func (tags keyValueTags) Outdated(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if nv, ok := newTags[k]; !ok || aws.StringValue(nv) != aws.StringValue(v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the tags in newTags that are not present or have a
+// different value in tags.
+func (tags keyValueTags) Updated(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range newTags {
+		if old, ok := tags[k]; !ok || aws.StringValue(old) != aws.StringValue(v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Chunks splits the tags, in key order, into chunks of at most size tags so
+// that they can be sent within the per-call limit of an API. A size of zero
+// means no limit.
+func (tags keyValueTags) Chunks(size int) []keyValueTags {
+	if len(tags) == 0 {
+		return nil
+	}
+	if size <= 0 {
+		return []keyValueTags{tags}
+	}
+
+	var result []keyValueTags
+	chunk := make(keyValueTags)
+	for _, k := range tags.Keys() {
+		if len(chunk) == size {
+			result = append(result, chunk)
+			chunk = make(keyValueTags)
+		}
+		chunk[k] = tags[k]
+	}
+
+	return append(result, chunk)
+}
+
+// tagIgnoredAws returns whether the tag key is reserved for use by AWS.
+func tagIgnoredAws(k string) bool {
+	return strings.HasPrefix(k, awsTagKeyPrefix)
+}
+
+// diffTagsGeneric takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed, for the services using a map of strings for tags.
+func diffTagsGeneric(oldTags, newTags map[string]interface{}) (map[string]*string, map[string]*string) {
+	o, n := newKeyValueTags(oldTags), newKeyValueTags(newTags)
+
+	return map[string]*string(o.Updated(n)), map[string]*string(o.Outdated(n))
+}
+
+// tagsFromMapGeneric returns the tags for the given map of data.
+func tagsFromMapGeneric(m map[string]interface{}) map[string]*string {
+	return map[string]*string(newKeyValueTags(m).IgnoreAws())
+}
+
+// tagsToMapGeneric turns the tags into a map.
+func tagsToMapGeneric(ts map[string]*string) map[string]string {
+	return newKeyValueTags(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredGeneric returns whether the tag key is reserved for use by AWS.
+func tagIgnoredGeneric(k string) bool {
+	return tagIgnoredAws(k)
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestNewKeyValueTags(t *testing.T) {
+	expected := map[string]string{
+		"foo": "bar",
+	}
+
+	cases := []interface{}{
+		map[string]interface{}{"foo": "bar"},
+		map[string]string{"foo": "bar"},
+		map[string]*string{"foo": aws.String("bar")},
+		keyValueTags{"foo": aws.String("bar")},
+	}
+
+	for i, tc := range cases {
+		actual := newKeyValueTags(tc).Map()
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, expected, actual)
+		}
+	}
+
+	if len(newKeyValueTags(nil)) != 0 {
+		t.Fatal("expected no tags for nil")
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	tags := newKeyValueTags(map[string]string{
+		"Name":                          "foo",
+		"Owner":                         "ops",
+		"aws:cloudformation:logical-id": "foo",
+		"kubernetes.io/cluster/foo":     "owned",
+	})
+
+	expected := map[string]string{
+		"Name":                      "foo",
+		"Owner":                     "ops",
+		"kubernetes.io/cluster/foo": "owned",
+	}
+	if actual := tags.IgnoreAws().Map(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	config := &ignoreTagsConfig{
+		Keys:        []string{"Owner"},
+		KeyPrefixes: []string{"kubernetes.io/"},
+	}
+	expected = map[string]string{
+		"Name": "foo",
+	}
+	if actual := tags.IgnoreAws().IgnoreConfig(config).Map(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	if actual := tags.IgnoreAws().IgnoreConfig(nil).Map(); !reflect.DeepEqual(actual, tags.IgnoreAws().Map()) {
+		t.Fatalf("expected no tags to be ignored without a configuration, got %#v", actual)
+	}
+}
+
+func TestKeyValueTagsRemovedUpdated(t *testing.T) {
+	cases := []struct {
+		Old, New                   map[string]string
+		Removed, Outdated, Updated map[string]string
+	}{
+		// Basic add/remove
+		{
+			Old:      map[string]string{"foo": "bar"},
+			New:      map[string]string{"bar": "baz"},
+			Removed:  map[string]string{"foo": "bar"},
+			Outdated: map[string]string{"foo": "bar"},
+			Updated:  map[string]string{"bar": "baz"},
+		},
+
+		// Modify
+		{
+			Old:      map[string]string{"foo": "bar", "bar": "baz"},
+			New:      map[string]string{"foo": "baz", "bar": "baz"},
+			Removed:  map[string]string{},
+			Outdated: map[string]string{"foo": "bar"},
+			Updated:  map[string]string{"foo": "baz"},
+		},
+
+		// No changes
+		{
+			Old:      map[string]string{"foo": "bar"},
+			New:      map[string]string{"foo": "bar"},
+			Removed:  map[string]string{},
+			Outdated: map[string]string{},
+			Updated:  map[string]string{},
+		},
+	}
+
+	for i, tc := range cases {
+		o, n := newKeyValueTags(tc.Old), newKeyValueTags(tc.New)
+		if actual := o.Removed(n).Map(); !reflect.DeepEqual(actual, tc.Removed) {
+			t.Fatalf("%d: bad removed: %#v", i, actual)
+		}
+		if actual := o.Outdated(n).Map(); !reflect.DeepEqual(actual, tc.Outdated) {
+			t.Fatalf("%d: bad outdated: %#v", i, actual)
+		}
+		if actual := o.Updated(n).Map(); !reflect.DeepEqual(actual, tc.Updated) {
+			t.Fatalf("%d: bad updated: %#v", i, actual)
+		}
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	tags := newKeyValueTags(map[string]string{
+		"a": "1",
+		"b": "2",
+		"c": "3",
+		"d": "4",
+		"e": "5",
+	})
+
+	cases := []struct {
+		Size     int
+		Expected [][]string
+	}{
+		{
+			Size:     2,
+			Expected: [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			Size:     5,
+			Expected: [][]string{{"a", "b", "c", "d", "e"}},
+		},
+		{
+			Size:     0,
+			Expected: [][]string{{"a", "b", "c", "d", "e"}},
+		},
+	}
+
+	for i, tc := range cases {
+		var actual [][]string
+		for _, chunk := range tags.Chunks(tc.Size) {
+			actual = append(actual, chunk.Keys())
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: expected %v, got %v", i, tc.Expected, actual)
+		}
+	}
+
+	if chunks := newKeyValueTags(nil).Chunks(2); len(chunks) != 0 {
+		t.Fatalf("expected no chunks for no tags, got %v", chunks)
+	}
+}
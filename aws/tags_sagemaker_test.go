@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+)
+
+func TestSageMakerDiffTags(t *testing.T) {
+	cases := []struct {
+		o, n map[string]interface{}
+		a, r map[string]string
+	}{
+		// basic add / remove
+		{
+			o: map[string]interface{}{"test-key-1": "test-value-1"},
+			n: map[string]interface{}{"test-key-2": "test-value-2"},
+			a: map[string]string{"test-key-2": "test-value-2"},
+			r: map[string]string{"test-key-1": "test-value-1"},
+		},
+		// modify
+		{
+			o: map[string]interface{}{"test-key-1": "test-value-1"},
+			n: map[string]interface{}{"test-key-1": "test-value-1-modified"},
+			a: map[string]string{"test-key-1": "test-value-1-modified"},
+			r: map[string]string{"test-key-1": "test-value-1"},
+		},
+	}
+
+	for _, c := range cases {
+		ar, rr := diffTagsSageMaker(tagsFromMapSageMaker(c.o), tagsFromMapSageMaker(c.n))
+		a := tagsToMapSageMaker(ar)
+		r := tagsToMapSageMaker(rr)
+
+		if !reflect.DeepEqual(a, c.a) {
+			t.Fatalf("Add tags mismatch: Actual %#v; Expected %#v", a, c.a)
+		}
+		if !reflect.DeepEqual(r, c.r) {
+			t.Fatalf("Remove tags mismatch: Actual %#v; Expected %#v", r, c.r)
+		}
+	}
+}
+
+func TestIgnoringTagsSageMaker(t *testing.T) {
+	ignoredTags := []*sagemaker.Tag{
+		{
+			Key:   aws.String("aws:cloudformation:logical-id"),
+			Value: aws.String("foo"),
+		},
+	}
+	for _, tag := range ignoredTags {
+		if !tagIgnoredSageMaker(tag) {
+			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
+		}
+	}
+}
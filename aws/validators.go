@@ -31,6 +31,18 @@ func validateRFC3339TimeString(v interface{}, k string) (ws []string, errors []e
 	return
 }
 
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid duration, e.g. 30s: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
 func validateRdsIdentifier(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
@@ -213,6 +225,60 @@ func validateElbNamePrefix(v interface{}, k string) (ws []string, errors []error
 	return
 }
 
+func validateLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be at least 3 characters long: %q", k, value))
+	}
+	if len(value) > 128 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 128 characters: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[0-9A-Za-z().\-/_]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters, parentheses, periods, hyphens, forward slashes and underscores allowed in %q: %q",
+			k, value))
+	}
+	return
+}
+
+func validateLaunchTemplateNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	// 26 characters are appended to the prefix to make the name unique.
+	if len(value) > 102 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 102 characters: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[0-9A-Za-z().\-/_]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters, parentheses, periods, hyphens, forward slashes and underscores allowed in %q: %q",
+			k, value))
+	}
+	return
+}
+
+func validateLaunchTemplateBlockDurationMinutes(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value%60 != 0 || value < 60 || value > 360 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a multiple of 60 between 60 and 360: %d", k, value))
+	}
+	return
+}
+
+func validateLaunchTemplateVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "$Latest" || value == "$Default" {
+		return
+	}
+	if !regexp.MustCompile(`^[1-9][0-9]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a version number, \"$Latest\" or \"$Default\": %q", k, value))
+	}
+	return
+}
+
 func validateEcrRepositoryName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) < 2 {
@@ -382,6 +448,81 @@ func validateAwsAccountId(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
+func validateBudgetsBudgetName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/aws-cost-management/latest/APIReference/API_budgets_Budget.html
+	if strings.ContainsAny(value, `:\`) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot contain \":\" or \"\\\" characters: %q", k, value))
+	}
+
+	return
+}
+
+func validateBudgetsTimePeriod(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := time.Parse(budgetsTimePeriodLayout, value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be in the format %q: %s", k, budgetsTimePeriodLayout, err))
+	}
+
+	return
+}
+
+func validateOrganizationsParentId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/organizations/latest/APIReference/API_CreateOrganizationalUnit.html
+	pattern := `^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[0-9a-z]{8,32})$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't look like an Organizations root or organizational unit ID (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
+func validateOrganizationsAccountEmail(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/organizations/latest/APIReference/API_CreateAccount.html
+	pattern := `^[^\s@]+@[^\s@]+\.[^\s@]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a valid email address: %q",
+			k, value))
+	}
+
+	if len(value) < 6 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be less than 6 characters: %q", k, value))
+	}
+
+	if len(value) > 64 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be greater than 64 characters: %q", k, value))
+	}
+
+	return
+}
+
+func validateOrganizationsAccountRoleName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/organizations/latest/APIReference/API_CreateAccount.html
+	pattern := `^[\w+=,.@-]{1,64}$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must consist of upper and lowercase alphanumeric characters with no spaces. You can also include any of the following characters: =,.@- (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
 func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -598,6 +739,22 @@ func validateCloudFormationTemplate(v interface{}, k string) (ws []string, error
 	return
 }
 
+func validateCloudFormationStackSetName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/AWSCloudFormation/latest/APIReference/API_CreateStackSet.html
+	if !regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must start with a letter and contain only alphanumeric characters and hyphens: %q", k, value))
+	}
+	if len(value) > 128 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 128 characters: %q", k, value))
+	}
+
+	return
+}
+
 func validateApiGatewayIntegrationContentHandling() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		apigateway.ContentHandlingStrategyConvertToBinary,
@@ -707,6 +864,19 @@ func validateAwsDynamoDbGlobalTableName(v interface{}, k string) (ws []string, e
 	return
 }
 
+// http://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_CreateBackup.html
+func validateDynamoDbBackupName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if (len(value) > 255) || (len(value) < 3) {
+		errors = append(errors, fmt.Errorf("%s length must be between 3 and 255 characters: %q", k, value))
+	}
+	pattern := `^[a-zA-Z0-9_.-]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must only include alphanumeric, underscore, period, or hyphen characters: %q", k, value))
+	}
+	return
+}
+
 // Validates that an Ecs placement strategy is set correctly
 // Takes type, and field as strings
 func validateAwsEcsPlacementStrategy(stratType, stratField string) error {
@@ -1118,6 +1288,23 @@ func validateCognitoIdentityProvidersProviderName(v interface{}, k string) (ws [
 	return
 }
 
+func validateCognitoResourceServerScopeName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if len(value) < 1 {
+		es = append(es, fmt.Errorf("%q cannot be less than 1 character", k))
+	}
+
+	if len(value) > 256 {
+		es = append(es, fmt.Errorf("%q cannot be longer than 256 character", k))
+	}
+
+	// Double quotes, slashes and backslashes are not allowed
+	if !regexp.MustCompile(`^[\x21\x23-\x2E\x30-\x5B\x5D-\x7E]+$`).MatchString(value) {
+		es = append(es, fmt.Errorf("%q must satisfy regular expression pattern: [\\x21\\x23-\\x2E\\x30-\\x5B\\x5D-\\x7E]+", k))
+	}
+	return
+}
+
 func validateCognitoUserGroupName(v interface{}, k string) (ws []string, es []error) {
 	value := v.(string)
 	if len(value) < 1 {
@@ -1573,6 +1760,19 @@ func validateCognitoUserPoolDomain(v interface{}, k string) (ws []string, errors
 	return
 }
 
+func validateSagemakerName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and hyphens allowed in %q, without leading or trailing hyphens", k))
+	}
+	if len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 63 characters", k))
+	}
+	return
+}
+
 func validateDxConnectionBandWidth() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{"1Gbps", "10Gbps"}, false)
 }
@@ -81,6 +81,67 @@ func TestValidateRFC3339TimeString(t *testing.T) {
 	}
 }
 
+func TestValidateLaunchTemplateName(t *testing.T) {
+	validNames := []string{
+		"tf-test-template",
+		"tf_test/template.(1)",
+		strings.Repeat("W", 128),
+	}
+
+	for _, s := range validNames {
+		_, errors := validateLaunchTemplateName(s, "name")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid launch template name: %v", s, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"tf",
+		"tf test template",
+		"tf-test-template!",
+		strings.Repeat("W", 129),
+	}
+
+	for _, s := range invalidNames {
+		_, errors := validateLaunchTemplateName(s, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid launch template name: %v", s, errors)
+		}
+	}
+}
+
+func TestValidateLaunchTemplateBlockDurationMinutes(t *testing.T) {
+	for _, v := range []int{60, 180, 360} {
+		_, errors := validateLaunchTemplateBlockDurationMinutes(v, "block_duration_minutes")
+		if len(errors) > 0 {
+			t.Fatalf("%d should be a valid block duration: %v", v, errors)
+		}
+	}
+
+	for _, v := range []int{0, 30, 90, 420} {
+		_, errors := validateLaunchTemplateBlockDurationMinutes(v, "block_duration_minutes")
+		if len(errors) == 0 {
+			t.Fatalf("%d should not be a valid block duration", v)
+		}
+	}
+}
+
+func TestValidateLaunchTemplateVersion(t *testing.T) {
+	for _, s := range []string{"1", "42", "$Latest", "$Default"} {
+		_, errors := validateLaunchTemplateVersion(s, "version")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid launch template version: %v", s, errors)
+		}
+	}
+
+	for _, s := range []string{"", "0", "01", "latest", "$latest", "v1"} {
+		_, errors := validateLaunchTemplateVersion(s, "version")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid launch template version", s)
+		}
+	}
+}
+
 func TestValidateEcrRepositoryName(t *testing.T) {
 	validNames := []string{
 		"nginx-web-app",
@@ -292,6 +353,147 @@ func TestValidateAwsAccountId(t *testing.T) {
 	}
 }
 
+func TestValidateBudgetsBudgetName(t *testing.T) {
+	validNames := []string{
+		"my-budget",
+		"My Budget 2018",
+		"budget_with.dots",
+	}
+	for _, v := range validNames {
+		_, errors := validateBudgetsBudgetName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Budget name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"my:budget",
+		`my\budget`,
+	}
+	for _, v := range invalidNames {
+		_, errors := validateBudgetsBudgetName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Budget name", v)
+		}
+	}
+}
+
+func TestValidateBudgetsTimePeriod(t *testing.T) {
+	validTimes := []string{
+		"2017-01-01_00:00",
+		"2087-06-15_23:59",
+	}
+	for _, v := range validTimes {
+		_, errors := validateBudgetsTimePeriod(v, "time_period_start")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Budget time period: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"",
+		"2017-01-01",
+		"2017-01-01 00:00",
+		"2017-01-01T00:00:00Z",
+		"2017-13-01_00:00",
+	}
+	for _, v := range invalidTimes {
+		_, errors := validateBudgetsTimePeriod(v, "time_period_start")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Budget time period", v)
+		}
+	}
+}
+
+func TestValidateOrganizationsParentId(t *testing.T) {
+	validIds := []string{
+		"r-abcd",
+		"r-0a1b2c3d4e",
+		"ou-abcd-12345678",
+		"ou-0a1b-0a1b2c3d4e5f",
+	}
+	for _, v := range validIds {
+		_, errors := validateOrganizationsParentId(v, "parent_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Organizations parent ID: %q", v, errors)
+		}
+	}
+
+	invalidIds := []string{
+		"",
+		"r-abc",            // too short
+		"ou-abcd-1234567",  // too short
+		"ou-abcd",          // missing suffix
+		"123456789012",     // account ID
+		"p-FullAWSAccess",  // policy ID
+		"R-ABCD",           // uppercase
+		"ou-ABCD-12345678", // uppercase
+	}
+	for _, v := range invalidIds {
+		_, errors := validateOrganizationsParentId(v, "parent_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Organizations parent ID", v)
+		}
+	}
+}
+
+func TestValidateOrganizationsAccountEmail(t *testing.T) {
+	validEmails := []string{
+		"a@b.cc",
+		"a@example.com",
+		"a+b@example.com",
+		"first.last@sub.example.co.uk",
+	}
+	for _, v := range validEmails {
+		_, errors := validateOrganizationsAccountEmail(v, "email")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid account email: %q", v, errors)
+		}
+	}
+
+	invalidEmails := []string{
+		"",
+		"a@b.c", // too short
+		"example.com",
+		"a b@example.com",
+		"a@example",
+		strings.Repeat("a", 53) + "@example.com", // too long
+	}
+	for _, v := range invalidEmails {
+		_, errors := validateOrganizationsAccountEmail(v, "email")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid account email", v)
+		}
+	}
+}
+
+func TestValidateOrganizationsAccountRoleName(t *testing.T) {
+	validNames := []string{
+		"OrganizationAccountAccessRole",
+		"Admin+Role=,.@-_1",
+		strings.Repeat("W", 64),
+	}
+	for _, v := range validNames {
+		_, errors := validateOrganizationsAccountRoleName(v, "role_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid account role name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"Admin Role",
+		"Admin/Role",
+		strings.Repeat("W", 65),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateOrganizationsAccountRoleName(v, "role_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid account role name", v)
+		}
+	}
+}
+
 func TestValidateArn(t *testing.T) {
 	v := ""
 	_, errors := validateArn(v, "arn")
@@ -755,6 +957,36 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 	}
 }
 
+func TestValidateCloudFormationStackSetName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"my-stack-set",
+		"StackSet1",
+		"a" + strings.Repeat("b", 127),
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudFormationStackSetName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudFormation StackSet name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1stack-set",
+		"-stack-set",
+		"stack_set",
+		"stack set",
+		"a" + strings.Repeat("b", 128),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudFormationStackSetName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudFormation StackSet name", v)
+		}
+	}
+}
+
 func TestValidateCloudFormationTemplate(t *testing.T) {
 	type testCases struct {
 		Value    string
@@ -2431,6 +2663,39 @@ func TestValidateCognitoUserPoolDomain(t *testing.T) {
 	}
 }
 
+func TestValidateCognitoResourceServerScopeName(t *testing.T) {
+	validValues := []string{
+		"read",
+		"read:all",
+		"users.write",
+		"foo-bar_baz",
+		strings.Repeat("W", 256),
+	}
+
+	for _, s := range validValues {
+		_, errors := validateCognitoResourceServerScopeName(s, "scope_name")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito Resource Server Scope Name: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"foo/bar",
+		"foo bar",
+		`foo"bar`,
+		`foo\bar`,
+		strings.Repeat("W", 257), // > 256
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateCognitoResourceServerScopeName(s, "scope_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito Resource Server Scope Name: %v", s, errors)
+		}
+	}
+}
+
 func TestValidateCognitoUserGroupName(t *testing.T) {
 	validValues := []string{
 		"foo",
@@ -2463,6 +2728,64 @@ func TestValidateCognitoUserGroupName(t *testing.T) {
 	}
 }
 
+func TestValidateDynamoDbBackupName(t *testing.T) {
+	validValues := []string{
+		"backup",
+		"tf-acc-test_backup.2018-03-01",
+		strings.Repeat("W", 255),
+	}
+	for _, v := range validValues {
+		_, errors := validateDynamoDbBackupName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DynamoDB backup name: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"ab",
+		"invalid backup",
+		"invalid/backup",
+		strings.Repeat("W", 256),
+	}
+	for _, v := range invalidValues {
+		_, errors := validateDynamoDbBackupName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DynamoDB backup name", v)
+		}
+	}
+}
+
+func TestValidateSagemakerName(t *testing.T) {
+	validValues := []string{
+		"ValidSageMakerName",
+		"Valid-5a63Mak3r-Name",
+		"123-456-789",
+		"1234",
+		strings.Repeat("W", 63),
+	}
+	for _, v := range validValues {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"Invalid name",
+		"-invalid-name",
+		"invalid-name-",
+		"invalid_name",
+		"",
+		strings.Repeat("W", 64),
+	}
+	for _, v := range invalidValues {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker name", v)
+		}
+	}
+}
+
 func TestValidateCognitoUserPoolId(t *testing.T) {
 	validValues := []string{
 		"eu-west-1_Foo123",
@@ -0,0 +1,411 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// vpnConnectionDeviceConfigData is the data the device configuration
+// templates are executed against. The parsed customer gateway configuration
+// is embedded, so templates can range over .Tunnels directly.
+type vpnConnectionDeviceConfigData struct {
+	XmlVpnConnectionConfig
+
+	// OutsideInterface is the customer gateway interface the tunnels are
+	// terminated on. Templates fall back to a device specific default.
+	OutsideInterface string
+	// VpcCidrBlocks are the VPC address ranges to route over the tunnels
+	// when the connection uses static routing.
+	VpcCidrBlocks []string
+}
+
+// vpnConnectionDeviceTemplates are the built-in device configuration
+// templates, keyed by device type.
+var vpnConnectionDeviceTemplates = map[string]string{
+	"generic":     vpnConnectionDeviceTemplateGeneric,
+	"cisco_ios":   vpnConnectionDeviceTemplateCiscoIOS,
+	"juniper_srx": vpnConnectionDeviceTemplateJuniperSRX,
+	"pfsense":     vpnConnectionDeviceTemplatePfSense,
+}
+
+// vpnConnectionDeviceTypes returns the sorted names of the built-in device
+// configuration templates.
+func vpnConnectionDeviceTypes() []string {
+	types := make([]string, 0, len(vpnConnectionDeviceTemplates))
+	for k := range vpnConnectionDeviceTemplates {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+	return types
+}
+
+var vpnConnectionCipherKeyLengthRegexp = regexp.MustCompile(`^aes-?(\d+)`)
+
+var vpnConnectionDeviceTemplateFuncs = template.FuncMap{
+	"add": func(a, b int) int { return a + b },
+	"mul": func(a, b int) int { return a * b },
+
+	// cipherKeyLength returns the key length of an AWS encryption protocol
+	// name, e.g. "128" for "aes-128-cbc".
+	"cipherKeyLength": func(protocol string) string {
+		if m := vpnConnectionCipherKeyLengthRegexp.FindStringSubmatch(protocol); m != nil {
+			return m[1]
+		}
+		return protocol
+	},
+
+	// integrityAlgorithm returns the hash algorithm of an AWS IKE or IPsec
+	// authentication protocol name, e.g. "sha1" for "hmac-sha1-96".
+	"integrityAlgorithm": func(protocol string) string {
+		switch {
+		case strings.Contains(protocol, "sha2-256"), strings.Contains(protocol, "sha256"):
+			return "sha256"
+		case strings.Contains(protocol, "sha1"):
+			return "sha1"
+		}
+		return protocol
+	},
+
+	// dhGroupNumber returns the Diffie-Hellman group number of an AWS PFS
+	// group name, e.g. "2" for "group2".
+	"dhGroupNumber": func(group string) string {
+		return strings.TrimPrefix(group, "group")
+	},
+
+	// strongswanDhGroup returns the strongSwan name of an AWS PFS group
+	// name, e.g. "modp1024" for "group2".
+	"strongswanDhGroup": func(group string) string {
+		switch strings.TrimPrefix(group, "group") {
+		case "2":
+			return "modp1024"
+		case "5":
+			return "modp1536"
+		case "14":
+			return "modp2048"
+		case "15":
+			return "modp3072"
+		case "16":
+			return "modp4096"
+		case "19":
+			return "ecp256"
+		case "20":
+			return "ecp384"
+		case "24":
+			return "modp2048s256"
+		}
+		return group
+	},
+
+	"cidrNetwork": func(cidr string) (string, error) {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return "", err
+		}
+		return ipnet.IP.String(), nil
+	},
+
+	"cidrNetmask": func(cidr string) (string, error) {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return "", err
+		}
+		if len(ipnet.Mask) != net.IPv4len {
+			return "", fmt.Errorf("%q is not an IPv4 CIDR block", cidr)
+		}
+		return net.IP(ipnet.Mask).String(), nil
+	},
+}
+
+// renderVpnConnectionDeviceConfig executes the given device configuration
+// template against the data.
+func renderVpnConnectionDeviceConfig(text string, data *vpnConnectionDeviceConfigData) (string, error) {
+	tmpl, err := template.New("device_config").Funcs(vpnConnectionDeviceTemplateFuncs).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("error rendering template: %s", err)
+	}
+
+	return buf.String(), nil
+}
+
+const vpnConnectionDeviceTemplateGeneric = `# AWS VPN connection {{.ID}}
+# Virtual private gateway: {{.VpnGatewayID}}
+# Customer gateway: {{.CustomerGatewayID}}
+#
+# Route based configuration for strongSwan and Libreswan, using one VTI
+# interface per tunnel.
+
+# /etc/ipsec.conf
+{{- range $i, $t := .Tunnels}}
+{{- $n := add $i 1}}
+
+conn {{$.ID}}-{{$n}}
+	auto=start
+	type=tunnel
+	authby=secret
+	keyexchange=ikev1
+	left=%defaultroute
+	leftid={{$t.CgwOutsideAddress}}
+	leftsubnet=0.0.0.0/0
+	right={{$t.OutsideAddress}}
+	rightsubnet=0.0.0.0/0
+	ike=aes{{cipherKeyLength $t.IkeEncryptionProtocol}}-{{integrityAlgorithm $t.IkeAuthenticationProtocol}}-{{strongswanDhGroup $t.IkePerfectForwardSecrecy}}!
+	ikelifetime={{$t.IkeLifetime}}s
+	esp=aes{{cipherKeyLength $t.IpsecEncryptionProtocol}}-{{integrityAlgorithm $t.IpsecAuthenticationProtocol}}-{{strongswanDhGroup $t.IpsecPerfectForwardSecrecy}}!
+	lifetime={{$t.IpsecLifetime}}s
+	dpddelay={{$t.DPDDelay}}s
+	dpdtimeout={{mul $t.DPDDelay $t.DPDRetry}}s
+	dpdaction=restart
+	mark={{add 100 $n}}
+{{- end}}
+
+# /etc/ipsec.secrets
+{{- range $t := .Tunnels}}
+{{$t.CgwOutsideAddress}} {{$t.OutsideAddress}} : PSK "{{$t.PreSharedKey}}"
+{{- end}}
+
+# Tunnel interfaces
+{{- range $i, $t := .Tunnels}}
+{{- $n := add $i 1}}
+# ip link add vti{{$n}} type vti local {{$t.CgwOutsideAddress}} remote {{$t.OutsideAddress}} key {{add 100 $n}}
+# ip addr add {{$t.CgwInsideAddress}}/{{$t.InsideNetworkCidr}} remote {{$t.VgwInsideAddress}}/{{$t.InsideNetworkCidr}} dev vti{{$n}}
+# ip link set vti{{$n}} up mtu 1436
+# iptables -t mangle -A FORWARD -o vti{{$n}} -p tcp --tcp-flags SYN,RST SYN -j TCPMSS --set-mss {{$t.IpsecTCPMSSAdjustment}}
+{{- end}}
+
+# Routing
+{{- range $i, $t := .Tunnels}}
+{{- $n := add $i 1}}
+{{- if $t.CgwBGPASN}}
+# BGP neighbor {{$t.VgwInsideAddress}} remote-as {{$t.BGPASN}} local-as {{$t.CgwBGPASN}} hold-time {{$t.BGPHoldTime}} via vti{{$n}}
+{{- else}}
+{{- range $c := $.VpcCidrBlocks}}
+# ip route add {{$c}} dev vti{{$n}} metric {{add 100 $n}}
+{{- end}}
+{{- end}}
+{{- end}}
+`
+
+const vpnConnectionDeviceTemplateCiscoIOS = `! AWS VPN connection {{.ID}}
+! Virtual private gateway: {{.VpnGatewayID}}
+! Customer gateway: {{.CustomerGatewayID}}
+!
+! Route based configuration for Cisco IOS, using one tunnel interface per tunnel.
+{{- range $i, $t := .Tunnels}}
+{{- $n := add $i 1}}
+{{- $name := printf "%s-%d" $.ID $n}}
+{{- $ikeHash := integrityAlgorithm $t.IkeAuthenticationProtocol}}
+{{- $espHash := integrityAlgorithm $t.IpsecAuthenticationProtocol}}
+
+! Tunnel #{{$n}}
+crypto isakmp policy {{add 200 $n}}
+  encryption aes {{cipherKeyLength $t.IkeEncryptionProtocol}}
+  authentication pre-share
+  group {{dhGroupNumber $t.IkePerfectForwardSecrecy}}
+  lifetime {{$t.IkeLifetime}}
+  hash {{if eq $ikeHash "sha1"}}sha{{else}}{{$ikeHash}}{{end}}
+exit
+
+crypto keyring keyring-{{$name}}
+  local-address {{$t.CgwOutsideAddress}}
+  pre-shared-key address {{$t.OutsideAddress}} key {{$t.PreSharedKey}}
+exit
+
+crypto isakmp profile isakmp-{{$name}}
+  local-address {{$t.CgwOutsideAddress}}
+  match identity address {{$t.OutsideAddress}}
+  keyring keyring-{{$name}}
+exit
+
+crypto ipsec transform-set ipsec-prop-{{$name}} esp-aes {{cipherKeyLength $t.IpsecEncryptionProtocol}} esp-{{if eq $espHash "sha1"}}sha{{else}}{{$espHash}}{{end}}-hmac
+  mode {{$t.IpsecMode}}
+exit
+
+crypto ipsec profile ipsec-{{$name}}
+  set pfs group{{dhGroupNumber $t.IpsecPerfectForwardSecrecy}}
+  set security-association lifetime seconds {{$t.IpsecLifetime}}
+  set transform-set ipsec-prop-{{$name}}
+exit
+
+crypto isakmp keepalive {{$t.DPDDelay}} {{$t.DPDRetry}} on-demand
+
+interface Tunnel{{$n}}
+  ip address {{$t.CgwInsideAddress}} {{$t.InsideNetworkMask}}
+  ip virtual-reassembly
+  tunnel source {{or $.OutsideInterface $t.CgwOutsideAddress}}
+  tunnel destination {{$t.OutsideAddress}}
+  tunnel mode ipsec ipv4
+  tunnel protection ipsec profile ipsec-{{$name}}
+  ip tcp adjust-mss {{$t.IpsecTCPMSSAdjustment}}
+  no shutdown
+exit
+{{- if $t.CgwBGPASN}}
+
+router bgp {{$t.CgwBGPASN}}
+  neighbor {{$t.VgwInsideAddress}} remote-as {{$t.BGPASN}}
+  neighbor {{$t.VgwInsideAddress}} activate
+  neighbor {{$t.VgwInsideAddress}} timers 10 {{$t.BGPHoldTime}} {{$t.BGPHoldTime}}
+exit
+{{- else}}
+{{- range $c := $.VpcCidrBlocks}}
+ip route {{cidrNetwork $c}} {{cidrNetmask $c}} Tunnel{{$n}} {{add 100 $n}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if .Tunnels}}
+{{- $t := index .Tunnels 0}}
+{{- if $t.IpsecClearDfBit}}
+
+crypto ipsec df-bit clear
+{{- end}}
+{{- if $t.IpsecFragmentationBeforeEncryption}}
+crypto ipsec fragmentation before-encryption
+{{- end}}
+{{- end}}
+`
+
+const vpnConnectionDeviceTemplateJuniperSRX = `# AWS VPN connection {{.ID}}
+# Virtual private gateway: {{.VpnGatewayID}}
+# Customer gateway: {{.CustomerGatewayID}}
+#
+# Route based configuration for Juniper SRX (JunOS), using one st0 unit per tunnel.
+{{- range $i, $t := .Tunnels}}
+{{- $n := add $i 1}}
+{{- $name := printf "%s-%d" $.ID $n}}
+
+# Tunnel #{{$n}}
+set interfaces st0 unit {{$n}} family inet address {{$t.CgwInsideAddress}}/{{$t.InsideNetworkCidr}}
+set interfaces st0 unit {{$n}} family inet mtu 1436
+set security zones security-zone trust interfaces st0.{{$n}}
+
+set security ike proposal ike-prop-{{$name}} authentication-method pre-shared-keys
+set security ike proposal ike-prop-{{$name}} authentication-algorithm {{$t.IkeAuthenticationProtocol}}
+set security ike proposal ike-prop-{{$name}} encryption-algorithm {{$t.IkeEncryptionProtocol}}
+set security ike proposal ike-prop-{{$name}} lifetime-seconds {{$t.IkeLifetime}}
+set security ike proposal ike-prop-{{$name}} dh-group {{$t.IkePerfectForwardSecrecy}}
+set security ike policy ike-pol-{{$name}} mode {{$t.IkeMode}}
+set security ike policy ike-pol-{{$name}} proposals ike-prop-{{$name}}
+set security ike policy ike-pol-{{$name}} pre-shared-key ascii-text "{{$t.PreSharedKey}}"
+set security ike gateway gw-{{$name}} ike-policy ike-pol-{{$name}}
+set security ike gateway gw-{{$name}} external-interface {{or $.OutsideInterface "ge-0/0/0.0"}}
+set security ike gateway gw-{{$name}} address {{$t.OutsideAddress}}
+set security ike gateway gw-{{$name}} dead-peer-detection interval {{$t.DPDDelay}}
+set security ike gateway gw-{{$name}} dead-peer-detection threshold {{$t.DPDRetry}}
+set security ike gateway gw-{{$name}} no-nat-traversal
+
+set security ipsec proposal ipsec-prop-{{$name}} protocol {{$t.IpsecProtocol}}
+set security ipsec proposal ipsec-prop-{{$name}} authentication-algorithm {{$t.IpsecAuthenticationProtocol}}
+set security ipsec proposal ipsec-prop-{{$name}} encryption-algorithm {{$t.IpsecEncryptionProtocol}}
+set security ipsec proposal ipsec-prop-{{$name}} lifetime-seconds {{$t.IpsecLifetime}}
+set security ipsec policy ipsec-pol-{{$name}} perfect-forward-secrecy keys {{$t.IpsecPerfectForwardSecrecy}}
+set security ipsec policy ipsec-pol-{{$name}} proposals ipsec-prop-{{$name}}
+set security ipsec vpn {{$name}} bind-interface st0.{{$n}}
+set security ipsec vpn {{$name}} ike gateway gw-{{$name}}
+set security ipsec vpn {{$name}} ike ipsec-policy ipsec-pol-{{$name}}
+{{- if $t.IpsecClearDfBit}}
+set security ipsec vpn {{$name}} df-bit clear
+{{- end}}
+set security ipsec vpn {{$name}} establish-tunnels immediately
+{{- if $t.CgwBGPASN}}
+
+set routing-options autonomous-system {{$t.CgwBGPASN}}
+set protocols bgp group ebgp type external
+set protocols bgp group ebgp neighbor {{$t.VgwInsideAddress}} peer-as {{$t.BGPASN}}
+set protocols bgp group ebgp neighbor {{$t.VgwInsideAddress}} local-address {{$t.CgwInsideAddress}}
+set protocols bgp group ebgp neighbor {{$t.VgwInsideAddress}} hold-time {{$t.BGPHoldTime}}
+{{- else}}
+{{- range $c := $.VpcCidrBlocks}}
+set routing-options static route {{$c}} qualified-next-hop st0.{{$n}} preference {{add 100 $n}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if .Tunnels}}
+{{- $t := index .Tunnels 0}}
+
+set security flow tcp-mss ipsec-vpn mss {{$t.IpsecTCPMSSAdjustment}}
+{{- end}}
+`
+
+const vpnConnectionDeviceTemplatePfSense = `<!--
+  AWS VPN connection {{.ID}}
+  Virtual private gateway: {{.VpnGatewayID}}
+  Customer gateway: {{.CustomerGatewayID}}
+
+  IPsec section of a pfSense config.xml, using one VTI phase 2 per tunnel.
+{{- if .Tunnels}}{{if (index .Tunnels 0).CgwBGPASN}}
+  BGP requires the OpenBGPD or FRR package.
+{{- end}}{{end}}
+-->
+<ipsec>
+{{- range $i, $t := .Tunnels}}
+{{- $n := add $i 1}}
+	<phase1>
+		<ikeid>{{$n}}</ikeid>
+		<iketype>ikev1</iketype>
+		<interface>{{or $.OutsideInterface "wan"}}</interface>
+		<remote-gateway>{{$t.OutsideAddress}}</remote-gateway>
+		<protocol>inet</protocol>
+		<myid_type>myaddress</myid_type>
+		<peerid_type>peeraddress</peerid_type>
+		<encryption>
+			<item>
+				<encryption-algorithm>
+					<name>aes</name>
+					<keylen>{{cipherKeyLength $t.IkeEncryptionProtocol}}</keylen>
+				</encryption-algorithm>
+				<hash-algorithm>{{integrityAlgorithm $t.IkeAuthenticationProtocol}}</hash-algorithm>
+				<dhgroup>{{dhGroupNumber $t.IkePerfectForwardSecrecy}}</dhgroup>
+			</item>
+		</encryption>
+		<lifetime>{{$t.IkeLifetime}}</lifetime>
+		<pre-shared-key>{{html $t.PreSharedKey}}</pre-shared-key>
+		<authentication_method>pre_shared_key</authentication_method>
+		<mode>{{$t.IkeMode}}</mode>
+		<descr>{{html $.ID}}-{{$n}}</descr>
+		<dpd_delay>{{$t.DPDDelay}}</dpd_delay>
+		<dpd_maxfail>{{$t.DPDRetry}}</dpd_maxfail>
+	</phase1>
+	<phase2>
+		<ikeid>{{$n}}</ikeid>
+		<mode>vti</mode>
+		<localid>
+			<type>address</type>
+			<address>{{$t.CgwInsideAddress}}</address>
+			<netbits>{{$t.InsideNetworkCidr}}</netbits>
+		</localid>
+		<remoteid>
+			<type>address</type>
+			<address>{{$t.VgwInsideAddress}}</address>
+		</remoteid>
+		<protocol>{{$t.IpsecProtocol}}</protocol>
+		<encryption-algorithm-option>
+			<name>aes</name>
+			<keylen>{{cipherKeyLength $t.IpsecEncryptionProtocol}}</keylen>
+		</encryption-algorithm-option>
+		<hash-algorithm-option>hmac_{{integrityAlgorithm $t.IpsecAuthenticationProtocol}}</hash-algorithm-option>
+		<pfsgroup>{{dhGroupNumber $t.IpsecPerfectForwardSecrecy}}</pfsgroup>
+		<lifetime>{{$t.IpsecLifetime}}</lifetime>
+		<descr>{{html $.ID}}-{{$n}}</descr>
+	</phase2>
+{{- end}}
+</ipsec>
+{{- range $i, $t := .Tunnels}}
+{{- $n := add $i 1}}
+{{- if $t.CgwBGPASN}}
+<!-- Tunnel #{{$n}}: BGP neighbor {{$t.VgwInsideAddress}} remote-as {{$t.BGPASN}} local-as {{$t.CgwBGPASN}} holdtime {{$t.BGPHoldTime}} -->
+{{- else}}
+{{- range $c := $.VpcCidrBlocks}}
+<!-- Tunnel #{{$n}}: static route {{$c}} via {{$t.VgwInsideAddress}} -->
+{{- end}}
+{{- end}}
+{{- end}}
+`
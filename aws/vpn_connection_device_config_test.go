@@ -0,0 +1,455 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderVpnConnectionDeviceConfig(t *testing.T) {
+	bgpConfig, err := parseXmlVpnConnectionConfig(testAccAwsVpnConnectionDeviceConfigXML)
+	if err != nil {
+		t.Fatalf("Error parsing XML: %s", err)
+	}
+	staticConfig, err := parseXmlVpnConnectionConfig(testAccAwsVpnConnectionDeviceConfigStaticXML)
+	if err != nil {
+		t.Fatalf("Error parsing XML: %s", err)
+	}
+
+	cases := []struct {
+		Name       string
+		Template   string
+		Data       *vpnConnectionDeviceConfigData
+		Expected   []string
+		Unexpected []string
+	}{
+		{
+			Name:     "generic",
+			Template: vpnConnectionDeviceTemplates["generic"],
+			Data:     &vpnConnectionDeviceConfigData{XmlVpnConnectionConfig: *bgpConfig},
+			Expected: []string{
+				"conn vpn-0123456789abcdef0-1\n",
+				"\tright=52.1.1.1\n",
+				"\tike=aes128-sha1-modp1024!\n",
+				"\tesp=aes128-sha1-modp1024!\n",
+				"\tdpdtimeout=30s\n",
+				"198.51.100.1 52.1.1.1 : PSK \"key_one\"\n",
+				"198.51.100.1 52.2.2.2 : PSK \"key_two\"\n",
+				"# ip addr add 169.254.10.2/30 remote 169.254.10.1/30 dev vti1\n",
+				"# BGP neighbor 169.254.10.1 remote-as 64512 local-as 65000 hold-time 30 via vti1\n",
+			},
+		},
+		{
+			Name:     "generic static",
+			Template: vpnConnectionDeviceTemplates["generic"],
+			Data: &vpnConnectionDeviceConfigData{
+				XmlVpnConnectionConfig: *staticConfig,
+				VpcCidrBlocks:          []string{"10.0.0.0/16"},
+			},
+			Expected: []string{
+				"# ip route add 10.0.0.0/16 dev vti1 metric 101\n",
+				"# ip route add 10.0.0.0/16 dev vti2 metric 102\n",
+			},
+			Unexpected: []string{"BGP"},
+		},
+		{
+			Name:     "cisco_ios",
+			Template: vpnConnectionDeviceTemplates["cisco_ios"],
+			Data:     &vpnConnectionDeviceConfigData{XmlVpnConnectionConfig: *bgpConfig},
+			Expected: []string{
+				"crypto isakmp policy 201\n",
+				"  encryption aes 128\n",
+				"  hash sha\n",
+				"  pre-shared-key address 52.2.2.2 key key_two\n",
+				"crypto ipsec transform-set ipsec-prop-vpn-0123456789abcdef0-1 esp-aes 128 esp-sha-hmac\n",
+				"  set pfs group2\n",
+				"crypto isakmp keepalive 10 3 on-demand\n",
+				"  ip address 169.254.10.2 255.255.255.252\n",
+				"  tunnel source 198.51.100.1\n",
+				"  ip tcp adjust-mss 1379\n",
+				"router bgp 65000\n",
+				"  neighbor 169.254.20.1 remote-as 64512\n",
+				"crypto ipsec df-bit clear\n",
+				"crypto ipsec fragmentation before-encryption\n",
+			},
+		},
+		{
+			Name:     "cisco_ios static",
+			Template: vpnConnectionDeviceTemplates["cisco_ios"],
+			Data: &vpnConnectionDeviceConfigData{
+				XmlVpnConnectionConfig: *staticConfig,
+				OutsideInterface:       "GigabitEthernet0/0",
+				VpcCidrBlocks:          []string{"10.0.0.0/16", "172.16.0.0/24"},
+			},
+			Expected: []string{
+				"  tunnel source GigabitEthernet0/0\n",
+				"ip route 10.0.0.0 255.255.0.0 Tunnel1 101\n",
+				"ip route 172.16.0.0 255.255.255.0 Tunnel2 102\n",
+			},
+			Unexpected: []string{"router bgp"},
+		},
+		{
+			Name:     "juniper_srx",
+			Template: vpnConnectionDeviceTemplates["juniper_srx"],
+			Data:     &vpnConnectionDeviceConfigData{XmlVpnConnectionConfig: *bgpConfig},
+			Expected: []string{
+				"set interfaces st0 unit 1 family inet address 169.254.10.2/30\n",
+				"set security ike proposal ike-prop-vpn-0123456789abcdef0-1 authentication-algorithm sha1\n",
+				"set security ike proposal ike-prop-vpn-0123456789abcdef0-2 dh-group group2\n",
+				"set security ike policy ike-pol-vpn-0123456789abcdef0-2 pre-shared-key ascii-text \"key_two\"\n",
+				"set security ike gateway gw-vpn-0123456789abcdef0-1 external-interface ge-0/0/0.0\n",
+				"set security ipsec proposal ipsec-prop-vpn-0123456789abcdef0-1 authentication-algorithm hmac-sha1-96\n",
+				"set security ipsec vpn vpn-0123456789abcdef0-1 df-bit clear\n",
+				"set routing-options autonomous-system 65000\n",
+				"set protocols bgp group ebgp neighbor 169.254.20.1 peer-as 64512\n",
+				"set security flow tcp-mss ipsec-vpn mss 1379\n",
+			},
+		},
+		{
+			Name:     "juniper_srx static",
+			Template: vpnConnectionDeviceTemplates["juniper_srx"],
+			Data: &vpnConnectionDeviceConfigData{
+				XmlVpnConnectionConfig: *staticConfig,
+				OutsideInterface:       "ge-0/0/1.0",
+				VpcCidrBlocks:          []string{"10.0.0.0/16"},
+			},
+			Expected: []string{
+				"external-interface ge-0/0/1.0\n",
+				"set routing-options static route 10.0.0.0/16 qualified-next-hop st0.2 preference 102\n",
+			},
+			Unexpected: []string{"protocols bgp"},
+		},
+		{
+			Name:     "pfsense",
+			Template: vpnConnectionDeviceTemplates["pfsense"],
+			Data:     &vpnConnectionDeviceConfigData{XmlVpnConnectionConfig: *bgpConfig},
+			Expected: []string{
+				"\t\t<interface>wan</interface>\n",
+				"\t\t<remote-gateway>52.2.2.2</remote-gateway>\n",
+				"\t\t\t\t\t<keylen>128</keylen>\n",
+				"\t\t<pre-shared-key>key_one</pre-shared-key>\n",
+				"\t\t<hash-algorithm-option>hmac_sha1</hash-algorithm-option>\n",
+				"\t\t<pfsgroup>2</pfsgroup>\n",
+				"BGP requires the OpenBGPD or FRR package.",
+				"<!-- Tunnel #2: BGP neighbor 169.254.20.1 remote-as 64512 local-as 65000 holdtime 30 -->",
+			},
+		},
+		{
+			Name:     "custom",
+			Template: `{{range .Tunnels}}{{.OutsideAddress}}={{.IkeEncryptionProtocol}}/{{cipherKeyLength .IpsecEncryptionProtocol}};{{end}}`,
+			Data:     &vpnConnectionDeviceConfigData{XmlVpnConnectionConfig: *bgpConfig},
+			Expected: []string{"52.1.1.1=aes-128-cbc/128;52.2.2.2=aes-128-cbc/128;"},
+		},
+	}
+
+	for _, tc := range cases {
+		rendered, err := renderVpnConnectionDeviceConfig(tc.Template, tc.Data)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+
+		for _, s := range tc.Expected {
+			if !strings.Contains(rendered, s) {
+				t.Errorf("%s: expected rendered configuration to contain %q, got:\n%s", tc.Name, s, rendered)
+			}
+		}
+		for _, s := range tc.Unexpected {
+			if strings.Contains(rendered, s) {
+				t.Errorf("%s: expected rendered configuration not to contain %q, got:\n%s", tc.Name, s, rendered)
+			}
+		}
+	}
+}
+
+func TestRenderVpnConnectionDeviceConfig_errors(t *testing.T) {
+	config, err := parseXmlVpnConnectionConfig(testAccAwsVpnConnectionDeviceConfigStaticXML)
+	if err != nil {
+		t.Fatalf("Error parsing XML: %s", err)
+	}
+
+	cases := []struct {
+		Name     string
+		Template string
+		Data     *vpnConnectionDeviceConfigData
+	}{
+		{
+			Name:     "parse",
+			Template: `{{range .Tunnels}}`,
+			Data:     &vpnConnectionDeviceConfigData{XmlVpnConnectionConfig: *config},
+		},
+		{
+			Name:     "unknown field",
+			Template: `{{.NoSuchField}}`,
+			Data:     &vpnConnectionDeviceConfigData{XmlVpnConnectionConfig: *config},
+		},
+		{
+			Name:     "invalid CIDR block",
+			Template: vpnConnectionDeviceTemplates["cisco_ios"],
+			Data: &vpnConnectionDeviceConfigData{
+				XmlVpnConnectionConfig: *config,
+				VpcCidrBlocks:          []string{"10.0.0.0"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		if _, err := renderVpnConnectionDeviceConfig(tc.Template, tc.Data); err == nil {
+			t.Errorf("%s: expected an error", tc.Name)
+		}
+	}
+}
+
+func TestParseXmlVpnConnectionConfig(t *testing.T) {
+	config, err := parseXmlVpnConnectionConfig(testAccAwsVpnConnectionDeviceConfigXML)
+	if err != nil {
+		t.Fatalf("Error parsing XML: %s", err)
+	}
+
+	if config.ID != "vpn-0123456789abcdef0" {
+		t.Errorf("Bad ID: %s", config.ID)
+	}
+	if config.CustomerGatewayID != "cgw-0123456789abcdef0" {
+		t.Errorf("Bad customer gateway ID: %s", config.CustomerGatewayID)
+	}
+	if config.VpnGatewayID != "vgw-0123456789abcdef0" {
+		t.Errorf("Bad VPN gateway ID: %s", config.VpnGatewayID)
+	}
+	if len(config.Tunnels) != 2 {
+		t.Fatalf("Expected 2 tunnels, got %d", len(config.Tunnels))
+	}
+
+	// The tunnels are ordered by their outside address.
+	tunnel := config.Tunnels[0]
+	if tunnel.OutsideAddress != "52.1.1.1" {
+		t.Errorf("Bad outside address: %s", tunnel.OutsideAddress)
+	}
+	if tunnel.CgwBGPASN != "65000" || tunnel.BGPASN != "64512" || tunnel.BGPHoldTime != 30 {
+		t.Errorf("Bad BGP configuration: %#v", tunnel)
+	}
+	if tunnel.InsideNetworkCidr != 30 || tunnel.InsideNetworkMask != "255.255.255.252" {
+		t.Errorf("Bad inside network: %#v", tunnel)
+	}
+	if tunnel.IkeLifetime != 28800 || tunnel.IkeMode != "main" || tunnel.IkePerfectForwardSecrecy != "group2" {
+		t.Errorf("Bad IKE configuration: %#v", tunnel)
+	}
+	if tunnel.IpsecLifetime != 3600 || tunnel.IpsecAuthenticationProtocol != "hmac-sha1-96" || !tunnel.IpsecClearDfBit {
+		t.Errorf("Bad IPsec configuration: %#v", tunnel)
+	}
+	if tunnel.DPDDelay != 10 || tunnel.DPDRetry != 3 {
+		t.Errorf("Bad dead peer detection configuration: %#v", tunnel)
+	}
+}
+
+// A BGP routed VPN connection, with the tunnels out of order.
+const testAccAwsVpnConnectionDeviceConfigXML = `<?xml version="1.0" encoding="UTF-8"?>
+<vpn_connection id="vpn-0123456789abcdef0">
+  <customer_gateway_id>cgw-0123456789abcdef0</customer_gateway_id>
+  <vpn_gateway_id>vgw-0123456789abcdef0</vpn_gateway_id>
+  <vpn_connection_type>ipsec.1</vpn_connection_type>
+  <ipsec_tunnel>
+    <customer_gateway>
+      <tunnel_outside_address>
+        <ip_address>198.51.100.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.20.2</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>65000</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </customer_gateway>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>52.2.2.2</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.20.1</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>64512</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </vpn_gateway>
+    <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
+      <pre_shared_key>key_two</pre_shared_key>
+    </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1379</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retry>3</retry>
+      </dead_peer_detection>
+    </ipsec>
+  </ipsec_tunnel>
+  <ipsec_tunnel>
+    <customer_gateway>
+      <tunnel_outside_address>
+        <ip_address>198.51.100.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.10.2</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>65000</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </customer_gateway>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>52.1.1.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.10.1</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+      <bgp>
+        <asn>64512</asn>
+        <hold_time>30</hold_time>
+      </bgp>
+    </vpn_gateway>
+    <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
+      <pre_shared_key>key_one</pre_shared_key>
+    </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1379</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retry>3</retry>
+      </dead_peer_detection>
+    </ipsec>
+  </ipsec_tunnel>
+</vpn_connection>
+`
+
+// A statically routed VPN connection.
+const testAccAwsVpnConnectionDeviceConfigStaticXML = `<?xml version="1.0" encoding="UTF-8"?>
+<vpn_connection id="vpn-0123456789abcdef0">
+  <customer_gateway_id>cgw-0123456789abcdef0</customer_gateway_id>
+  <vpn_gateway_id>vgw-0123456789abcdef0</vpn_gateway_id>
+  <vpn_connection_type>ipsec.1</vpn_connection_type>
+  <vpn_connection_attributes>NoBGPVPNConnection</vpn_connection_attributes>
+  <ipsec_tunnel>
+    <customer_gateway>
+      <tunnel_outside_address>
+        <ip_address>198.51.100.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.20.2</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+    </customer_gateway>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>52.2.2.2</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.20.1</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+    </vpn_gateway>
+    <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
+      <pre_shared_key>key_two</pre_shared_key>
+    </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1379</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retry>3</retry>
+      </dead_peer_detection>
+    </ipsec>
+  </ipsec_tunnel>
+  <ipsec_tunnel>
+    <customer_gateway>
+      <tunnel_outside_address>
+        <ip_address>198.51.100.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.10.2</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+    </customer_gateway>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>52.1.1.1</ip_address>
+      </tunnel_outside_address>
+      <tunnel_inside_address>
+        <ip_address>169.254.10.1</ip_address>
+        <network_mask>255.255.255.252</network_mask>
+        <network_cidr>30</network_cidr>
+      </tunnel_inside_address>
+    </vpn_gateway>
+    <ike>
+      <authentication_protocol>sha1</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>28800</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>main</mode>
+      <pre_shared_key>key_one</pre_shared_key>
+    </ike>
+    <ipsec>
+      <protocol>esp</protocol>
+      <authentication_protocol>hmac-sha1-96</authentication_protocol>
+      <encryption_protocol>aes-128-cbc</encryption_protocol>
+      <lifetime>3600</lifetime>
+      <perfect_forward_secrecy>group2</perfect_forward_secrecy>
+      <mode>tunnel</mode>
+      <clear_df_bit>true</clear_df_bit>
+      <fragmentation_before_encryption>true</fragmentation_before_encryption>
+      <tcp_mss_adjustment>1379</tcp_mss_adjustment>
+      <dead_peer_detection>
+        <delay>10</delay>
+        <retry>3</retry>
+      </dead_peer_detection>
+    </ipsec>
+  </ipsec_tunnel>
+</vpn_connection>
+`